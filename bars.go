@@ -0,0 +1,378 @@
+package futu
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+type BarKind int
+
+const (
+	BarTime     BarKind = iota // fixed time interval
+	BarVolume                  // fixed traded volume
+	BarTurnover                // fixed traded turnover, aka dollar bars
+)
+
+// BarSpec specifies the bars built by BarBuilder.
+type BarSpec struct {
+	Kind      BarKind
+	Interval  time.Duration // BarTime
+	Threshold float64       // BarVolume & BarTurnover
+}
+
+// TimeBars are bars of interval d, aligned to midnight of exchange time.
+func TimeBars(d time.Duration) BarSpec {
+	return BarSpec{Kind: BarTime, Interval: d}
+}
+
+// VolumeBars are closed once volume reaches v.
+func VolumeBars(v int64) BarSpec {
+	return BarSpec{Kind: BarVolume, Threshold: float64(v)}
+}
+
+// TurnoverBars are closed once turnover reaches t.
+func TurnoverBars(t float64) BarSpec {
+	return BarSpec{Kind: BarTurnover, Threshold: t}
+}
+
+// AuctionMode is how BarBuilder handles TickerType_Auction ticks.
+type AuctionMode int
+
+const (
+	// AuctionMerge merges opening auctions into the first bar of continuous
+	// trading, and closing auctions into the last bar before the session
+	// close, which needs WithBarCalendar. Without a calendar, closing
+	// auctions make their own bars.
+	AuctionMerge    AuctionMode = iota
+	AuctionSeparate             // auction ticks make their own bars
+	AuctionSkip                 // ignored
+)
+
+type barOptions struct {
+	loc        *time.Location
+	calendar   *Calendar
+	sessionGap time.Duration
+	auction    AuctionMode
+}
+
+type BarOption func(o *barOptions)
+
+// WithBarLocation sets the exchange time zone, used for aligning time bars,
+// session dates, and parsing ticker time strings.
+// Defaults to the zone of the security's market.
+func WithBarLocation(loc *time.Location) BarOption {
+	return func(o *barOptions) {
+		o.loc = loc
+	}
+}
+
+// WithBarCalendar closes bars at the session ends of c, eg: the HK lunch
+// break, and time bars are cut short at them. Trade dates may be requested
+// by Add, see Calendar.
+func WithBarCalendar(c *Calendar) BarOption {
+	return func(o *barOptions) {
+		o.calendar = c
+	}
+}
+
+// WithSessionGap starts a new bar if no tick is received for d, defaults to
+// 30 minutes, for builders without WithBarCalendar only.
+// Bars never span exchange dates. 0 disables the gap check.
+func WithSessionGap(d time.Duration) BarOption {
+	return func(o *barOptions) {
+		o.sessionGap = d
+	}
+}
+
+// WithAuctionMode sets how auction ticks are handled, defaults to AuctionMerge.
+func WithAuctionMode(m AuctionMode) BarOption {
+	return func(o *barOptions) {
+		o.auction = m
+	}
+}
+
+type bar struct {
+	start, end time.Time // bucket of time bars
+	last       time.Time // time of last tick
+	session    Session   // by calendar, zero without
+	open       float64
+	high       float64
+	low        float64
+	close      float64
+	volume     int64
+	turnover   float64
+	auction    bool // only auction ticks so far
+	pending    bool // opening auction to be merged
+}
+
+// BarBuilder aggregates Ticker pushes of one security into custom bars.
+// Duplicated or resent ticks are dropped by sequence.
+//
+// Bars are returned as *pb.KLine. Like Futu klines, time of a time bar is the
+// end of its interval; time of other bars is the time of their last tick.
+// A tick is never split, volume and turnover bars may exceed the threshold.
+type BarBuilder struct {
+	barOptions
+	security *pb.Security
+	spec     BarSpec
+
+	mutex     sync.Mutex
+	cur       *bar
+	lastSeq   int64
+	lastClose float64
+	lastTrade time.Time // of the last tick not in auctions
+}
+
+// NewBarBuilder creates a BarBuilder of security.
+func NewBarBuilder(security *pb.Security, spec BarSpec, opts ...BarOption) *BarBuilder {
+	o := barOptions{
+		loc:        QotMarketLocation(security.GetMarket()),
+		sessionGap: 30 * time.Minute,
+		auction:    AuctionMerge,
+	}
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	return &BarBuilder{
+		barOptions: o,
+		security:   proto.Clone(security).(*pb.Security),
+		spec:       spec,
+	}
+}
+
+// Apply adds tickers of a QotUpdateTicker push, and returns closed bars.
+// Pushes of other securities are ignored.
+func (b *BarBuilder) Apply(msg *pb.QotUpdateTickerResponse) ([]*pb.KLine, error) {
+	if !sameSecurity(b.security, msg.GetSecurity()) {
+		return nil, nil
+	}
+
+	closed := []*pb.KLine{}
+	for _, t := range msg.GetTickerList() {
+		bars, err := b.Add(t)
+		closed = append(closed, bars...)
+		if err != nil {
+			return closed, err
+		}
+	}
+	return closed, nil
+}
+
+// Add adds a ticker, and returns bars closed by it.
+func (b *BarBuilder) Add(t *pb.Ticker) ([]*pb.KLine, error) {
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	seq := t.GetSequence()
+	if seq != 0 && seq <= b.lastSeq {
+		return nil, nil
+	}
+
+	tm, err := b.tickerTime(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if seq != 0 {
+		b.lastSeq = seq
+	}
+
+	auction := t.GetType() == pb.TickerType_TickerType_Auction
+	if auction && b.auction == AuctionSkip {
+		return nil, nil
+	}
+
+	// at is the time bucketed by
+	at, session, opening := tm, Session{}, false
+	if b.calendar != nil {
+		ctx := context.TODO()
+		if session, err = b.calendar.SessionAt(ctx, tm); err != nil {
+			return nil, err
+		}
+		opening = session.Type == SessionOpeningAuction
+
+		// closing auction is merged into the last bar of the session before
+		if auction && b.auction == AuctionMerge && session.Type == SessionClosingAuction {
+			prev, err := b.calendar.SessionAt(ctx, session.Start.Add(-time.Nanosecond))
+			if err != nil {
+				return nil, err
+			}
+			if prev.Type != SessionClosed {
+				at, session, auction = prev.End.Add(-time.Nanosecond), prev, false
+			}
+		}
+	} else {
+		// closing auctions follow continuous trading of the date
+		opening = !sameDate(b.lastTrade, tm)
+	}
+
+	closed := []*pb.KLine{}
+
+	if b.cur != nil && b.closedBy(at, session, auction) {
+		closed = append(closed, b.closeBar())
+	}
+
+	if b.cur == nil {
+		b.cur = &bar{
+			open:    t.GetPrice(),
+			high:    t.GetPrice(),
+			low:     t.GetPrice(),
+			session: session,
+			auction: auction,
+			pending: auction && opening && b.auction == AuctionMerge,
+		}
+		b.cur.start, b.cur.end = b.bucket(at, session)
+	} else if b.cur.pending && !auction {
+		// opening auction is merged into the first bar of continuous trading
+		b.cur.auction, b.cur.pending = false, false
+		b.cur.session = session
+		b.cur.start, b.cur.end = b.bucket(at, session)
+	}
+
+	if !auction {
+		b.lastTrade = tm
+	}
+
+	c := b.cur
+	c.last = tm
+	c.close = t.GetPrice()
+	c.high = max(c.high, t.GetPrice())
+	c.low = min(c.low, t.GetPrice())
+	c.volume += t.GetVolume()
+	c.turnover += t.GetTurnover()
+
+	switch b.spec.Kind {
+	case BarVolume:
+		if float64(c.volume) >= b.spec.Threshold {
+			closed = append(closed, b.closeBar())
+		}
+	case BarTurnover:
+		if c.turnover >= b.spec.Threshold {
+			closed = append(closed, b.closeBar())
+		}
+	}
+
+	return closed, nil
+}
+
+func sameDate(t1, t2 time.Time) bool {
+	y1, m1, d1 := t1.Date()
+	y2, m2, d2 := t2.Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// closedBy reports whether a tick at tm of session closes the current bar.
+func (b *BarBuilder) closedBy(tm time.Time, session Session, auction bool) bool {
+	c := b.cur
+
+	// opening auction waits for continuous trading of the date
+	if c.pending {
+		if b.calendar != nil {
+			return session.TradeDate != c.session.TradeDate
+		}
+		return !sameDate(c.last, tm)
+	}
+
+	// session boundaries
+	if b.calendar != nil {
+		if session.Type != c.session.Type || !session.Start.Equal(c.session.Start) {
+			return true
+		}
+	} else {
+		if !sameDate(c.last, tm) {
+			return true
+		}
+		if b.sessionGap > 0 && tm.Sub(c.last) > b.sessionGap {
+			return true
+		}
+	}
+
+	if c.auction != auction {
+		return true
+	}
+
+	return b.spec.Kind == BarTime && !tm.Before(c.end)
+}
+
+// bucket returns the time bar interval of tm, ending by the session end.
+func (b *BarBuilder) bucket(tm time.Time, session Session) (start, end time.Time) {
+	if b.spec.Kind != BarTime || b.spec.Interval <= 0 {
+		return tm, tm
+	}
+
+	midnight := time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, b.loc)
+	n := tm.Sub(midnight) / b.spec.Interval
+	start = midnight.Add(n * b.spec.Interval)
+	end = start.Add(b.spec.Interval)
+	if session.Type != SessionClosed && session.End.Before(end) {
+		end = session.End
+	}
+	return start, end
+}
+
+func (b *BarBuilder) tickerTime(t *pb.Ticker) (time.Time, error) {
+	return ParseTimeTimestamp(t.GetTime(), t.GetTimestamp(), b.loc)
+}
+
+func (b *BarBuilder) closeBar() *pb.KLine {
+	c := b.cur
+	b.cur = nil
+
+	tm := c.last
+	if b.spec.Kind == BarTime {
+		tm = c.end
+	}
+
+	kl := &pb.KLine{
+		Time:       proto.String(tm.Format(TimeFormat)),
+		IsBlank:    proto.Bool(false),
+		OpenPrice:  proto.Float64(c.open),
+		HighPrice:  proto.Float64(c.high),
+		LowPrice:   proto.Float64(c.low),
+		ClosePrice: proto.Float64(c.close),
+		Volume:     proto.Int64(c.volume),
+		Turnover:   proto.Float64(c.turnover),
+		Timestamp:  proto.Float64(float64(tm.UnixMilli()) / 1000),
+	}
+	if b.lastClose != 0 {
+		kl.LastClosePrice = proto.Float64(b.lastClose)
+	}
+	b.lastClose = c.close
+
+	return kl
+}
+
+// Flush closes the current time bar if its interval has ended by now,
+// eg: called by a timer when no tick arrives. Bars of opening auctions
+// waiting to be merged are kept.
+func (b *BarBuilder) Flush(now time.Time) *pb.KLine {
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.cur == nil || b.spec.Kind != BarTime || now.Before(b.cur.end) {
+		return nil
+	}
+	if b.cur.pending {
+		return nil
+	}
+	return b.closeBar()
+}
+
+// Finish closes the current bar regardless of its interval, eg: at the end of a session.
+func (b *BarBuilder) Finish() *pb.KLine {
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.cur == nil {
+		return nil
+	}
+	return b.closeBar()
+}
@@ -0,0 +1,186 @@
+package futu_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var hkt = time.FixedZone("HKT", 8*3600)
+
+func tick(seq int64, tm string, price float64, volume int64) *pb.Ticker {
+	return &pb.Ticker{
+		Time:     proto.String(tm),
+		Sequence: proto.Int64(seq),
+		Dir:      pb.TickerDirection_TickerDirection_Bid.Enum(),
+		Price:    proto.Float64(price),
+		Volume:   proto.Int64(volume),
+		Turnover: proto.Float64(price * float64(volume)),
+		Type:     pb.TickerType_TickerType_Automatch.Enum(),
+	}
+}
+
+func auctionTick(seq int64, tm string, price float64, volume int64) *pb.Ticker {
+	t := tick(seq, tm, price, volume)
+	t.Type = pb.TickerType_TickerType_Auction.Enum()
+	return t
+}
+
+func addTicks(should *require.Assertions, b *futu.BarBuilder, ticks ...*pb.Ticker) []*pb.KLine {
+	bars := []*pb.KLine{}
+	for _, t := range ticks {
+		closed, err := b.Add(t)
+		should.NoError(err)
+		bars = append(bars, closed...)
+	}
+	return bars
+}
+
+func TestBarBuilderTime(t *testing.T) {
+	should := require.New(t)
+
+	b := futu.NewBarBuilder(futu.NewSecurity("HK.00700"), futu.TimeBars(10*time.Second),
+		futu.WithBarLocation(hkt))
+
+	bars := addTicks(should, b,
+		auctionTick(1, "2024-03-01 09:20:00.000", 100, 1000), // opening auction
+		tick(2, "2024-03-01 09:30:01.500", 101, 100),
+		tick(2, "2024-03-01 09:30:01.500", 101, 100), // duplicated
+		tick(3, "2024-03-01 09:30:09.999", 99, 100),
+		tick(4, "2024-03-01 09:30:10.000", 102, 100),
+		tick(5, "2024-03-01 09:30:25.000", 103, 100),
+	)
+
+	should.Len(bars, 2)
+	should.Equal("2024-03-01 09:30:10", bars[0].GetTime())
+	should.Equal(100.0, bars[0].GetOpenPrice())
+	should.Equal(101.0, bars[0].GetHighPrice())
+	should.Equal(99.0, bars[0].GetLowPrice())
+	should.Equal(99.0, bars[0].GetClosePrice())
+	should.Equal(int64(1200), bars[0].GetVolume())
+	should.Equal(int64(100), bars[1].GetVolume())
+	should.Equal(99.0, bars[1].GetLastClosePrice())
+
+	// interval not ended
+	should.Nil(b.Flush(time.Date(2024, 3, 1, 9, 30, 29, 0, hkt)))
+	kl := b.Flush(time.Date(2024, 3, 1, 9, 30, 30, 0, hkt))
+	should.Equal("2024-03-01 09:30:30", kl.GetTime())
+
+	// closing auction makes its own bar without a calendar
+	bars = addTicks(should, b,
+		tick(6, "2024-03-01 15:59:59.000", 104, 100),
+		auctionTick(7, "2024-03-01 16:08:00.000", 105, 5000),
+		tick(8, "2024-03-04 09:30:00.000", 106, 100), // next session
+	)
+	should.Len(bars, 2)
+	should.Equal("2024-03-01 16:00:00", bars[0].GetTime())
+	should.Equal(int64(100), bars[0].GetVolume())
+	should.Equal("2024-03-01 16:08:10", bars[1].GetTime())
+	should.Equal(int64(5000), bars[1].GetVolume())
+
+	should.Equal("2024-03-04 09:30:10", b.Finish().GetTime())
+	should.Nil(b.Finish())
+}
+
+func TestBarBuilderCalendar(t *testing.T) {
+	should := require.New(t)
+
+	m := pbtest.NewMock()
+	tradeDates(m, nil, nil)
+	c := futu.NewCalendar(m, pb.TradeDateMarket_HK)
+
+	b := futu.NewBarBuilder(futu.NewSecurity("HK.00700"), futu.TimeBars(time.Hour),
+		futu.WithBarLocation(hkt), futu.WithBarCalendar(c))
+
+	bars := addTicks(should, b,
+		auctionTick(1, "2024-03-01 09:20:00.000", 100, 1000), // opening auction
+		tick(2, "2024-03-01 09:30:00.000", 101, 100),
+		tick(3, "2024-03-01 10:15:00.000", 102, 100),
+		tick(4, "2024-03-01 11:10:00.000", 103, 100), // illiquid, no gap split
+		tick(5, "2024-03-01 11:50:00.000", 104, 100),
+	)
+	should.Len(bars, 2)
+	should.Equal("2024-03-01 10:00:00", bars[0].GetTime())
+	should.Equal(int64(1100), bars[0].GetVolume())
+	should.Equal("2024-03-01 11:00:00", bars[1].GetTime())
+
+	// cut short at the lunch break
+	should.Nil(b.Flush(time.Date(2024, 3, 1, 11, 59, 0, 0, hkt)))
+	kl := b.Flush(time.Date(2024, 3, 1, 12, 0, 0, 0, hkt))
+	should.Equal("2024-03-01 12:00:00", kl.GetTime())
+	should.Equal(int64(200), kl.GetVolume())
+
+	// closing auction is clamped to the session close
+	bars = addTicks(should, b,
+		tick(6, "2024-03-01 14:30:00.000", 105, 100),
+		auctionTick(7, "2024-03-01 16:08:00.000", 106, 5000),
+		tick(8, "2024-03-04 09:30:00.000", 107, 100), // next trade date
+	)
+	should.Len(bars, 2)
+	should.Equal("2024-03-01 15:00:00", bars[0].GetTime())
+	should.Equal(int64(100), bars[0].GetVolume())
+	should.Equal("2024-03-01 16:00:00", bars[1].GetTime())
+	should.Equal(106.0, bars[1].GetClosePrice())
+	should.Equal(int64(5000), bars[1].GetVolume())
+
+	should.Equal("2024-03-04 10:00:00", b.Finish().GetTime())
+}
+
+func TestBarBuilderVolume(t *testing.T) {
+	should := require.New(t)
+
+	b := futu.NewBarBuilder(futu.NewSecurity("HK.00700"), futu.VolumeBars(300),
+		futu.WithBarLocation(hkt), futu.WithAuctionMode(futu.AuctionSeparate))
+
+	bars := addTicks(should, b,
+		auctionTick(1, "2024-03-01 09:20:00.000", 100, 50),
+		tick(2, "2024-03-01 09:30:00.000", 101, 200),
+		tick(3, "2024-03-01 09:30:01.000", 102, 200),
+		tick(4, "2024-03-01 13:00:00.000", 103, 100), // after lunch break
+	)
+
+	should.Len(bars, 2)
+	should.Equal(int64(50), bars[0].GetVolume())
+	should.Equal("2024-03-01 09:20:00", bars[0].GetTime())
+	should.Equal(int64(400), bars[1].GetVolume())
+	should.Equal("2024-03-01 09:30:01", bars[1].GetTime())
+
+	// session gap
+	bars = addTicks(should, b, tick(5, "2024-03-01 15:00:00.000", 104, 100))
+	should.Len(bars, 1)
+	should.Equal(int64(100), bars[0].GetVolume())
+}
+
+func TestBarBuilderTurnover(t *testing.T) {
+	should := require.New(t)
+
+	ts := time.Date(2024, 3, 1, 9, 30, 0, 0, hkt)
+	msg := &pb.QotUpdateTickerResponse{
+		Security: futu.NewSecurity("HK.00700"),
+	}
+	for i := range 5 {
+		tk := tick(int64(i+1), "", 100, 10)
+		tk.Timestamp = proto.Float64(float64(ts.Add(time.Duration(i)*time.Second).UnixMilli()) / 1000)
+		msg.TickerList = append(msg.TickerList, tk)
+	}
+
+	b := futu.NewBarBuilder(futu.NewSecurity("HK.00700"), futu.TurnoverBars(2000),
+		futu.WithBarLocation(hkt), futu.WithAuctionMode(futu.AuctionSkip))
+
+	bars, err := b.Apply(msg)
+	should.NoError(err)
+	should.Len(bars, 2)
+	should.Equal(2000.0, bars[0].GetTurnover())
+	should.Equal("2024-03-01 09:30:01", bars[0].GetTime())
+
+	// other securities are ignored
+	msg.Security = futu.NewSecurity("HK.09988")
+	bars, err = b.Apply(msg)
+	should.NoError(err)
+	should.Empty(bars)
+}
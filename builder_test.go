@@ -0,0 +1,42 @@
+package futu_test
+
+import (
+	"testing"
+
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRequestBuilder(t *testing.T) {
+	should := require.New(t)
+
+	req := (&pb.QotStockFilterRequest{}).
+		WithBegin(0).
+		WithNum(200).
+		WithMarket(pb.QotMarket_HK_Security).
+		AddBaseFilter(
+			pb.NewBaseFilter(pb.StockField_CurPrice).WithFilterMin(10).WithFilterMax(100),
+			pb.NewBaseFilter(pb.StockField_MarketVal).WithIsNoFilter(false),
+		).
+		AddBaseFilter(pb.NewBaseFilter(pb.StockField_VolumeRatio)).
+		AddAccumulateFilter(pb.NewAccumulateFilter(pb.AccumulateField_ChangeRate, 5)).
+		AddFinancialFilter(pb.NewFinancialFilter(pb.FinancialField_NetProfit, pb.FinancialQuarter_Annual))
+
+	should.Len(req.GetBaseFilterList(), 3)
+	should.Equal(pb.StockField_VolumeRatio, req.GetBaseFilterList()[2].GetFieldName())
+	should.Equal(int32(5), req.GetAccumulateFilterList()[0].GetDays())
+	should.Equal(pb.FinancialQuarter_Annual, req.GetFinancialFilterList()[0].GetQuarter())
+	should.NoError(req.Validate())
+
+	// header injection
+	var order proto.Message = &pb.TrdPlaceOrderRequest{}
+	getter, ok := order.(pb.HeaderGetter)
+	should.True(ok)
+	should.Nil(getter.GetHeader())
+
+	setter, ok := order.(pb.HeaderSetter)
+	should.True(ok)
+	setter.SetHeader(pb.NewTrdHeader(pb.TrdEnv_Simulate, 1, pb.TrdMarket_HK))
+	should.Equal(uint64(1), getter.GetHeader().GetAccID())
+}
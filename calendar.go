@@ -0,0 +1,398 @@
+package futu
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/santsai/futu-go/pb"
+)
+
+type SessionType int
+
+const (
+	SessionClosed SessionType = iota
+	SessionOpeningAuction
+	SessionContinuous
+	SessionClosingAuction
+	SessionPreMarket
+	SessionAfterHours
+	SessionOvernight
+)
+
+var sessionTypeNames = map[SessionType]string{
+	SessionClosed:         "Closed",
+	SessionOpeningAuction: "OpeningAuction",
+	SessionContinuous:     "Continuous",
+	SessionClosingAuction: "ClosingAuction",
+	SessionPreMarket:      "PreMarket",
+	SessionAfterHours:     "AfterHours",
+	SessionOvernight:      "Overnight",
+}
+
+func (t SessionType) String() string {
+	return sessionTypeNames[t]
+}
+
+// extended reports whether t is US extended hours.
+func (t SessionType) extended() bool {
+	return t == SessionPreMarket || t == SessionAfterHours || t == SessionOvernight
+}
+
+// Session is a trading session of a trade date, in [Start, End).
+type Session struct {
+	Type      SessionType
+	Name      string
+	TradeDate string // YYYY-MM-DD
+	Start     time.Time
+	End       time.Time
+}
+
+// trade date types a session is in
+const (
+	dayWhole = 1 << iota
+	dayMorning
+	dayAfternoon
+
+	dayAll = dayWhole | dayMorning | dayAfternoon
+)
+
+func tradeDateBit(t pb.TradeDateType) int {
+	switch t {
+	case pb.TradeDateType_Morning:
+		return dayMorning
+	case pb.TradeDateType_Afternoon:
+		return dayAfternoon
+	}
+	return dayWhole
+}
+
+// sessionSpec is a session in minutes from midnight of the trade date,
+// negative for sessions starting on the previous day.
+type sessionSpec struct {
+	typ        SessionType
+	name       string
+	start, end int
+	days       int
+}
+
+func hm(h, m int) int {
+	return h*60 + m
+}
+
+var (
+	hkSessions = []sessionSpec{
+		{SessionOpeningAuction, "pre-opening", hm(9, 0), hm(9, 30), dayWhole | dayMorning},
+		{SessionContinuous, "morning", hm(9, 30), hm(12, 0), dayWhole | dayMorning},
+		{SessionClosingAuction, "closing auction", hm(12, 0), hm(12, 10), dayMorning},
+		{SessionContinuous, "afternoon", hm(13, 0), hm(16, 0), dayWhole | dayAfternoon},
+		{SessionClosingAuction, "closing auction", hm(16, 0), hm(16, 10), dayWhole | dayAfternoon},
+	}
+
+	usSessions = []sessionSpec{
+		{SessionOvernight, "overnight", hm(-4, 0), hm(4, 0), dayAll},
+		{SessionPreMarket, "pre-market", hm(4, 0), hm(9, 30), dayAll},
+		{SessionContinuous, "regular", hm(9, 30), hm(16, 0), dayWhole},
+		{SessionContinuous, "regular", hm(9, 30), hm(13, 0), dayMorning | dayAfternoon},
+		{SessionAfterHours, "after-hours", hm(16, 0), hm(20, 0), dayWhole},
+		{SessionAfterHours, "after-hours", hm(13, 0), hm(17, 0), dayMorning | dayAfternoon},
+	}
+
+	cnSessions = []sessionSpec{
+		{SessionOpeningAuction, "call auction", hm(9, 15), hm(9, 25), dayWhole | dayMorning},
+		{SessionContinuous, "morning", hm(9, 30), hm(11, 30), dayWhole | dayMorning},
+		{SessionContinuous, "afternoon", hm(13, 0), hm(14, 57), dayWhole | dayAfternoon},
+		{SessionClosingAuction, "closing auction", hm(14, 57), hm(15, 0), dayWhole | dayAfternoon},
+	}
+)
+
+type calendarOptions struct {
+	extendedHours bool
+}
+
+type CalendarOption func(o *calendarOptions)
+
+// WithExtendedHours counts US pre-market, after-hours and overnight sessions
+// as trading in NextOpen and TradingDuration.
+func WithExtendedHours(b bool) CalendarOption {
+	return func(o *calendarOptions) {
+		o.extendedHours = b
+	}
+}
+
+// Calendar answers trading days and sessions of a market. Trade dates are
+// requested with QotRequestTradeDate by half year when needed, and cached.
+// Temporary closures, eg: typhoons, are not included by OpenD.
+type Calendar struct {
+	calendarOptions
+	rh       pb.RequestHandler
+	market   pb.TradeDateMarket
+	loc      *time.Location
+	sessions []sessionSpec
+
+	mutex  sync.Mutex
+	loaded map[string]bool             // half years, eg: 2024H1
+	dates  map[string]pb.TradeDateType // YYYY-MM-DD
+}
+
+// NewCalendar creates a Calendar of market.
+// Sessions are known for HK, US, CN and the stock connects.
+func NewCalendar(rh pb.RequestHandler, market pb.TradeDateMarket, opts ...CalendarOption) *Calendar {
+	c := &Calendar{
+		rh:     rh,
+		market: market,
+		loc:    time.UTC,
+		loaded: map[string]bool{},
+		dates:  map[string]pb.TradeDateType{},
+	}
+
+	switch market {
+	case pb.TradeDateMarket_HK, pb.TradeDateMarket_ST:
+		c.loc, c.sessions = LocHongKong, hkSessions
+	case pb.TradeDateMarket_US:
+		c.loc, c.sessions = LocNewYork, usSessions
+	case pb.TradeDateMarket_CN, pb.TradeDateMarket_NT:
+		c.loc, c.sessions = LocShanghai, cnSessions
+	case pb.TradeDateMarket_JP_Future:
+		c.loc = LocTokyo
+	case pb.TradeDateMarket_SG_Future:
+		c.loc = LocSingapore
+	}
+
+	for _, o := range opts {
+		o(&c.calendarOptions)
+	}
+
+	return c
+}
+
+// Location returns the time zone of the market.
+func (c *Calendar) Location() *time.Location {
+	return c.loc
+}
+
+// load requests trade dates of the half year of date, if not yet.
+func (c *Calendar) load(ctx context.Context, date time.Time) error {
+	y, m, _ := date.Date()
+	begin, end := time.Date(y, 1, 1, 0, 0, 0, 0, c.loc), time.Date(y, 6, 30, 0, 0, 0, 0, c.loc)
+	half := "H1"
+	if m > 6 {
+		begin, end = time.Date(y, 7, 1, 0, 0, 0, 0, c.loc), time.Date(y, 12, 31, 0, 0, 0, 0, c.loc)
+		half = "H2"
+	}
+
+	key := begin.Format("2006") + half
+	if c.loaded[key] {
+		return nil
+	}
+
+	req := &pb.QotRequestTradeDateRequest{
+		Market:    c.market.Enum(),
+		BeginTime: DatePtr(begin),
+		EndTime:   DatePtr(end),
+	}
+	resp, err := req.Dispatch(ctx, c.rh)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range resp.GetTradeDateList() {
+		c.dates[datePart(d.GetTime())] = d.GetTradeDateType()
+	}
+	c.loaded[key] = true
+	return nil
+}
+
+// tradeDate returns the trade date type of date, false if not a trading day.
+func (c *Calendar) tradeDate(ctx context.Context, date time.Time) (pb.TradeDateType, bool, error) {
+	if err := c.load(ctx, date); err != nil {
+		return 0, false, err
+	}
+	t, ok := c.dates[date.Format(DateFormat)]
+	return t, ok, nil
+}
+
+// TradingDay returns the trade date type of the date of t in market time,
+// false if not a trading day.
+func (c *Calendar) TradingDay(ctx context.Context, t time.Time) (pb.TradeDateType, bool, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.tradeDate(ctx, t.In(c.loc))
+}
+
+// IsTradingDay reports whether the date of t in market time is a trading day.
+func (c *Calendar) IsTradingDay(ctx context.Context, t time.Time) (bool, error) {
+	_, ok, err := c.TradingDay(ctx, t)
+	return ok, err
+}
+
+// sessionsOf returns sessions of date, nil if not a trading day.
+func (c *Calendar) sessionsOf(ctx context.Context, date time.Time) ([]Session, error) {
+	if c.sessions == nil {
+		return nil, ErrNoSessionTable
+	}
+
+	t, ok, err := c.tradeDate(ctx, date)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	y, m, d := date.Date()
+	sessions := []Session{}
+	for _, s := range c.sessions {
+		if s.days&tradeDateBit(t) == 0 {
+			continue
+		}
+		sessions = append(sessions, Session{
+			Type:      s.typ,
+			Name:      s.name,
+			TradeDate: date.Format(DateFormat),
+			Start:     time.Date(y, m, d, 0, s.start, 0, 0, c.loc),
+			End:       time.Date(y, m, d, 0, s.end, 0, 0, c.loc),
+		})
+	}
+	return sessions, nil
+}
+
+// Sessions returns sessions of the trade date of t in market time,
+// empty if not a trading day.
+func (c *Calendar) Sessions(ctx context.Context, t time.Time) ([]Session, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.sessionsOf(ctx, t.In(c.loc))
+}
+
+// SessionAt returns the session at t, of type SessionClosed if none.
+func (c *Calendar) SessionAt(ctx context.Context, t time.Time) (Session, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	t = t.In(c.loc)
+	date := startOfDay(t)
+
+	// sessions of the next trade date may start on the day before
+	for _, d := range []time.Time{date, date.AddDate(0, 0, 1)} {
+		sessions, err := c.sessionsOf(ctx, d)
+		if err != nil {
+			return Session{}, err
+		}
+		for _, s := range sessions {
+			if !t.Before(s.Start) && t.Before(s.End) {
+				return s, nil
+			}
+		}
+	}
+
+	return Session{Type: SessionClosed}, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+// trading reports whether s counts as trading.
+func (c *Calendar) trading(s Session) bool {
+	return s.Type == SessionContinuous || (c.extendedHours && s.Type.extended())
+}
+
+// max days searched by NextOpen
+const calendarSearchDays = 370
+
+// NextOpen returns the start of the next trading session after t.
+// Auctions are not counted, extended hours are counted with WithExtendedHours.
+func (c *Calendar) NextOpen(ctx context.Context, t time.Time) (time.Time, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	t = t.In(c.loc)
+	date := startOfDay(t)
+
+	// sessions of the day before, which may continue into the day
+	prev, err := c.sessionsOf(ctx, date.AddDate(0, 0, -1))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for i := 0; i < calendarSearchDays; i++ {
+		sessions, err := c.sessionsOf(ctx, date.AddDate(0, 0, i))
+		if err != nil {
+			return time.Time{}, err
+		}
+		for _, s := range sessions {
+			if c.trading(s) && s.Start.After(t) && !c.continues(s, prev, sessions) {
+				return s.Start, nil
+			}
+		}
+		prev = sessions
+	}
+
+	return time.Time{}, ErrNoTradingDay
+}
+
+// continues reports whether s continues a trading session ending at its start,
+// eg: regular hours after pre-market.
+func (c *Calendar) continues(s Session, lists ...[]Session) bool {
+	for _, sessions := range lists {
+		for _, v := range sessions {
+			if c.trading(v) && v.End.Equal(s.Start) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TradingDuration returns the trading time in [from, to).
+// Auctions are not counted, extended hours are counted with WithExtendedHours.
+func (c *Calendar) TradingDuration(ctx context.Context, from, to time.Time) (time.Duration, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	from, to = from.In(c.loc), to.In(c.loc)
+	total := time.Duration(0)
+
+	// the day after, for sessions starting on the day before
+	last := startOfDay(to).AddDate(0, 0, 1)
+	for date := startOfDay(from); !date.After(last); date = date.AddDate(0, 0, 1) {
+		sessions, err := c.sessionsOf(ctx, date)
+		if err != nil {
+			return 0, err
+		}
+		for _, s := range sessions {
+			if !c.trading(s) {
+				continue
+			}
+			start, end := s.Start, s.End
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+	}
+
+	return total, nil
+}
+
+// TradingMinutes returns the trading minutes in [from, to), see TradingDuration.
+func (c *Calendar) TradingMinutes(ctx context.Context, from, to time.Time) (float64, error) {
+	d, err := c.TradingDuration(ctx, from, to)
+	return d.Minutes(), err
+}
+
+// TradeDates returns cached trade dates, as YYYY-MM-DD.
+func (c *Calendar) TradeDates() map[string]pb.TradeDateType {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	dates := make(map[string]pb.TradeDateType, len(c.dates))
+	for k, v := range c.dates {
+		dates[k] = v
+	}
+	return dates
+}
@@ -0,0 +1,130 @@
+package futu_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// tradeDates serves weekdays as trade dates, except holidays.
+func tradeDates(m *pbtest.Mock, holidays map[string]bool, halfDays map[string]pb.TradeDateType) {
+	m.OnQotRequestTradeDate(func(req *pb.QotRequestTradeDateRequest) (*pb.QotRequestTradeDateResponse, error) {
+		begin, _ := time.Parse(futu.DateFormat, req.GetBeginTime())
+		end, _ := time.Parse(futu.DateFormat, req.GetEndTime())
+
+		resp := &pb.QotRequestTradeDateResponse{}
+		for d := begin; !d.After(end); d = d.AddDate(0, 0, 1) {
+			date := d.Format(futu.DateFormat)
+			if d.Weekday() == time.Saturday || d.Weekday() == time.Sunday || holidays[date] {
+				continue
+			}
+			resp.TradeDateList = append(resp.TradeDateList, &pb.TradeDate{
+				Time:          proto.String(date),
+				TradeDateType: halfDays[date].Enum(),
+			})
+		}
+		return resp, nil
+	})
+}
+
+func TestCalendarHK(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	m := pbtest.NewMock()
+	tradeDates(m, map[string]bool{"2024-03-29": true, "2024-04-01": true}, map[string]pb.TradeDateType{
+		"2024-12-24": pb.TradeDateType_Morning,
+	})
+
+	c := futu.NewCalendar(m, pb.TradeDateMarket_HK)
+	at := func(day, h, min int) time.Time {
+		return time.Date(2024, time.Month(day/100), day%100, h, min, 0, 0, futu.LocHongKong)
+	}
+
+	ok, err := c.IsTradingDay(ctx, at(328, 10, 0))
+	should.NoError(err)
+	should.True(ok)
+	ok, _ = c.IsTradingDay(ctx, at(401, 10, 0)) // easter monday
+	should.False(ok)
+
+	s, err := c.SessionAt(ctx, at(328, 12, 30))
+	should.NoError(err)
+	should.Equal(futu.SessionClosed, s.Type)
+
+	s, _ = c.SessionAt(ctx, at(328, 9, 15))
+	should.Equal(futu.SessionOpeningAuction, s.Type)
+	s, _ = c.SessionAt(ctx, at(328, 16, 5))
+	should.Equal(futu.SessionClosingAuction, s.Type)
+
+	// lunch break
+	open, err := c.NextOpen(ctx, at(328, 12, 30))
+	should.NoError(err)
+	should.Equal(at(328, 13, 0), open)
+
+	// over the long weekend
+	open, _ = c.NextOpen(ctx, at(328, 16, 30))
+	should.Equal(at(402, 9, 30), open)
+
+	d, err := c.TradingDuration(ctx, at(328, 11, 0), at(328, 14, 0))
+	should.NoError(err)
+	should.Equal(2*time.Hour, d)
+
+	minutes, _ := c.TradingMinutes(ctx, at(328, 0, 0), at(402, 0, 0))
+	should.Equal(330.0, minutes)
+
+	// half day
+	sessions, err := c.Sessions(ctx, at(1224, 0, 0))
+	should.NoError(err)
+	should.Len(sessions, 3)
+	s, _ = c.SessionAt(ctx, at(1224, 12, 5))
+	should.Equal(futu.SessionClosingAuction, s.Type)
+	s, _ = c.SessionAt(ctx, at(1224, 14, 0))
+	should.Equal(futu.SessionClosed, s.Type)
+
+	// cached by half year
+	should.Len(m.QotRequestTradeDateCalls(), 2)
+}
+
+func TestCalendarUS(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	m := pbtest.NewMock()
+	tradeDates(m, nil, nil)
+
+	c := futu.NewCalendar(m, pb.TradeDateMarket_US)
+	ext := futu.NewCalendar(m, pb.TradeDateMarket_US, futu.WithExtendedHours(true))
+
+	// sunday evening, overnight session of monday
+	sunday := time.Date(2024, 3, 10, 21, 0, 0, 0, futu.LocNewYork)
+	s, err := c.SessionAt(ctx, sunday)
+	should.NoError(err)
+	should.Equal(futu.SessionOvernight, s.Type)
+	should.Equal("2024-03-11", s.TradeDate)
+
+	// regular hours in UTC, after DST starts
+	open, err := c.NextOpen(ctx, sunday)
+	should.NoError(err)
+	should.Equal(time.Date(2024, 3, 11, 13, 30, 0, 0, time.UTC), open.UTC())
+
+	// extended hours continue through the night
+	open, err = ext.NextOpen(ctx, time.Date(2024, 3, 11, 10, 0, 0, 0, futu.LocNewYork))
+	should.NoError(err)
+	should.Equal(time.Date(2024, 3, 15, 20, 0, 0, 0, futu.LocNewYork).AddDate(0, 0, 2), open)
+
+	d, _ := c.TradingDuration(ctx, sunday, sunday.Add(24*time.Hour))
+	should.Equal(390*time.Minute, d)
+	d, _ = ext.TradingDuration(ctx, sunday, sunday.Add(24*time.Hour))
+	should.Equal(24*time.Hour, d)
+
+	jp := futu.NewCalendar(m, pb.TradeDateMarket_JP_Future)
+	_, err = jp.SessionAt(ctx, sunday)
+	should.True(errors.Is(err, futu.ErrNoSessionTable))
+}
@@ -0,0 +1,80 @@
+package futu
+
+import (
+	"fmt"
+
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// OpenD reports its version in InitConnect as major * 100 + minor,
+// eg: 9.4.5408 is reported as 904.
+const (
+	ServerVer_9_4 int32 = 904 // Session fields, Order.session
+)
+
+// capability is a request field that OpenD silently ignores before MinVer.
+type capability struct {
+	ProtoId pb.ProtoId
+	Field   protoreflect.Name
+	MinVer  int32
+}
+
+// capabilities lists request fields with a minimum OpenD version.
+// the versions are taken from the proto comments & futu api changelog.
+//
+// The table is intentionally limited to the session fields: other fields
+// added by newer OpenD are response fields, eg: MaxTrdQtys.longRequiredIM,
+// which older OpenD simply leaves unset, or predate the versions in use.
+var capabilities = []capability{
+	// 美股订单时段
+	{ProtoId: pb.ProtoId_TrdPlaceOrder, Field: "session", MinVer: ServerVer_9_4},
+	{ProtoId: pb.ProtoId_TrdGetMaxTrdQtys, Field: "session", MinVer: ServerVer_9_4},
+	// 美股盘前盘后/夜盘行情
+	{ProtoId: pb.ProtoId_QotSub, Field: "session", MinVer: ServerVer_9_4},
+	{ProtoId: pb.ProtoId_QotRequestHistoryKL, Field: "session", MinVer: ServerVer_9_4},
+}
+
+// FormatServerVer converts a server version to "major.minor", eg: 904 -> "9.4".
+func FormatServerVer(ver int32) string {
+	return fmt.Sprintf("%d.%d", ver/100, ver%100)
+}
+
+// CheckCapability returns ErrUnsupportedField if payload sets a field
+// that requires a newer OpenD than serverVer.
+// A serverVer of 0 means unknown and always passes.
+func CheckCapability(serverVer int32, protoId pb.ProtoId, payload proto.Message) error {
+
+	if serverVer <= 0 || payload == nil {
+		return nil
+	}
+
+	m := payload.ProtoReflect()
+	for _, c := range capabilities {
+		if c.ProtoId != protoId || serverVer >= c.MinVer {
+			continue
+		}
+
+		fd := m.Descriptor().Fields().ByName(c.Field)
+		if fd == nil || !m.Has(fd) {
+			continue
+		}
+
+		return fmt.Errorf("%w: %s.%s requires OpenD >= %s, connected %s",
+			ErrUnsupportedField, m.Descriptor().Name(), c.Field,
+			FormatServerVer(c.MinVer), FormatServerVer(serverVer))
+	}
+
+	return nil
+}
+
+// ServerVer returns the OpenD version negotiated in InitConnect.
+func (client *Client) ServerVer() int32 {
+	return client.serverVer
+}
+
+// SupportsServerVer reports whether the connected OpenD is at least ver.
+func (client *Client) SupportsServerVer(ver int32) bool {
+	return client.serverVer >= ver
+}
@@ -0,0 +1,41 @@
+package futu_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheckCapability(t *testing.T) {
+	should := require.New(t)
+
+	req := &pb.TrdPlaceOrderRequest{
+		Code: proto.String("AAPL"),
+		Qty:  proto.Float64(1),
+	}
+
+	// field not set
+	should.NoError(futu.CheckCapability(900, pb.ProtoId_TrdPlaceOrder, req))
+
+	req.Session = pb.Session_OVERNIGHT.Enum()
+	err := futu.CheckCapability(900, pb.ProtoId_TrdPlaceOrder, req)
+	should.True(errors.Is(err, futu.ErrUnsupportedField))
+	should.Contains(err.Error(), "TrdPlaceOrderRequest.session")
+	should.Contains(err.Error(), "9.4")
+
+	should.NoError(futu.CheckCapability(futu.ServerVer_9_4, pb.ProtoId_TrdPlaceOrder, req))
+
+	// unknown version
+	should.NoError(futu.CheckCapability(0, pb.ProtoId_TrdPlaceOrder, req))
+}
+
+func TestFormatServerVer(t *testing.T) {
+	should := require.New(t)
+
+	should.Equal("9.4", futu.FormatServerVer(904))
+	should.Equal("5.0", futu.FormatServerVer(500))
+}
@@ -23,12 +23,13 @@ import (
 type Client struct {
 	clientOptions
 
-	conn     net.Conn
-	sn       atomic.Uint32  // serial number
-	respChan chan *response // response channel
-	closed   chan struct{}  // indicate the client is closed
-	connID   uint64
-	userID   uint64
+	conn      net.Conn
+	sn        atomic.Uint32  // serial number
+	respChan  chan *response // response channel
+	closed    chan struct{}  // indicate the client is closed
+	connID    uint64
+	userID    uint64
+	serverVer int32
 
 	wgReader sync.WaitGroup
 	wgWorker sync.WaitGroup
@@ -40,6 +41,18 @@ type Client struct {
 	handlers      map[pb.ProtoId]Handler // push notification handlers
 	dispatchMap   map[uint64]*dispatchItem
 	dispatchMutex sync.Mutex
+
+	// typed Notify callbacks, called before the ProtoId_Notify handler
+	notify NotifyDispatcher
+
+	// states updated by Notify pushes
+	stateMutex  sync.Mutex
+	unusableErr error
+	trdLocked   atomic.Bool
+	qotRight    atomic.Pointer[pb.QotRightNotice]
+	statusChan  chan struct{} // closed on status pushes
+
+	trdHeader atomic.Pointer[pb.TrdHeader] // default trade header
 }
 
 // New creates a new client.
@@ -53,6 +66,16 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 	}
 
 	client.respChan = make(chan *response, client.numBuffers)
+	client.trdHeader.Store(client.clientOptions.trdHeader)
+
+	// OpenD has no query of the unlock state, assume locked until
+	// TrdUnlockTrade succeeds on this client
+	client.trdLocked.Store(true)
+
+	client.OnGtwEvent(client.onGtwEvent)
+	client.OnQotRight(client.qotRight.Store)
+	client.OnConnectStatus(func(*pb.ConnectStatusNotice) { client.notifyStatusChanged() })
+	client.OnProgramStatus(func(*pb.ProgramStatus) { client.notifyStatusChanged() })
 
 	var err error
 
@@ -100,6 +123,7 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 
 	client.connID = s2c.GetConnID()
 	client.userID = s2c.GetLoginUserID()
+	client.serverVer = s2c.GetServerVer()
 
 	if client.privateKey != nil {
 		key := []byte(s2c.GetConnAESKey())
@@ -116,6 +140,14 @@ func NewClient(opts ...ClientOption) (*Client, error) {
 		go client.heartbeat(time.Second * time.Duration(interval))
 	}
 
+	// QotRight is pushed only on changes
+	if info, err := client.GetUserInfo(context.TODO(),
+		GetUserInfoWithFlag(int32(pb.UserInfoField_UserInfoField_QotRight))); err != nil {
+		log.Warn().Err(err).Msg("get quote rights error")
+	} else {
+		client.qotRight.CompareAndSwap(nil, qotRightNotice(info))
+	}
+
 	return client, nil
 }
 
@@ -173,6 +205,21 @@ func (client *Client) patchRequest(req pb.Request) {
 	if setter, ok := payload.(pb.PacketIDSetter); ok {
 		setter.SetPacketID(client.nextTradePacketId())
 	}
+
+	// default trade header, if not set
+	if h := client.trdHeader.Load(); h != nil {
+		getter, ok1 := payload.(pb.HeaderGetter)
+		setter, ok2 := payload.(pb.HeaderSetter)
+		if ok1 && ok2 && getter.GetHeader() == nil {
+			setter.SetHeader(proto.Clone(h).(*pb.TrdHeader))
+		}
+	}
+}
+
+// SetTrdHeader sets the default trade header, filled into trade requests
+// without header. nil disables it.
+func (client *Client) SetTrdHeader(h *pb.TrdHeader) {
+	client.trdHeader.Store(h)
 }
 
 func (client *Client) encodeRequest(protoId pb.ProtoId, req pb.Request) (*bytes.Buffer, uint32, error) {
@@ -180,6 +227,13 @@ func (client *Client) encodeRequest(protoId pb.ProtoId, req pb.Request) (*bytes.
 	// fill in required infomation
 	client.patchRequest(req)
 
+	// name the invalid field, rather than a marshal error or server rejection
+	if v, ok := req.GetRequestPayload().(pb.Validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, 0, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+		}
+	}
+
 	body, err := proto.Marshal(req)
 	if err != nil {
 		return nil, 0, err
@@ -226,6 +280,15 @@ func (client *Client) Request(ctx context.Context, protoId pb.ProtoId, req pb.Re
 		err error
 	)
 
+	if err = client.Err(); err != nil {
+		return nil, err
+	}
+
+	// fail early rather than having OpenD ignore the field
+	if err = CheckCapability(client.serverVer, protoId, req.GetRequestPayload()); err != nil {
+		return nil, err
+	}
+
 	// encode
 	if buf, sn, err = client.encodeRequest(protoId, req); err != nil {
 		return nil, err
@@ -265,7 +328,20 @@ func (client *Client) Request(ctx context.Context, protoId pb.ProtoId, req pb.Re
 			return nil, rr.Err
 		}
 
-		return rr.Resp.GetResponsePayload(), ResponseError(protoId, rr.Resp)
+		if err = ResponseError(protoId, rr.Resp); err == nil {
+			client.afterRequest(protoId, req)
+		}
+
+		return rr.Resp.GetResponsePayload(), err
+	}
+}
+
+// afterRequest updates client states after a successful request.
+func (client *Client) afterRequest(protoId pb.ProtoId, req pb.Request) {
+	if protoId == pb.ProtoId_TrdUnlockTrade {
+		if payload, ok := req.GetRequestPayload().(*pb.TrdUnlockTradeRequest); ok {
+			client.trdLocked.Store(!payload.GetUnlock())
+		}
 	}
 }
 
@@ -329,6 +405,10 @@ func (client *Client) respWork(r *response) {
 
 	} else {
 		if r.Err == nil {
+			if msg, ok := r.Resp.GetResponsePayload().(*pb.NotifyResponse); ok {
+				client.notify.DispatchNotify(msg)
+			}
+
 			h := client.getHandler(r.ProtoID)
 			h(r.Resp.GetResponsePayload())
 		} else {
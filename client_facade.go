@@ -0,0 +1,1634 @@
+// Code generated by protoc-gen-go-futu. DO NOT EDIT.
+
+package futu
+
+import (
+	context "context"
+	pb "github.com/santsai/futu-go/pb"
+)
+
+// GetDelayStatisticsOption sets an optional field of pb.GetDelayStatisticsRequest.
+type GetDelayStatisticsOption func(*pb.GetDelayStatisticsRequest)
+
+// GetDelayStatisticsWithTypeList sets typeList.
+// 统计数据类型，DelayStatisticsType
+func GetDelayStatisticsWithTypeList(o ...pb.DelayStatisticsType) GetDelayStatisticsOption {
+	return func(r *pb.GetDelayStatisticsRequest) { r.SetTypeList(o...) }
+}
+
+// GetDelayStatisticsWithPushStage sets qotPushStage.
+// 行情推送统计的区间，行情推送统计时有效，QotPushStage
+func GetDelayStatisticsWithPushStage(o pb.QotPushStage) GetDelayStatisticsOption {
+	return func(r *pb.GetDelayStatisticsRequest) { r.SetPushStage(o) }
+}
+
+// GetDelayStatisticsWithSegmentList sets segmentList.
+// 统计分段，默认100ms以下以2ms分段，100ms以上以500，1000，2000，-1分段，-1表示无穷大。
+func GetDelayStatisticsWithSegmentList(o ...int32) GetDelayStatisticsOption {
+	return func(r *pb.GetDelayStatisticsRequest) { r.SetSegmentList(o...) }
+}
+
+// GetDelayStatistics dispatches pb.GetDelayStatisticsRequest.
+func (client *Client) GetDelayStatistics(ctx context.Context, opts ...GetDelayStatisticsOption) (*pb.GetDelayStatisticsResponse, error) {
+	req := &pb.GetDelayStatisticsRequest{}
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// GetGlobalState dispatches pb.GetGlobalStateRequest.
+func (client *Client) GetGlobalState(ctx context.Context) (*pb.GetGlobalStateResponse, error) {
+	req := &pb.GetGlobalStateRequest{}
+
+	return req.Dispatch(ctx, client)
+}
+
+// GetUserInfoOption sets an optional field of pb.GetUserInfoRequest.
+type GetUserInfoOption func(*pb.GetUserInfoRequest)
+
+// GetUserInfoWithFlag sets flag.
+// UserInfoField集合，不设置默认返回全部信息
+func GetUserInfoWithFlag(o int32) GetUserInfoOption {
+	return func(r *pb.GetUserInfoRequest) { r.SetFlag(o) }
+}
+
+// GetUserInfo dispatches pb.GetUserInfoRequest.
+func (client *Client) GetUserInfo(ctx context.Context, opts ...GetUserInfoOption) (*pb.GetUserInfoResponse, error) {
+	req := &pb.GetUserInfoRequest{}
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// GetBasicQot dispatches pb.QotGetBasicQotRequest.
+func (client *Client) GetBasicQot(ctx context.Context, securityList ...string) ([]*pb.BasicQot, error) {
+	req := &pb.QotGetBasicQotRequest{}
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetBasicQotList(), nil
+}
+
+// GetBroker dispatches pb.QotGetBrokerRequest.
+func (client *Client) GetBroker(ctx context.Context, security string) (*pb.QotGetBrokerResponse, error) {
+	req := &pb.QotGetBrokerRequest{}
+	req.SetSecurity(NewSecurity(security))
+
+	return req.Dispatch(ctx, client)
+}
+
+// GetCapitalDistribution dispatches pb.QotGetCapitalDistributionRequest.
+func (client *Client) GetCapitalDistribution(ctx context.Context, security string) (*pb.QotGetCapitalDistributionResponse, error) {
+	req := &pb.QotGetCapitalDistributionRequest{}
+	req.SetSecurity(NewSecurity(security))
+
+	return req.Dispatch(ctx, client)
+}
+
+// GetCapitalFlowOption sets an optional field of pb.QotGetCapitalFlowRequest.
+type GetCapitalFlowOption func(*pb.QotGetCapitalFlowRequest)
+
+// GetCapitalFlowWithPeriodType sets periodType.
+// PeriodType 周期类型
+func GetCapitalFlowWithPeriodType(o pb.PeriodType) GetCapitalFlowOption {
+	return func(r *pb.QotGetCapitalFlowRequest) { r.SetPeriodType(o) }
+}
+
+// GetCapitalFlowWithBeginTime sets beginTime.
+// 开始时间（格式：yyyy-MM-dd），仅周期类型不为实时有效
+func GetCapitalFlowWithBeginTime(o string) GetCapitalFlowOption {
+	return func(r *pb.QotGetCapitalFlowRequest) { r.SetBeginTime(o) }
+}
+
+// GetCapitalFlowWithEndTime sets endTime.
+// 结束时间（格式：yyyy-MM-dd），仅周期类型不为实时有效
+func GetCapitalFlowWithEndTime(o string) GetCapitalFlowOption {
+	return func(r *pb.QotGetCapitalFlowRequest) { r.SetEndTime(o) }
+}
+
+// GetCapitalFlow dispatches pb.QotGetCapitalFlowRequest.
+func (client *Client) GetCapitalFlow(ctx context.Context, security string, opts ...GetCapitalFlowOption) (*pb.QotGetCapitalFlowResponse, error) {
+	req := &pb.QotGetCapitalFlowRequest{}
+	req.SetSecurity(NewSecurity(security))
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// GetCodeChangeOption sets an optional field of pb.QotGetCodeChangeRequest.
+type GetCodeChangeOption func(*pb.QotGetCodeChangeRequest)
+
+// GetCodeChangeWithPlaceHolder sets placeHolder.
+// 占位
+func GetCodeChangeWithPlaceHolder(o int32) GetCodeChangeOption {
+	return func(r *pb.QotGetCodeChangeRequest) { r.SetPlaceHolder(o) }
+}
+
+// GetCodeChangeWithSecurityList sets securityList.
+// 根据股票筛选
+func GetCodeChangeWithSecurityList(o ...*pb.Security) GetCodeChangeOption {
+	return func(r *pb.QotGetCodeChangeRequest) { r.SetSecurityList(o...) }
+}
+
+// GetCodeChangeWithTimeFilterList sets timeFilterList.
+// 根据时间筛选
+func GetCodeChangeWithTimeFilterList(o ...*pb.TimeFilter) GetCodeChangeOption {
+	return func(r *pb.QotGetCodeChangeRequest) { r.SetTimeFilterList(o...) }
+}
+
+// GetCodeChangeWithTypeList sets typeList.
+// CodeChangeType，根据类型筛选
+func GetCodeChangeWithTypeList(o ...pb.CodeChangeType) GetCodeChangeOption {
+	return func(r *pb.QotGetCodeChangeRequest) { r.SetTypeList(o...) }
+}
+
+// GetCodeChange dispatches pb.QotGetCodeChangeRequest.
+func (client *Client) GetCodeChange(ctx context.Context, opts ...GetCodeChangeOption) ([]*pb.CodeChangeInfo, error) {
+	req := &pb.QotGetCodeChangeRequest{}
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetCodeChangeList(), nil
+}
+
+// GetFutureInfo dispatches pb.QotGetFutureInfoRequest.
+func (client *Client) GetFutureInfo(ctx context.Context, securityList ...string) ([]*pb.FutureInfo, error) {
+	req := &pb.QotGetFutureInfoRequest{}
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetFutureInfoList(), nil
+}
+
+// GetHoldingChangeListOption sets an optional field of pb.QotGetHoldingChangeListRequest.
+type GetHoldingChangeListOption func(*pb.QotGetHoldingChangeListRequest)
+
+// GetHoldingChangeListWithBeginTime sets beginTime.
+// 开始时间，严格按YYYY-MM-DD HH:MM:SS或YYYY-MM-DD HH:MM:SS.MS格式传
+func GetHoldingChangeListWithBeginTime(o string) GetHoldingChangeListOption {
+	return func(r *pb.QotGetHoldingChangeListRequest) { r.SetBeginTime(o) }
+}
+
+// GetHoldingChangeListWithEndTime sets endTime.
+// 结束时间，严格按YYYY-MM-DD HH:MM:SS或YYYY-MM-DD HH:MM:SS.MS格式传
+func GetHoldingChangeListWithEndTime(o string) GetHoldingChangeListOption {
+	return func(r *pb.QotGetHoldingChangeListRequest) { r.SetEndTime(o) }
+}
+
+// GetHoldingChangeList dispatches pb.QotGetHoldingChangeListRequest.
+func (client *Client) GetHoldingChangeList(ctx context.Context, security string, holderCategory pb.HolderCategory, opts ...GetHoldingChangeListOption) ([]*pb.ShareHoldingChange, error) {
+	req := &pb.QotGetHoldingChangeListRequest{}
+	req.SetSecurity(NewSecurity(security))
+	req.SetHolderCategory(holderCategory)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetHoldingChangeList(), nil
+}
+
+// GetIpoList dispatches pb.QotGetIpoListRequest.
+func (client *Client) GetIpoList(ctx context.Context, market pb.QotMarket) ([]*pb.IpoData, error) {
+	req := &pb.QotGetIpoListRequest{}
+	req.SetMarket(market)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetIpoList(), nil
+}
+
+// GetKL dispatches pb.QotGetKLRequest.
+func (client *Client) GetKL(ctx context.Context, rehabType pb.RehabType, klType pb.KLType, security string, reqNum int32) ([]*pb.KLine, error) {
+	req := &pb.QotGetKLRequest{}
+	req.SetRehabType(rehabType)
+	req.SetKlType(klType)
+	req.SetSecurity(NewSecurity(security))
+	req.SetReqNum(reqNum)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetKlList(), nil
+}
+
+// GetMarketState dispatches pb.QotGetMarketStateRequest.
+func (client *Client) GetMarketState(ctx context.Context, securityList ...string) ([]*pb.MarketInfo, error) {
+	req := &pb.QotGetMarketStateRequest{}
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetMarketInfoList(), nil
+}
+
+// GetOptionChainOption sets an optional field of pb.QotGetOptionChainRequest.
+type GetOptionChainOption func(*pb.QotGetOptionChainRequest)
+
+// GetOptionChainWithIndexOptionType sets indexOptionType.
+// Qot_Common.IndexOptionType，指数期权的类型，仅用于恒指国指
+func GetOptionChainWithIndexOptionType(o pb.IndexOptionType) GetOptionChainOption {
+	return func(r *pb.QotGetOptionChainRequest) { r.SetIndexOptionType(o) }
+}
+
+// GetOptionChainWithType sets type.
+// Qot_Common.OptionType，期权类型，可选字段，不指定则表示都返回
+func GetOptionChainWithType(o pb.OptionType) GetOptionChainOption {
+	return func(r *pb.QotGetOptionChainRequest) { r.SetType(o) }
+}
+
+// GetOptionChainWithCondition sets condition.
+// OptionCondType，价内价外，可选字段，不指定则表示都返回
+func GetOptionChainWithCondition(o pb.OptionCondType) GetOptionChainOption {
+	return func(r *pb.QotGetOptionChainRequest) { r.SetCondition(o) }
+}
+
+// GetOptionChainWithDataFilter sets dataFilter.
+// 数据字段筛选
+func GetOptionChainWithDataFilter(o *pb.DataFilter) GetOptionChainOption {
+	return func(r *pb.QotGetOptionChainRequest) { r.SetDataFilter(o) }
+}
+
+// GetOptionChain dispatches pb.QotGetOptionChainRequest.
+func (client *Client) GetOptionChain(ctx context.Context, owner string, beginTime string, endTime string, opts ...GetOptionChainOption) ([]*pb.OptionChain, error) {
+	req := &pb.QotGetOptionChainRequest{}
+	req.SetOwner(NewSecurity(owner))
+	req.SetBeginTime(beginTime)
+	req.SetEndTime(endTime)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetOptionChain(), nil
+}
+
+// GetOptionExpirationDateOption sets an optional field of pb.QotGetOptionExpirationDateRequest.
+type GetOptionExpirationDateOption func(*pb.QotGetOptionExpirationDateRequest)
+
+// GetOptionExpirationDateWithIndexOptionType sets indexOptionType.
+// Qot_Common.IndexOptionType，指数期权的类型，仅用于恒指国指
+func GetOptionExpirationDateWithIndexOptionType(o pb.IndexOptionType) GetOptionExpirationDateOption {
+	return func(r *pb.QotGetOptionExpirationDateRequest) { r.SetIndexOptionType(o) }
+}
+
+// GetOptionExpirationDate dispatches pb.QotGetOptionExpirationDateRequest.
+func (client *Client) GetOptionExpirationDate(ctx context.Context, owner string, opts ...GetOptionExpirationDateOption) ([]*pb.OptionExpirationDate, error) {
+	req := &pb.QotGetOptionExpirationDateRequest{}
+	req.SetOwner(NewSecurity(owner))
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetDateList(), nil
+}
+
+// GetOrderBook dispatches pb.QotGetOrderBookRequest.
+func (client *Client) GetOrderBook(ctx context.Context, security string, num int32) (*pb.QotGetOrderBookResponse, error) {
+	req := &pb.QotGetOrderBookRequest{}
+	req.SetSecurity(NewSecurity(security))
+	req.SetNum(num)
+
+	return req.Dispatch(ctx, client)
+}
+
+// GetOwnerPlate dispatches pb.QotGetOwnerPlateRequest.
+func (client *Client) GetOwnerPlate(ctx context.Context, securityList ...string) ([]*pb.SecurityOwnerPlate, error) {
+	req := &pb.QotGetOwnerPlateRequest{}
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetOwnerPlateList(), nil
+}
+
+// GetPlateSecurityOption sets an optional field of pb.QotGetPlateSecurityRequest.
+type GetPlateSecurityOption func(*pb.QotGetPlateSecurityRequest)
+
+// GetPlateSecurityWithSortField sets sortField.
+// Qot_Common.SortField,根据哪个字段排序,不填默认Code排序
+func GetPlateSecurityWithSortField(o pb.SortField) GetPlateSecurityOption {
+	return func(r *pb.QotGetPlateSecurityRequest) { r.SetSortField(o) }
+}
+
+// GetPlateSecurityWithAscend sets ascend.
+// 升序ture, 降序false, 不填默认升序
+func GetPlateSecurityWithAscend(o bool) GetPlateSecurityOption {
+	return func(r *pb.QotGetPlateSecurityRequest) { r.SetAscend(o) }
+}
+
+// GetPlateSecurity dispatches pb.QotGetPlateSecurityRequest.
+func (client *Client) GetPlateSecurity(ctx context.Context, plate string, opts ...GetPlateSecurityOption) ([]*pb.SecurityStaticInfo, error) {
+	req := &pb.QotGetPlateSecurityRequest{}
+	req.SetPlate(NewSecurity(plate))
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetStaticInfoList(), nil
+}
+
+// GetPlateSet dispatches pb.QotGetPlateSetRequest.
+func (client *Client) GetPlateSet(ctx context.Context, market pb.QotMarket, plateSetType pb.PlateSetType) ([]*pb.PlateInfo, error) {
+	req := &pb.QotGetPlateSetRequest{}
+	req.SetMarket(market)
+	req.SetPlateSetType(plateSetType)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPlateInfoList(), nil
+}
+
+// GetPriceReminderOption sets an optional field of pb.QotGetPriceReminderRequest.
+type GetPriceReminderOption func(*pb.QotGetPriceReminderRequest)
+
+// GetPriceReminderWithSecurity sets security.
+// 查询股票下的到价提醒项，security和market二选一，都存在的情况下security优先。
+func GetPriceReminderWithSecurity(o *pb.Security) GetPriceReminderOption {
+	return func(r *pb.QotGetPriceReminderRequest) { r.SetSecurity(o) }
+}
+
+// GetPriceReminderWithMarket sets market.
+// Qot_Common::QotMarket 市场，查询市场下的到价提醒项，不区分沪深
+func GetPriceReminderWithMarket(o pb.QotMarket) GetPriceReminderOption {
+	return func(r *pb.QotGetPriceReminderRequest) { r.SetMarket(o) }
+}
+
+// GetPriceReminder dispatches pb.QotGetPriceReminderRequest.
+func (client *Client) GetPriceReminder(ctx context.Context, opts ...GetPriceReminderOption) ([]*pb.PriceReminder, error) {
+	req := &pb.QotGetPriceReminderRequest{}
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPriceReminderList(), nil
+}
+
+// GetReference dispatches pb.QotGetReferenceRequest.
+func (client *Client) GetReference(ctx context.Context, security string, referenceType pb.ReferenceType) ([]*pb.SecurityStaticInfo, error) {
+	req := &pb.QotGetReferenceRequest{}
+	req.SetSecurity(NewSecurity(security))
+	req.SetReferenceType(referenceType)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetStaticInfoList(), nil
+}
+
+// GetRT dispatches pb.QotGetRTRequest.
+func (client *Client) GetRT(ctx context.Context, security string) ([]*pb.TimeShare, error) {
+	req := &pb.QotGetRTRequest{}
+	req.SetSecurity(NewSecurity(security))
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRtList(), nil
+}
+
+// GetSecuritySnapshot dispatches pb.QotGetSecuritySnapshotRequest.
+func (client *Client) GetSecuritySnapshot(ctx context.Context, securityList ...string) ([]*pb.Snapshot, error) {
+	req := &pb.QotGetSecuritySnapshotRequest{}
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSnapshotList(), nil
+}
+
+// GetStaticInfoOption sets an optional field of pb.QotGetStaticInfoRequest.
+type GetStaticInfoOption func(*pb.QotGetStaticInfoRequest)
+
+// GetStaticInfoWithMarket sets market.
+// Qot_Common.QotMarket,股票市场
+func GetStaticInfoWithMarket(o pb.QotMarket) GetStaticInfoOption {
+	return func(r *pb.QotGetStaticInfoRequest) { r.SetMarket(o) }
+}
+
+// GetStaticInfoWithSecType sets secType.
+// Qot_Common.SecurityType,股票类型
+func GetStaticInfoWithSecType(o pb.SecurityType) GetStaticInfoOption {
+	return func(r *pb.QotGetStaticInfoRequest) { r.SetSecType(o) }
+}
+
+// GetStaticInfoWithSecurityList sets securityList.
+// 股票，若该字段存在，忽略其他字段，只返回该字段股票的静态信息
+func GetStaticInfoWithSecurityList(o ...*pb.Security) GetStaticInfoOption {
+	return func(r *pb.QotGetStaticInfoRequest) { r.SetSecurityList(o...) }
+}
+
+// GetStaticInfo dispatches pb.QotGetStaticInfoRequest.
+func (client *Client) GetStaticInfo(ctx context.Context, opts ...GetStaticInfoOption) ([]*pb.SecurityStaticInfo, error) {
+	req := &pb.QotGetStaticInfoRequest{}
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetStaticInfoList(), nil
+}
+
+// GetSubInfoOption sets an optional field of pb.QotGetSubInfoRequest.
+type GetSubInfoOption func(*pb.QotGetSubInfoRequest)
+
+// GetSubInfoWithIsReqAllConn sets isReqAllConn.
+// 是否返回所有连接的订阅状态,不传或者传false只返回当前连接数据
+func GetSubInfoWithIsReqAllConn(o bool) GetSubInfoOption {
+	return func(r *pb.QotGetSubInfoRequest) { r.SetIsReqAllConn(o) }
+}
+
+// GetSubInfo dispatches pb.QotGetSubInfoRequest.
+func (client *Client) GetSubInfo(ctx context.Context, opts ...GetSubInfoOption) (*pb.QotGetSubInfoResponse, error) {
+	req := &pb.QotGetSubInfoRequest{}
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// GetSuspend dispatches pb.QotGetSuspendRequest.
+func (client *Client) GetSuspend(ctx context.Context, beginTime string, endTime string, securityList ...string) ([]*pb.SecuritySuspend, error) {
+	req := &pb.QotGetSuspendRequest{}
+	req.SetBeginTime(beginTime)
+	req.SetEndTime(endTime)
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSecuritySuspendList(), nil
+}
+
+// GetTicker dispatches pb.QotGetTickerRequest.
+func (client *Client) GetTicker(ctx context.Context, security string, maxRetNum int32) ([]*pb.Ticker, error) {
+	req := &pb.QotGetTickerRequest{}
+	req.SetSecurity(NewSecurity(security))
+	req.SetMaxRetNum(maxRetNum)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTickerList(), nil
+}
+
+// GetUserSecurity dispatches pb.QotGetUserSecurityRequest.
+func (client *Client) GetUserSecurity(ctx context.Context, groupName string) ([]*pb.SecurityStaticInfo, error) {
+	req := &pb.QotGetUserSecurityRequest{}
+	req.SetGroupName(groupName)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetStaticInfoList(), nil
+}
+
+// GetUserSecurityGroup dispatches pb.QotGetUserSecurityGroupRequest.
+func (client *Client) GetUserSecurityGroup(ctx context.Context, groupType pb.GroupType) ([]*pb.GroupData, error) {
+	req := &pb.QotGetUserSecurityGroupRequest{}
+	req.SetGroupType(groupType)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetGroupList(), nil
+}
+
+// GetWarrantOption sets an optional field of pb.QotGetWarrantRequest.
+type GetWarrantOption func(*pb.QotGetWarrantRequest)
+
+// GetWarrantWithOwner sets owner.
+// 所属正股
+func GetWarrantWithOwner(o *pb.Security) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetOwner(o) }
+}
+
+// GetWarrantWithTypeList sets typeList.
+// Qot_Common.WarrantType，窝轮类型过滤列表
+func GetWarrantWithTypeList(o ...pb.WarrantType) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetTypeList(o...) }
+}
+
+// GetWarrantWithIssuerList sets issuerList.
+// Qot_Common.Issuer，发行人过滤列表
+func GetWarrantWithIssuerList(o ...pb.Issuer) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetIssuerList(o...) }
+}
+
+// GetWarrantWithMaturityTimeMin sets maturityTimeMin.
+// 到期日，到期日范围的开始时间戳
+func GetWarrantWithMaturityTimeMin(o string) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetMaturityTimeMin(o) }
+}
+
+// GetWarrantWithMaturityTimeMax sets maturityTimeMax.
+// 到期日范围的结束时间戳
+func GetWarrantWithMaturityTimeMax(o string) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetMaturityTimeMax(o) }
+}
+
+// GetWarrantWithIpoPeriod sets ipoPeriod.
+// Qot_Common.IpoPeriod，上市日
+func GetWarrantWithIpoPeriod(o pb.IpoPeriod) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetIpoPeriod(o) }
+}
+
+// GetWarrantWithPriceType sets priceType.
+// Qot_Common.PriceType，价内/价外（暂不支持界内证的界内外筛选）
+func GetWarrantWithPriceType(o pb.PriceType) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetPriceType(o) }
+}
+
+// GetWarrantWithStatus sets status.
+// Qot_Common.WarrantStatus，窝轮状态
+func GetWarrantWithStatus(o pb.WarrantStatus) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetStatus(o) }
+}
+
+// GetWarrantWithCurPriceMin sets curPriceMin.
+// 最新价的过滤下限（闭区间），不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithCurPriceMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetCurPriceMin(o) }
+}
+
+// GetWarrantWithCurPriceMax sets curPriceMax.
+// 最新价的过滤上限（闭区间），不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithCurPriceMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetCurPriceMax(o) }
+}
+
+// GetWarrantWithStrikePriceMin sets strikePriceMin.
+// 行使价的过滤下限（闭区间），不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithStrikePriceMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetStrikePriceMin(o) }
+}
+
+// GetWarrantWithStrikePriceMax sets strikePriceMax.
+// 行使价的过滤上限（闭区间），不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithStrikePriceMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetStrikePriceMax(o) }
+}
+
+// GetWarrantWithStreetMin sets streetMin.
+// 街货占比的过滤下限（闭区间），该字段为百分比字段，默认不展示 %，如 20 实际对应 20%。不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithStreetMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetStreetMin(o) }
+}
+
+// GetWarrantWithStreetMax sets streetMax.
+// 街货占比的过滤上限（闭区间），该字段为百分比字段，默认不展示 %，如 20 实际对应 20%。不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithStreetMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetStreetMax(o) }
+}
+
+// GetWarrantWithConversionMin sets conversionMin.
+// 换股比率的过滤下限（闭区间），不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithConversionMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetConversionMin(o) }
+}
+
+// GetWarrantWithConversionMax sets conversionMax.
+// 换股比率的过滤上限（闭区间），不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithConversionMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetConversionMax(o) }
+}
+
+// GetWarrantWithVolMin sets volMin.
+// 成交量的过滤下限（闭区间），不传代表下限为 -∞
+func GetWarrantWithVolMin(o uint64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetVolMin(o) }
+}
+
+// GetWarrantWithVolMax sets volMax.
+// 成交量的过滤上限（闭区间），不传代表上限为 +∞
+func GetWarrantWithVolMax(o uint64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetVolMax(o) }
+}
+
+// GetWarrantWithPremiumMin sets premiumMin.
+// 溢价的过滤下限（闭区间），该字段为百分比字段，默认不展示 %，如 20 实际对应 20%。不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithPremiumMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetPremiumMin(o) }
+}
+
+// GetWarrantWithPremiumMax sets premiumMax.
+// 溢价的过滤上限（闭区间），该字段为百分比字段，默认不展示 %，如 20 实际对应 20%。不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithPremiumMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetPremiumMax(o) }
+}
+
+// GetWarrantWithLeverageRatioMin sets leverageRatioMin.
+// 杠杆比率的过滤下限（闭区间），不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithLeverageRatioMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetLeverageRatioMin(o) }
+}
+
+// GetWarrantWithLeverageRatioMax sets leverageRatioMax.
+// 杠杆比率的过滤上限（闭区间），不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithLeverageRatioMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetLeverageRatioMax(o) }
+}
+
+// GetWarrantWithDeltaMin sets deltaMin.
+// 对冲值的过滤下限（闭区间），仅认购认沽支持此字段过滤，不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithDeltaMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetDeltaMin(o) }
+}
+
+// GetWarrantWithDeltaMax sets deltaMax.
+// 对冲值的过滤上限（闭区间），仅认购认沽支持此字段过滤，不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithDeltaMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetDeltaMax(o) }
+}
+
+// GetWarrantWithImpliedMin sets impliedMin.
+// 引伸波幅的过滤下限（闭区间），仅认购认沽支持此字段过滤，不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithImpliedMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetImpliedMin(o) }
+}
+
+// GetWarrantWithImpliedMax sets impliedMax.
+// 引伸波幅的过滤上限（闭区间），仅认购认沽支持此字段过滤，不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithImpliedMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetImpliedMax(o) }
+}
+
+// GetWarrantWithRecoveryPriceMin sets recoveryPriceMin.
+// 收回价的过滤下限（闭区间），仅牛熊证支持此字段过滤，不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithRecoveryPriceMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetRecoveryPriceMin(o) }
+}
+
+// GetWarrantWithRecoveryPriceMax sets recoveryPriceMax.
+// 收回价的过滤上限（闭区间），仅牛熊证支持此字段过滤，不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithRecoveryPriceMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetRecoveryPriceMax(o) }
+}
+
+// GetWarrantWithPriceRecoveryRatioMin sets priceRecoveryRatioMin.
+// 正股距收回价，的过滤下限（闭区间），仅牛熊证支持此字段过滤。该字段为百分比字段，默认不展示 %，如 20 实际对应 20%。不传代表下限为 -∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithPriceRecoveryRatioMin(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetPriceRecoveryRatioMin(o) }
+}
+
+// GetWarrantWithPriceRecoveryRatioMax sets priceRecoveryRatioMax.
+// 正股距收回价，的过滤上限（闭区间），仅牛熊证支持此字段过滤。该字段为百分比字段，默认不展示 %，如 20 实际对应 20%。不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
+func GetWarrantWithPriceRecoveryRatioMax(o float64) GetWarrantOption {
+	return func(r *pb.QotGetWarrantRequest) { r.SetPriceRecoveryRatioMax(o) }
+}
+
+// GetWarrant dispatches pb.QotGetWarrantRequest.
+func (client *Client) GetWarrant(ctx context.Context, begin int32, num int32, sortField pb.SortField, ascend bool, opts ...GetWarrantOption) (*pb.QotGetWarrantResponse, error) {
+	req := &pb.QotGetWarrantRequest{}
+	req.SetBegin(begin)
+	req.SetNum(num)
+	req.SetSortField(sortField)
+	req.SetAscend(ascend)
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// ModifyUserSecurity dispatches pb.QotModifyUserSecurityRequest.
+func (client *Client) ModifyUserSecurity(ctx context.Context, groupName string, op pb.ModifyUserSecurityOp, securityList ...string) error {
+	req := &pb.QotModifyUserSecurityRequest{}
+	req.SetGroupName(groupName)
+	req.SetOp(op)
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+
+	_, err := req.Dispatch(ctx, client)
+	return err
+}
+
+// RegQotPushOption sets an optional field of pb.QotRegQotPushRequest.
+type RegQotPushOption func(*pb.QotRegQotPushRequest)
+
+// RegQotPushWithRehabTypeList sets rehabTypeList.
+// Qot_Common.RehabType,复权类型,注册K线类型才生效,其他订阅类型忽略该参数,注册K线时该参数不指定默认前复权
+func RegQotPushWithRehabTypeList(o ...pb.RehabType) RegQotPushOption {
+	return func(r *pb.QotRegQotPushRequest) { r.SetRehabTypeList(o...) }
+}
+
+// RegQotPushWithIsFirstPush sets isFirstPush.
+// 注册后如果本地已有数据是否首推一次已存在数据,该参数不指定则默认true
+func RegQotPushWithIsFirstPush(o bool) RegQotPushOption {
+	return func(r *pb.QotRegQotPushRequest) { r.SetIsFirstPush(o) }
+}
+
+// RegQotPush dispatches pb.QotRegQotPushRequest.
+func (client *Client) RegQotPush(ctx context.Context, isRegOrUnReg bool, subTypeList []pb.SubType, securityList []string, opts ...RegQotPushOption) error {
+	req := &pb.QotRegQotPushRequest{}
+	req.SetIsRegOrUnReg(isRegOrUnReg)
+	req.SetSubTypeList(subTypeList...)
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+	for _, o := range opts {
+		o(req)
+	}
+
+	_, err := req.Dispatch(ctx, client)
+	return err
+}
+
+// RequestHistoryKLOption sets an optional field of pb.QotRequestHistoryKLRequest.
+type RequestHistoryKLOption func(*pb.QotRequestHistoryKLRequest)
+
+// RequestHistoryKLWithMaxAckKLNum sets maxAckKLNum.
+// 最多返回多少根K线，如果未指定表示不限制
+func RequestHistoryKLWithMaxAckKLNum(o int32) RequestHistoryKLOption {
+	return func(r *pb.QotRequestHistoryKLRequest) { r.SetMaxAckKLNum(o) }
+}
+
+// RequestHistoryKLWithNeedKLFieldsFlag sets needKLFieldsFlag.
+// 指定返回K线结构体特定某几项数据，KLFields枚举值或组合，如果未指定返回全部字段
+func RequestHistoryKLWithNeedKLFieldsFlag(o int64) RequestHistoryKLOption {
+	return func(r *pb.QotRequestHistoryKLRequest) { r.SetNeedKLFieldsFlag(o) }
+}
+
+// RequestHistoryKLWithNextReqKey sets nextReqKey.
+// 分页请求key
+func RequestHistoryKLWithNextReqKey(o []byte) RequestHistoryKLOption {
+	return func(r *pb.QotRequestHistoryKLRequest) { r.SetNextReqKey(o) }
+}
+
+// RequestHistoryKLWithExtendedTime sets extendedTime.
+// 是否获取美股盘前盘后数据，仅支持 60 分钟及以下级别
+func RequestHistoryKLWithExtendedTime(o bool) RequestHistoryKLOption {
+	return func(r *pb.QotRequestHistoryKLRequest) { r.SetExtendedTime(o) }
+}
+
+// RequestHistoryKLWithSession sets session.
+// Qot_Common.Session, 美股盘前盘后数据，仅支持 60 分钟及以下级别
+func RequestHistoryKLWithSession(o pb.Session) RequestHistoryKLOption {
+	return func(r *pb.QotRequestHistoryKLRequest) { r.SetSession(o) }
+}
+
+// RequestHistoryKL dispatches pb.QotRequestHistoryKLRequest.
+func (client *Client) RequestHistoryKL(ctx context.Context, rehabType pb.RehabType, klType pb.KLType, security string, beginTime string, endTime string, opts ...RequestHistoryKLOption) (*pb.QotRequestHistoryKLResponse, error) {
+	req := &pb.QotRequestHistoryKLRequest{}
+	req.SetRehabType(rehabType)
+	req.SetKlType(klType)
+	req.SetSecurity(NewSecurity(security))
+	req.SetBeginTime(beginTime)
+	req.SetEndTime(endTime)
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// RequestHistoryKLQuotaOption sets an optional field of pb.QotRequestHistoryKLQuotaRequest.
+type RequestHistoryKLQuotaOption func(*pb.QotRequestHistoryKLQuotaRequest)
+
+// RequestHistoryKLQuotaWithBGetDetail sets bGetDetail.
+// 是否返回详细拉取过的历史纪录
+func RequestHistoryKLQuotaWithBGetDetail(o bool) RequestHistoryKLQuotaOption {
+	return func(r *pb.QotRequestHistoryKLQuotaRequest) { r.SetBGetDetail(o) }
+}
+
+// RequestHistoryKLQuota dispatches pb.QotRequestHistoryKLQuotaRequest.
+func (client *Client) RequestHistoryKLQuota(ctx context.Context, opts ...RequestHistoryKLQuotaOption) (*pb.QotRequestHistoryKLQuotaResponse, error) {
+	req := &pb.QotRequestHistoryKLQuotaRequest{}
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// RequestRehab dispatches pb.QotRequestRehabRequest.
+func (client *Client) RequestRehab(ctx context.Context, security string) ([]*pb.Rehab, error) {
+	req := &pb.QotRequestRehabRequest{}
+	req.SetSecurity(NewSecurity(security))
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRehabList(), nil
+}
+
+// RequestTradeDateOption sets an optional field of pb.QotRequestTradeDateRequest.
+type RequestTradeDateOption func(*pb.QotRequestTradeDateRequest)
+
+// RequestTradeDateWithSecurity sets security.
+// 指定标的
+func RequestTradeDateWithSecurity(o *pb.Security) RequestTradeDateOption {
+	return func(r *pb.QotRequestTradeDateRequest) { r.SetSecurity(o) }
+}
+
+// RequestTradeDate dispatches pb.QotRequestTradeDateRequest.
+func (client *Client) RequestTradeDate(ctx context.Context, market pb.TradeDateMarket, beginTime string, endTime string, opts ...RequestTradeDateOption) ([]*pb.TradeDate, error) {
+	req := &pb.QotRequestTradeDateRequest{}
+	req.SetMarket(market)
+	req.SetBeginTime(beginTime)
+	req.SetEndTime(endTime)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTradeDateList(), nil
+}
+
+// SetPriceReminderOption sets an optional field of pb.QotSetPriceReminderRequest.
+type SetPriceReminderOption func(*pb.QotSetPriceReminderRequest)
+
+// SetPriceReminderWithKey sets key.
+// 到价提醒的标识，GetPriceReminder协议可获得，用于指定要操作的到价提醒项，对于新增的情况不需要填
+func SetPriceReminderWithKey(o int64) SetPriceReminderOption {
+	return func(r *pb.QotSetPriceReminderRequest) { r.SetKey(o) }
+}
+
+// SetPriceReminderWithType sets type.
+// Qot_Common::PriceReminderType，提醒类型，删除、启用、禁用的情况下会忽略该字段
+func SetPriceReminderWithType(o pb.PriceReminderType) SetPriceReminderOption {
+	return func(r *pb.QotSetPriceReminderRequest) { r.SetType(o) }
+}
+
+// SetPriceReminderWithFreq sets freq.
+// Qot_Common::PriceReminderFreq，提醒频率类型，删除、启用、禁用的情况下会忽略该字段
+func SetPriceReminderWithFreq(o pb.PriceReminderFreq) SetPriceReminderOption {
+	return func(r *pb.QotSetPriceReminderRequest) { r.SetFreq(o) }
+}
+
+// SetPriceReminderWithValue sets value.
+// 提醒值，删除、启用、禁用的情况下会忽略该字段（精确到小数点后 3 位，超出部分会被舍弃）
+func SetPriceReminderWithValue(o float64) SetPriceReminderOption {
+	return func(r *pb.QotSetPriceReminderRequest) { r.SetValue(o) }
+}
+
+// SetPriceReminderWithNote sets note.
+// 用户设置到价提醒时的标注，仅支持 20 个以内的中文字符，删除、启用、禁用的情况下会忽略该字段
+func SetPriceReminderWithNote(o string) SetPriceReminderOption {
+	return func(r *pb.QotSetPriceReminderRequest) { r.SetNote(o) }
+}
+
+// SetPriceReminderWithReminderSessionList sets reminderSessionList.
+// 到价提醒的时段列表，删除、启用、禁用的情况下会忽略该入参,枚举参考Qot_Common::PriceReminderMarketStatus
+func SetPriceReminderWithReminderSessionList(o ...pb.PriceReminderMarketStatus) SetPriceReminderOption {
+	return func(r *pb.QotSetPriceReminderRequest) { r.SetReminderSessionList(o...) }
+}
+
+// SetPriceReminder dispatches pb.QotSetPriceReminderRequest.
+func (client *Client) SetPriceReminder(ctx context.Context, security string, op pb.SetPriceReminderOp, opts ...SetPriceReminderOption) (*pb.QotSetPriceReminderResponse, error) {
+	req := &pb.QotSetPriceReminderRequest{}
+	req.SetSecurity(NewSecurity(security))
+	req.SetOp(op)
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// StockFilterOption sets an optional field of pb.QotStockFilterRequest.
+type StockFilterOption func(*pb.QotStockFilterRequest)
+
+// StockFilterWithPlate sets plate.
+// 板块
+func StockFilterWithPlate(o *pb.Security) StockFilterOption {
+	return func(r *pb.QotStockFilterRequest) { r.SetPlate(o) }
+}
+
+// StockFilterWithBaseFilterList sets baseFilterList.
+// 简单指标过滤器
+func StockFilterWithBaseFilterList(o ...*pb.BaseFilter) StockFilterOption {
+	return func(r *pb.QotStockFilterRequest) { r.SetBaseFilterList(o...) }
+}
+
+// StockFilterWithAccumulateFilterList sets accumulateFilterList.
+// 累积指标过滤器
+func StockFilterWithAccumulateFilterList(o ...*pb.AccumulateFilter) StockFilterOption {
+	return func(r *pb.QotStockFilterRequest) { r.SetAccumulateFilterList(o...) }
+}
+
+// StockFilterWithFinancialFilterList sets financialFilterList.
+// 财务指标过滤器
+func StockFilterWithFinancialFilterList(o ...*pb.FinancialFilter) StockFilterOption {
+	return func(r *pb.QotStockFilterRequest) { r.SetFinancialFilterList(o...) }
+}
+
+// StockFilterWithPatternFilterList sets patternFilterList.
+// 形态技术指标过滤器
+func StockFilterWithPatternFilterList(o ...*pb.PatternFilter) StockFilterOption {
+	return func(r *pb.QotStockFilterRequest) { r.SetPatternFilterList(o...) }
+}
+
+// StockFilterWithCustomIndicatorFilterList sets customIndicatorFilterList.
+// 自定义技术指标过滤器
+func StockFilterWithCustomIndicatorFilterList(o ...*pb.CustomIndicatorFilter) StockFilterOption {
+	return func(r *pb.QotStockFilterRequest) { r.SetCustomIndicatorFilterList(o...) }
+}
+
+// StockFilter dispatches pb.QotStockFilterRequest.
+func (client *Client) StockFilter(ctx context.Context, begin int32, num int32, market pb.QotMarket, opts ...StockFilterOption) (*pb.QotStockFilterResponse, error) {
+	req := &pb.QotStockFilterRequest{}
+	req.SetBegin(begin)
+	req.SetNum(num)
+	req.SetMarket(market)
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// SubOption sets an optional field of pb.QotSubRequest.
+type SubOption func(*pb.QotSubRequest)
+
+// SubWithIsRegOrUnRegPush sets isRegOrUnRegPush.
+// 是否注册或反注册该连接上面行情的推送,该参数不指定不做注册反注册操作
+func SubWithIsRegOrUnRegPush(o bool) SubOption {
+	return func(r *pb.QotSubRequest) { r.SetIsRegOrUnRegPush(o) }
+}
+
+// SubWithRegPushRehabTypeList sets regPushRehabTypeList.
+// Qot_Common.RehabType,复权类型,注册推送并且是K线类型才生效,其他订阅类型忽略该参数,注册K线推送时该参数不指定默认前复权
+func SubWithRegPushRehabTypeList(o ...pb.RehabType) SubOption {
+	return func(r *pb.QotSubRequest) { r.SetRegPushRehabTypeList(o...) }
+}
+
+// SubWithIsFirstPush sets isFirstPush.
+// 注册后如果本地已有数据是否首推一次已存在数据,该参数不指定则默认true
+func SubWithIsFirstPush(o bool) SubOption {
+	return func(r *pb.QotSubRequest) { r.SetIsFirstPush(o) }
+}
+
+// SubWithIsUnsubAll sets isUnsubAll.
+// 当被设置为True时忽略其他参数，取消当前连接的所有订阅，并且反注册推送。
+func SubWithIsUnsubAll(o bool) SubOption {
+	return func(r *pb.QotSubRequest) { r.SetIsUnsubAll(o) }
+}
+
+// SubWithIsSubOrderBookDetail sets isSubOrderBookDetail.
+// 订阅摆盘可用,是否订阅摆盘明细,仅支持SF行情,该参数不指定则默认false
+func SubWithIsSubOrderBookDetail(o bool) SubOption {
+	return func(r *pb.QotSubRequest) { r.SetIsSubOrderBookDetail(o) }
+}
+
+// SubWithExtendedTime sets extendedTime.
+// 是否允许美股盘前盘后数据（仅用于订阅美股的实时K线、实时分时、实时逐笔）
+func SubWithExtendedTime(o bool) SubOption {
+	return func(r *pb.QotSubRequest) { r.SetExtendedTime(o) }
+}
+
+// SubWithSession sets session.
+// 时段 Session
+func SubWithSession(o pb.Session) SubOption {
+	return func(r *pb.QotSubRequest) { r.SetSession(o) }
+}
+
+// Sub dispatches pb.QotSubRequest.
+func (client *Client) Sub(ctx context.Context, isSubOrUnSub bool, subTypeList []pb.SubType, securityList []string, opts ...SubOption) error {
+	req := &pb.QotSubRequest{}
+	req.SetIsSubOrUnSub(isSubOrUnSub)
+	req.SetSubTypeList(subTypeList...)
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+	for _, o := range opts {
+		o(req)
+	}
+
+	_, err := req.Dispatch(ctx, client)
+	return err
+}
+
+// TestCmdOption sets an optional field of pb.TestCmdRequest.
+type TestCmdOption func(*pb.TestCmdRequest)
+
+// TestCmdWithParams sets params.
+func TestCmdWithParams(o string) TestCmdOption {
+	return func(r *pb.TestCmdRequest) { r.SetParams(o) }
+}
+
+// TestCmd dispatches pb.TestCmdRequest.
+func (client *Client) TestCmd(ctx context.Context, cmd string, opts ...TestCmdOption) (*pb.TestCmdResponse, error) {
+	req := &pb.TestCmdRequest{}
+	req.SetCmd(cmd)
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// FlowSummaryOption sets an optional field of pb.TrdFlowSummaryRequest.
+type FlowSummaryOption func(*pb.TrdFlowSummaryRequest)
+
+// FlowSummaryWithCashFlowDirection sets cashFlowDirection.
+// 现金流方向 TrdCashFlowDirection
+func FlowSummaryWithCashFlowDirection(o pb.TrdCashFlowDirection) FlowSummaryOption {
+	return func(r *pb.TrdFlowSummaryRequest) { r.SetCashFlowDirection(o) }
+}
+
+// FlowSummary dispatches pb.TrdFlowSummaryRequest.
+func (client *Client) FlowSummary(ctx context.Context, header *pb.TrdHeader, clearingDate string, opts ...FlowSummaryOption) ([]*pb.FlowSummaryInfo, error) {
+	req := &pb.TrdFlowSummaryRequest{}
+	req.SetHeader(header)
+	req.SetClearingDate(clearingDate)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetFlowSummaryInfoList(), nil
+}
+
+// GetAccListOption sets an optional field of pb.TrdGetAccListRequest.
+type GetAccListOption func(*pb.TrdGetAccListRequest)
+
+// GetAccListWithCategory sets trdCategory.
+// 交易品类，参考 TrdCategory
+func GetAccListWithCategory(o pb.TrdCategory) GetAccListOption {
+	return func(r *pb.TrdGetAccListRequest) { r.SetCategory(o) }
+}
+
+// GetAccListWithNeedGeneralSecAccount sets needGeneralSecAccount.
+// 是否返回综合账户 （适用于 HK/US/SG/AU 综合账户体系）
+func GetAccListWithNeedGeneralSecAccount(o bool) GetAccListOption {
+	return func(r *pb.TrdGetAccListRequest) { r.SetNeedGeneralSecAccount(o) }
+}
+
+// GetAccList dispatches pb.TrdGetAccListRequest.
+func (client *Client) GetAccList(ctx context.Context, opts ...GetAccListOption) ([]*pb.TrdAcc, error) {
+	req := &pb.TrdGetAccListRequest{}
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetAccList(), nil
+}
+
+// GetFundsOption sets an optional field of pb.TrdGetFundsRequest.
+type GetFundsOption func(*pb.TrdGetFundsRequest)
+
+// GetFundsWithRefreshCache sets refreshCache.
+// 立即刷新OpenD缓存的此数据，默认不填。true向服务器获取最新数据更新缓存并返回；flase或没填则返回OpenD缓存的数据，不会向服务器请求。
+func GetFundsWithRefreshCache(o bool) GetFundsOption {
+	return func(r *pb.TrdGetFundsRequest) { r.SetRefreshCache(o) }
+}
+
+// GetFundsWithCurrency sets currency.
+// 货币种类，参见Trd_Common.Currency。期货账户必填，其它账户忽略
+func GetFundsWithCurrency(o pb.Currency) GetFundsOption {
+	return func(r *pb.TrdGetFundsRequest) { r.SetCurrency(o) }
+}
+
+// GetFunds dispatches pb.TrdGetFundsRequest.
+func (client *Client) GetFunds(ctx context.Context, header *pb.TrdHeader, opts ...GetFundsOption) (*pb.Funds, error) {
+	req := &pb.TrdGetFundsRequest{}
+	req.SetHeader(header)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetFunds(), nil
+}
+
+// GetHistoryOrderFillList dispatches pb.TrdGetHistoryOrderFillListRequest.
+func (client *Client) GetHistoryOrderFillList(ctx context.Context, header *pb.TrdHeader, filterConditions *pb.TrdFilterConditions) ([]*pb.OrderFill, error) {
+	req := &pb.TrdGetHistoryOrderFillListRequest{}
+	req.SetHeader(header)
+	req.SetFilterConditions(filterConditions)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetOrderFillList(), nil
+}
+
+// GetHistoryOrderListOption sets an optional field of pb.TrdGetHistoryOrderListRequest.
+type GetHistoryOrderListOption func(*pb.TrdGetHistoryOrderListRequest)
+
+// GetHistoryOrderListWithFilterStatusList sets filterStatusList.
+// 需要过滤的订单状态列表
+func GetHistoryOrderListWithFilterStatusList(o ...pb.OrderStatus) GetHistoryOrderListOption {
+	return func(r *pb.TrdGetHistoryOrderListRequest) { r.SetFilterStatusList(o...) }
+}
+
+// GetHistoryOrderList dispatches pb.TrdGetHistoryOrderListRequest.
+func (client *Client) GetHistoryOrderList(ctx context.Context, header *pb.TrdHeader, filterConditions *pb.TrdFilterConditions, opts ...GetHistoryOrderListOption) ([]*pb.Order, error) {
+	req := &pb.TrdGetHistoryOrderListRequest{}
+	req.SetHeader(header)
+	req.SetFilterConditions(filterConditions)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetOrderList(), nil
+}
+
+// GetMarginRatio dispatches pb.TrdGetMarginRatioRequest.
+func (client *Client) GetMarginRatio(ctx context.Context, header *pb.TrdHeader, securityList ...string) ([]*pb.MarginRatioInfo, error) {
+	req := &pb.TrdGetMarginRatioRequest{}
+	req.SetHeader(header)
+	req.SetSecurityList(NewSecurityList(securityList...)...)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetMarginRatioInfoList(), nil
+}
+
+// GetMaxTrdQtysOption sets an optional field of pb.TrdGetMaxTrdQtysRequest.
+type GetMaxTrdQtysOption func(*pb.TrdGetMaxTrdQtysRequest)
+
+// GetMaxTrdQtysWithOrderID sets orderID.
+// 订单号，新下订单不需要，如果是修改订单就需要把原订单号带上才行，因为改单的最大买卖数量会包含原订单数量。
+func GetMaxTrdQtysWithOrderID(o uint64) GetMaxTrdQtysOption {
+	return func(r *pb.TrdGetMaxTrdQtysRequest) { r.SetOrderID(o) }
+}
+
+// GetMaxTrdQtysWithAdjustPrice sets adjustPrice.
+// 是否调整价格，如果价格不合法，是否调整到合法价位，true调整，false不调整
+func GetMaxTrdQtysWithAdjustPrice(o bool) GetMaxTrdQtysOption {
+	return func(r *pb.TrdGetMaxTrdQtysRequest) { r.SetAdjustPrice(o) }
+}
+
+// GetMaxTrdQtysWithAdjustSideAndLimit sets adjustSideAndLimit.
+// 调整方向和调整幅度百分比限制，正数代表向上调整，负数代表向下调整，具体值代表调整幅度限制，如：0.015代表向上调整且幅度不超过1.5%；-0.01代表向下调整且幅度不超过1%
+func GetMaxTrdQtysWithAdjustSideAndLimit(o float64) GetMaxTrdQtysOption {
+	return func(r *pb.TrdGetMaxTrdQtysRequest) { r.SetAdjustSideAndLimit(o) }
+}
+
+// GetMaxTrdQtysWithSecMarket sets secMarket.
+// 证券所属市场，参见TrdSecMarket的枚举定义
+func GetMaxTrdQtysWithSecMarket(o pb.TrdSecMarket) GetMaxTrdQtysOption {
+	return func(r *pb.TrdGetMaxTrdQtysRequest) { r.SetSecMarket(o) }
+}
+
+// GetMaxTrdQtysWithOrderIDEx sets orderIDEx.
+// 表示服务器订单id，可以用来代替orderID，和orderID二选一
+func GetMaxTrdQtysWithOrderIDEx(o string) GetMaxTrdQtysOption {
+	return func(r *pb.TrdGetMaxTrdQtysRequest) { r.SetOrderIDEx(o) }
+}
+
+// GetMaxTrdQtysWithSession sets session.
+// 美股订单时段, 参见Common.Session的枚举定义
+func GetMaxTrdQtysWithSession(o pb.Session) GetMaxTrdQtysOption {
+	return func(r *pb.TrdGetMaxTrdQtysRequest) { r.SetSession(o) }
+}
+
+// GetMaxTrdQtys dispatches pb.TrdGetMaxTrdQtysRequest.
+func (client *Client) GetMaxTrdQtys(ctx context.Context, header *pb.TrdHeader, orderType pb.OrderType, code string, price float64, opts ...GetMaxTrdQtysOption) (*pb.MaxTrdQtys, error) {
+	req := &pb.TrdGetMaxTrdQtysRequest{}
+	req.SetHeader(header)
+	req.SetOrderType(orderType)
+	req.SetCode(code)
+	req.SetPrice(price)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetMaxTrdQtys(), nil
+}
+
+// GetOrderFee dispatches pb.TrdGetOrderFeeRequest.
+func (client *Client) GetOrderFee(ctx context.Context, header *pb.TrdHeader, orderIdExList ...string) ([]*pb.OrderFee, error) {
+	req := &pb.TrdGetOrderFeeRequest{}
+	req.SetHeader(header)
+	req.SetOrderIdExList(orderIdExList...)
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetOrderFeeList(), nil
+}
+
+// GetOrderFillListOption sets an optional field of pb.TrdGetOrderFillListRequest.
+type GetOrderFillListOption func(*pb.TrdGetOrderFillListRequest)
+
+// GetOrderFillListWithFilterConditions sets filterConditions.
+// 过滤条件
+func GetOrderFillListWithFilterConditions(o *pb.TrdFilterConditions) GetOrderFillListOption {
+	return func(r *pb.TrdGetOrderFillListRequest) { r.SetFilterConditions(o) }
+}
+
+// GetOrderFillListWithRefreshCache sets refreshCache.
+// 立即刷新OpenD缓存的此数据，默认不填。true向服务器获取最新数据更新缓存并返回；flase或没填则返回OpenD缓存的数据，不会向服务器请求。
+func GetOrderFillListWithRefreshCache(o bool) GetOrderFillListOption {
+	return func(r *pb.TrdGetOrderFillListRequest) { r.SetRefreshCache(o) }
+}
+
+// GetOrderFillList dispatches pb.TrdGetOrderFillListRequest.
+func (client *Client) GetOrderFillList(ctx context.Context, header *pb.TrdHeader, opts ...GetOrderFillListOption) ([]*pb.OrderFill, error) {
+	req := &pb.TrdGetOrderFillListRequest{}
+	req.SetHeader(header)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetOrderFillList(), nil
+}
+
+// GetOrderListOption sets an optional field of pb.TrdGetOrderListRequest.
+type GetOrderListOption func(*pb.TrdGetOrderListRequest)
+
+// GetOrderListWithFilterConditions sets filterConditions.
+// 过滤条件
+func GetOrderListWithFilterConditions(o *pb.TrdFilterConditions) GetOrderListOption {
+	return func(r *pb.TrdGetOrderListRequest) { r.SetFilterConditions(o) }
+}
+
+// GetOrderListWithFilterStatusList sets filterStatusList.
+// 需要过滤的订单状态列表
+func GetOrderListWithFilterStatusList(o ...pb.OrderStatus) GetOrderListOption {
+	return func(r *pb.TrdGetOrderListRequest) { r.SetFilterStatusList(o...) }
+}
+
+// GetOrderListWithRefreshCache sets refreshCache.
+// 立即刷新OpenD缓存的此数据，默认不填。true向服务器获取最新数据更新缓存并返回；flase或没填则返回OpenD缓存的数据，不会向服务器请求。
+func GetOrderListWithRefreshCache(o bool) GetOrderListOption {
+	return func(r *pb.TrdGetOrderListRequest) { r.SetRefreshCache(o) }
+}
+
+// GetOrderList dispatches pb.TrdGetOrderListRequest.
+func (client *Client) GetOrderList(ctx context.Context, header *pb.TrdHeader, opts ...GetOrderListOption) ([]*pb.Order, error) {
+	req := &pb.TrdGetOrderListRequest{}
+	req.SetHeader(header)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetOrderList(), nil
+}
+
+// GetPositionListOption sets an optional field of pb.TrdGetPositionListRequest.
+type GetPositionListOption func(*pb.TrdGetPositionListRequest)
+
+// GetPositionListWithFilterConditions sets filterConditions.
+// 过滤条件
+func GetPositionListWithFilterConditions(o *pb.TrdFilterConditions) GetPositionListOption {
+	return func(r *pb.TrdGetPositionListRequest) { r.SetFilterConditions(o) }
+}
+
+// GetPositionListWithFilterPLRatioMin sets filterPLRatioMin.
+// 过滤盈亏百分比下限，高于此比例的会返回，比如传10.0，返回盈亏比例大于10%的持仓
+func GetPositionListWithFilterPLRatioMin(o float64) GetPositionListOption {
+	return func(r *pb.TrdGetPositionListRequest) { r.SetFilterPLRatioMin(o) }
+}
+
+// GetPositionListWithFilterPLRatioMax sets filterPLRatioMax.
+// 过滤盈亏百分比上限，低于此比例的会返回，比如传20.0，返回盈亏比例小于20%的持仓
+func GetPositionListWithFilterPLRatioMax(o float64) GetPositionListOption {
+	return func(r *pb.TrdGetPositionListRequest) { r.SetFilterPLRatioMax(o) }
+}
+
+// GetPositionListWithRefreshCache sets refreshCache.
+// 立即刷新OpenD缓存的此数据，默认不填。true向服务器获取最新数据更新缓存并返回；flase或没填则返回OpenD缓存的数据，不会向服务器请求。
+func GetPositionListWithRefreshCache(o bool) GetPositionListOption {
+	return func(r *pb.TrdGetPositionListRequest) { r.SetRefreshCache(o) }
+}
+
+// GetPositionList dispatches pb.TrdGetPositionListRequest.
+func (client *Client) GetPositionList(ctx context.Context, header *pb.TrdHeader, opts ...GetPositionListOption) ([]*pb.Position, error) {
+	req := &pb.TrdGetPositionListRequest{}
+	req.SetHeader(header)
+	for _, o := range opts {
+		o(req)
+	}
+
+	resp, err := req.Dispatch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPositionList(), nil
+}
+
+// ModifyOrderOption sets an optional field of pb.TrdModifyOrderRequest.
+type ModifyOrderOption func(*pb.TrdModifyOrderRequest)
+
+// ModifyOrderWithForAll sets forAll.
+// 是否对此业务账户的全部订单操作，true是，false否(对单个订单)，无此字段代表false，仅对单个订单
+func ModifyOrderWithForAll(o bool) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetForAll(o) }
+}
+
+// ModifyOrderWithMarket sets trdMarket.
+// 指定市场，全部撤单时才需要
+func ModifyOrderWithMarket(o pb.TrdMarket) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetMarket(o) }
+}
+
+// ModifyOrderWithQty sets qty.
+// 数量，期权单位是"张"（精确到小数点后 0 位，超出部分会被舍弃）
+func ModifyOrderWithQty(o float64) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetQty(o) }
+}
+
+// ModifyOrderWithPrice sets price.
+// 价格，（证券账户精确到小数点后 3 位，期货账户精确到小数点后 9 位，超出部分会被舍弃）
+func ModifyOrderWithPrice(o float64) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetPrice(o) }
+}
+
+// ModifyOrderWithAdjustPrice sets adjustPrice.
+// 是否调整价格，如果价格不合法，是否调整到合法价位，true调整，false不调整
+func ModifyOrderWithAdjustPrice(o bool) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetAdjustPrice(o) }
+}
+
+// ModifyOrderWithAdjustSideAndLimit sets adjustSideAndLimit.
+// 调整方向和调整幅度百分比限制，正数代表向上调整，负数代表向下调整，具体值代表调整幅度限制，如：0.015代表向上调整且幅度不超过1.5%；-0.01代表向下调整且幅度不超过1%
+func ModifyOrderWithAdjustSideAndLimit(o float64) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetAdjustSideAndLimit(o) }
+}
+
+// ModifyOrderWithAuxPrice sets auxPrice.
+// 触发价格
+func ModifyOrderWithAuxPrice(o float64) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetAuxPrice(o) }
+}
+
+// ModifyOrderWithTrailType sets trailType.
+// 跟踪类型, 参见Trd_Common.TrailType的枚举定义
+func ModifyOrderWithTrailType(o pb.TrailType) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetTrailType(o) }
+}
+
+// ModifyOrderWithTrailValue sets trailValue.
+// 跟踪金额/百分比
+func ModifyOrderWithTrailValue(o float64) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetTrailValue(o) }
+}
+
+// ModifyOrderWithTrailSpread sets trailSpread.
+// 指定价差
+func ModifyOrderWithTrailSpread(o float64) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetTrailSpread(o) }
+}
+
+// ModifyOrderWithOrderIDEx sets orderIDEx.
+// 表示服务器订单id，可以用来代替orderID，和orderID二选一
+func ModifyOrderWithOrderIDEx(o string) ModifyOrderOption {
+	return func(r *pb.TrdModifyOrderRequest) { r.SetOrderIDEx(o) }
+}
+
+// ModifyOrder dispatches pb.TrdModifyOrderRequest.
+func (client *Client) ModifyOrder(ctx context.Context, header *pb.TrdHeader, orderID uint64, modifyOrderOp pb.ModifyOrderOp, opts ...ModifyOrderOption) (*pb.TrdModifyOrderResponse, error) {
+	req := &pb.TrdModifyOrderRequest{}
+	req.SetHeader(header)
+	req.SetOrderID(orderID)
+	req.SetModifyOrderOp(modifyOrderOp)
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// PlaceOrderOption sets an optional field of pb.TrdPlaceOrderRequest.
+type PlaceOrderOption func(*pb.TrdPlaceOrderRequest)
+
+// PlaceOrderWithPrice sets price.
+// 价格，（证券账户精确到小数点后 3 位，期货账户精确到小数点后 9 位，超出部分会被舍弃）
+func PlaceOrderWithPrice(o float64) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetPrice(o) }
+}
+
+// PlaceOrderWithAdjustPrice sets adjustPrice.
+// 是否调整价格，如果价格不合法，是否调整到合法价位，true调整，false不调整
+func PlaceOrderWithAdjustPrice(o bool) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetAdjustPrice(o) }
+}
+
+// PlaceOrderWithAdjustSideAndLimit sets adjustSideAndLimit.
+// 调整方向和调整幅度百分比限制，正数代表向上调整，负数代表向下调整，具体值代表调整幅度限制，如：0.015代表向上调整且幅度不超过1.5%；-0.01代表向下调整且幅度不超过1%
+func PlaceOrderWithAdjustSideAndLimit(o float64) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetAdjustSideAndLimit(o) }
+}
+
+// PlaceOrderWithSecMarket sets secMarket.
+// 证券所属市场，参见TrdSecMarket的枚举定义
+func PlaceOrderWithSecMarket(o pb.TrdSecMarket) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetSecMarket(o) }
+}
+
+// PlaceOrderWithRemark sets remark.
+// 用户备注字符串，最多只能传64字节。可用于标识订单唯一信息等，下单填上，订单结构就会带上。
+func PlaceOrderWithRemark(o string) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetRemark(o) }
+}
+
+// PlaceOrderWithTimeInForce sets timeInForce.
+// 订单有效期限，参见TrdCommon_TimeInForce的枚举定义
+func PlaceOrderWithTimeInForce(o pb.TimeInForce) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetTimeInForce(o) }
+}
+
+// PlaceOrderWithFillOutsideRTH sets fillOutsideRTH.
+// 是否允许盘前盘后成交。仅适用于美股限价单。默认false
+func PlaceOrderWithFillOutsideRTH(o bool) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetFillOutsideRTH(o) }
+}
+
+// PlaceOrderWithAuxPrice sets auxPrice.
+// 触发价格
+func PlaceOrderWithAuxPrice(o float64) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetAuxPrice(o) }
+}
+
+// PlaceOrderWithTrailType sets trailType.
+// 跟踪类型, 参见Trd_Common.TrailType的枚举定义
+func PlaceOrderWithTrailType(o pb.TrailType) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetTrailType(o) }
+}
+
+// PlaceOrderWithTrailValue sets trailValue.
+// 跟踪金额/百分比
+func PlaceOrderWithTrailValue(o float64) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetTrailValue(o) }
+}
+
+// PlaceOrderWithTrailSpread sets trailSpread.
+// 指定价差
+func PlaceOrderWithTrailSpread(o float64) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetTrailSpread(o) }
+}
+
+// PlaceOrderWithSession sets session.
+// 美股订单时段, 参见Common.Session的枚举定义
+func PlaceOrderWithSession(o pb.Session) PlaceOrderOption {
+	return func(r *pb.TrdPlaceOrderRequest) { r.SetSession(o) }
+}
+
+// PlaceOrder dispatches pb.TrdPlaceOrderRequest.
+func (client *Client) PlaceOrder(ctx context.Context, header *pb.TrdHeader, trdSide pb.TrdSide, orderType pb.OrderType, code string, qty float64, opts ...PlaceOrderOption) (*pb.TrdPlaceOrderResponse, error) {
+	req := &pb.TrdPlaceOrderRequest{}
+	req.SetHeader(header)
+	req.SetSide(trdSide)
+	req.SetOrderType(orderType)
+	req.SetCode(code)
+	req.SetQty(qty)
+	for _, o := range opts {
+		o(req)
+	}
+
+	return req.Dispatch(ctx, client)
+}
+
+// ReconfirmOrder dispatches pb.TrdReconfirmOrderRequest.
+func (client *Client) ReconfirmOrder(ctx context.Context, header *pb.TrdHeader, orderID uint64, reconfirmReason int32) (*pb.TrdReconfirmOrderResponse, error) {
+	req := &pb.TrdReconfirmOrderRequest{}
+	req.SetHeader(header)
+	req.SetOrderID(orderID)
+	req.SetReconfirmReason(reconfirmReason)
+
+	return req.Dispatch(ctx, client)
+}
+
+// SubAccPush dispatches pb.TrdSubAccPushRequest.
+func (client *Client) SubAccPush(ctx context.Context, accIDList ...uint64) error {
+	req := &pb.TrdSubAccPushRequest{}
+	req.SetAccIDList(accIDList...)
+
+	_, err := req.Dispatch(ctx, client)
+	return err
+}
+
+// UnlockTradeOption sets an optional field of pb.TrdUnlockTradeRequest.
+type UnlockTradeOption func(*pb.TrdUnlockTradeRequest)
+
+// UnlockTradeWithPwdMD5 sets pwdMD5.
+// 交易密码的MD5转16进制(全小写)，解锁交易必须要填密码，锁定交易不需要验证密码，可不填
+func UnlockTradeWithPwdMD5(o string) UnlockTradeOption {
+	return func(r *pb.TrdUnlockTradeRequest) { r.SetPwdMD5(o) }
+}
+
+// UnlockTradeWithSecurityFirm sets securityFirm.
+// 券商标识，取值见Trd_Common.SecurityFirm
+func UnlockTradeWithSecurityFirm(o pb.SecurityFirm) UnlockTradeOption {
+	return func(r *pb.TrdUnlockTradeRequest) { r.SetSecurityFirm(o) }
+}
+
+// UnlockTrade dispatches pb.TrdUnlockTradeRequest.
+func (client *Client) UnlockTrade(ctx context.Context, unlock bool, opts ...UnlockTradeOption) error {
+	req := &pb.TrdUnlockTradeRequest{}
+	req.SetUnlock(unlock)
+	for _, o := range opts {
+		o(req)
+	}
+
+	_, err := req.Dispatch(ctx, client)
+	return err
+}
+
+// UsedQuota dispatches pb.UsedQuotaRequest.
+func (client *Client) UsedQuota(ctx context.Context) (*pb.UsedQuotaResponse, error) {
+	req := &pb.UsedQuotaRequest{}
+
+	return req.Dispatch(ctx, client)
+}
+
+// VerificationOption sets an optional field of pb.VerificationRequest.
+type VerificationOption func(*pb.VerificationRequest)
+
+// VerificationWithCode sets code.
+// 验证码，请求验证码时忽略该字段，输入时必填
+func VerificationWithCode(o string) VerificationOption {
+	return func(r *pb.VerificationRequest) { r.SetCode(o) }
+}
+
+// Verification dispatches pb.VerificationRequest.
+func (client *Client) Verification(ctx context.Context, typ pb.VerificationType, op pb.VerificationOp, opts ...VerificationOption) error {
+	req := &pb.VerificationRequest{}
+	req.SetType(typ)
+	req.SetOp(op)
+	for _, o := range opts {
+		o(req)
+	}
+
+	_, err := req.Dispatch(ctx, client)
+	return err
+}
@@ -125,6 +125,15 @@ func (ts *ClientTestSuite) TestGetGlobalState() {
 	fmt.Println(resp)
 }
 
+func (ts *ClientTestSuite) TestWaitReady() {
+	should := require.New(ts.T())
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	should.NoError(ts.client.WaitReady(ctx, true, true))
+}
+
 func (ts *ClientTestSuite) TestLockTrade() {
 	should := require.New(ts.T())
 
@@ -134,6 +143,7 @@ func (ts *ClientTestSuite) TestLockTrade() {
 	}
 	_, err := req.Dispatch(context.TODO(), ts.client)
 	should.NoError(err)
+	should.True(ts.client.TradeLocked())
 }
 
 func (ts *ClientTestSuite) TestSubscribeAccPush() {
@@ -326,6 +336,15 @@ func (ts *ClientTestSuite) TestTrdFlowSummary() {
 	should.True(errors.Is(err, futu.ErrNotSupportedInSimEnv))
 }
 
+func (ts *ClientTestSuite) TestUsedQuota() {
+	should := require.New(ts.T())
+
+	req := &pb.UsedQuotaRequest{}
+	res, err := req.Dispatch(context.TODO(), ts.client)
+	should.NoError(err)
+	log.Info().Interface("result", res).Msg("UsedQuota")
+}
+
 func (ts *ClientTestSuite) TestGetSubInfo() {
 	should := require.New(ts.T())
 
@@ -349,6 +368,14 @@ func (ts *ClientTestSuite) TestGetBasicQot() {
 	}
 }
 
+func (ts *ClientTestSuite) TestFacadeGetBasicQot() {
+	should := require.New(ts.T())
+
+	qots, err := ts.client.GetBasicQot(context.TODO(), "HK.00700", "HK.09988")
+	should.NoError(err)
+	should.Len(qots, 2)
+}
+
 func (ts *ClientTestSuite) TestGetKL() {
 	should := require.New(ts.T())
 
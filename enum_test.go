@@ -0,0 +1,83 @@
+package futu_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnum(t *testing.T) {
+	should := require.New(t)
+
+	for _, s := range []string{"Day", "day", "KL_Day", "KLType_Day", "2"} {
+		v, err := pb.ParseKLType(s)
+		should.NoError(err, s)
+		should.Equal(pb.KLType_Day, v, s)
+	}
+
+	for _, s := range []string{"HK", "HK_Security", "QotMarket_HK_Security"} {
+		v, err := pb.ParseQotMarket(s)
+		should.NoError(err, s)
+		should.Equal(pb.QotMarket_HK_Security, v, s)
+	}
+
+	_, err := pb.ParseKLType("Fortnight")
+	should.True(errors.Is(err, pb.ErrUnknownEnumName))
+
+	_, err = pb.ParseKLType("99")
+	should.True(errors.Is(err, pb.ErrUnknownEnumName))
+
+	should.Equal("Day", pb.KLType_Day.Name())
+	should.Equal("HK", pb.QotMarket_HK_Security.Name())
+	should.Equal("HK_Future", pb.QotMarket_HK_Future.Name())
+	should.Equal("Buy", pb.TrdSide_Buy.Name())
+}
+
+func TestEnumJSON(t *testing.T) {
+	should := require.New(t)
+
+	type config struct {
+		KLType pb.KLType     `json:"klType"`
+		Market *pb.QotMarket `json:"market"`
+	}
+
+	var c config
+	should.NoError(json.Unmarshal([]byte(`{"klType": "Day", "market": "HK"}`), &c))
+	should.Equal(pb.KLType_Day, c.KLType)
+	should.Equal(pb.QotMarket_HK_Security, *c.Market)
+
+	b, err := json.Marshal(c)
+	should.NoError(err)
+	should.JSONEq(`{"klType": "Day", "market": "HK"}`, string(b))
+
+	should.Error(json.Unmarshal([]byte(`{"klType": "Fortnight"}`), &c))
+}
+
+func TestEnumJSONNumber(t *testing.T) {
+	should := require.New(t)
+
+	var header pb.TrdHeader
+	should.NoError(json.Unmarshal([]byte(`{"trdEnv": 1, "accID": 1, "trdMarket": "HK"}`), &header))
+	should.Equal(pb.TrdEnv_TrdEnv_Real, header.GetTrdEnv())
+	should.Equal(pb.TrdMarket_TrdMarket_HK, header.GetTrdMarket())
+
+	b, err := json.Marshal(&header)
+	should.NoError(err)
+
+	var decoded pb.TrdHeader
+	should.NoError(json.Unmarshal(b, &decoded))
+	should.Equal(pb.TrdEnv_TrdEnv_Real, decoded.GetTrdEnv())
+
+	var c struct {
+		K pb.KLType `json:"k"`
+	}
+	should.NoError(json.Unmarshal([]byte(`{"k": 2}`), &c))
+	should.Equal(pb.KLType_Day, c.K)
+	should.NoError(json.Unmarshal([]byte(`{"k": "2"}`), &c))
+	should.Equal(pb.KLType_Day, c.K)
+
+	should.Error(json.Unmarshal([]byte(`{"k": 99}`), &c))
+}
@@ -14,6 +14,21 @@ var (
 	ErrInterrupted   = errors.New("process is interrupted")
 	ErrTimeout       = errors.New("timeout")
 
+	ErrUnsupportedField = errors.New("field not supported by OpenD")
+	ErrClientUnusable   = errors.New("client unusable")
+	ErrNotReady         = errors.New("OpenD not ready")
+	ErrInvalidRequest   = errors.New("invalid request")
+
+	ErrSubscriptionsClosed = errors.New("subscriptions closed")
+	ErrNoSessionTable      = errors.New("no session table of market")
+	ErrNoTradingDay        = errors.New("no trading day found")
+
+	ErrInvalidQuery = errors.New("invalid stock filter query")
+
+	// ErrVerificationBusy is returned by LoginAssistant.Verify while
+	// another verification flow is running.
+	ErrVerificationBusy = errors.New("verification in progress")
+
 	errSHA1Mismatch = errors.New("sha1 mismatch")
 )
 
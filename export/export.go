@@ -0,0 +1,326 @@
+// Package export writes futu messages, eg: klines, tickers, orders, fills
+// and positions, as CSV or JSON Lines, for spreadsheets or pandas.
+//
+// Columns are the proto field names in declaration order. Time strings
+// get a parsed column with the ISO suffix, eg: createTimeISO in RFC 3339,
+// taken from the matching timestamp field if set.
+//
+// In JSON Lines, 64-bit integers are written as strings, as protojson does,
+// since IDs, eg: orderID, may exceed the 2^53 precision of JSON numbers.
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+type Format int
+
+const (
+	CSV Format = iota
+	JSONL
+)
+
+type options struct {
+	enumNames bool
+	loc       *time.Location
+}
+
+type Option func(o *options)
+
+// WithEnumNames writes enums as short names, eg: Buy, instead of numbers.
+func WithEnumNames(b bool) Option {
+	return func(o *options) {
+		o.enumNames = b
+	}
+}
+
+// WithLocation sets the zone of time strings, for messages without secMarket,
+// eg: futu.QotMarketLocation(security.GetMarket()) for klines. Defaults to UTC.
+func WithLocation(loc *time.Location) Option {
+	return func(o *options) {
+		o.loc = loc
+	}
+}
+
+type column struct {
+	name  string
+	field protoreflect.FieldDescriptor
+	time  bool                         // parsed column of field
+	stamp protoreflect.FieldDescriptor // timestamp of a time field, may be nil
+}
+
+// columnsOf returns columns of scalar and enum fields of md.
+// Message and repeated fields are not exported.
+func columnsOf(md protoreflect.MessageDescriptor) []column {
+	columns := []column{}
+	fields := md.Fields()
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() || fd.IsMap() || fd.Message() != nil {
+			continue
+		}
+
+		name := string(fd.Name())
+		columns = append(columns, column{name: name, field: fd})
+
+		if fd.Kind() == protoreflect.StringKind && (name == "time" || strings.HasSuffix(name, "Time")) {
+			stamp := fields.ByName(protoreflect.Name(name + "stamp"))
+			if stamp != nil && stamp.Kind() != protoreflect.DoubleKind {
+				stamp = nil
+			}
+			columns = append(columns, column{name: name + "ISO", field: fd, time: true, stamp: stamp})
+		}
+	}
+
+	return columns
+}
+
+// Writer writes messages of type T one by one, for large histories.
+// Flush must be called after writing.
+type Writer[T proto.Message] struct {
+	options
+	format  Format
+	columns []column
+	market  protoreflect.FieldDescriptor // secMarket
+
+	csv    *csv.Writer
+	buf    *bufio.Writer
+	header bool
+}
+
+// NewWriter creates a Writer of format.
+func NewWriter[T proto.Message](w io.Writer, format Format, opts ...Option) *Writer[T] {
+	o := options{loc: time.UTC}
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	var zero T
+	md := zero.ProtoReflect().Descriptor()
+
+	ew := &Writer[T]{
+		options: o,
+		format:  format,
+		columns: columnsOf(md),
+		market:  md.Fields().ByName("secMarket"),
+	}
+
+	if format == CSV {
+		ew.csv = csv.NewWriter(w)
+	} else {
+		ew.buf = bufio.NewWriter(w)
+	}
+
+	return ew
+}
+
+// Columns returns column names.
+func (w *Writer[T]) Columns() []string {
+	names := make([]string, len(w.columns))
+	for i, c := range w.columns {
+		names[i] = c.name
+	}
+	return names
+}
+
+// Write writes a message. The CSV header is written before the first row.
+func (w *Writer[T]) Write(v T) error {
+	values := w.values(v.ProtoReflect())
+
+	if w.format == JSONL {
+		return w.writeJSON(values)
+	}
+
+	if !w.header {
+		w.header = true
+		if err := w.csv.Write(w.Columns()); err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, len(values))
+	for i, v := range values {
+		record[i] = csvValue(v)
+	}
+	return w.csv.Write(record)
+}
+
+// WriteAll writes all messages.
+func (w *Writer[T]) WriteAll(list []T) error {
+	for _, v := range list {
+		if err := w.Write(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Flush writes buffered data to the underlying writer.
+func (w *Writer[T]) Flush() error {
+	if w.csv != nil {
+		// no row written, header only
+		if !w.header {
+			w.header = true
+			if err := w.csv.Write(w.Columns()); err != nil {
+				return err
+			}
+		}
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	return w.buf.Flush()
+}
+
+func (w *Writer[T]) writeJSON(values []any) error {
+	w.buf.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			w.buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(w.columns[i].name)
+		w.buf.Write(name)
+		w.buf.WriteByte(':')
+
+		if v != nil && is64Bit(w.columns[i].field.Kind()) {
+			v = csvValue(v)
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		w.buf.Write(b)
+	}
+	w.buf.WriteByte('}')
+	_, err := w.buf.WriteString("\n")
+	return err
+}
+
+func is64Bit(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	}
+	return false
+}
+
+// values returns column values of m, nil for fields not set.
+func (w *Writer[T]) values(m protoreflect.Message) []any {
+	values := make([]any, len(w.columns))
+
+	for i, c := range w.columns {
+		if !m.Has(c.field) {
+			continue
+		}
+
+		if c.time {
+			if t, ok := w.parseTime(m, c); ok {
+				values[i] = t.Format(time.RFC3339Nano)
+			}
+			continue
+		}
+
+		v := m.Get(c.field)
+		switch c.field.Kind() {
+		case protoreflect.EnumKind:
+			values[i] = w.enumValue(c.field, v.Enum())
+		case protoreflect.BoolKind:
+			values[i] = v.Bool()
+		case protoreflect.StringKind:
+			values[i] = v.String()
+		case protoreflect.BytesKind:
+			values[i] = v.Bytes()
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			values[i] = v.Float()
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+			protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			values[i] = v.Uint()
+		default:
+			values[i] = v.Int()
+		}
+	}
+
+	return values
+}
+
+func (w *Writer[T]) parseTime(m protoreflect.Message, c column) (time.Time, bool) {
+	loc := w.loc
+	if w.market != nil && m.Has(w.market) {
+		loc = futu.TrdSecMarketLocation(pb.TrdSecMarket(m.Get(w.market).Enum()))
+	}
+
+	ts := 0.0
+	if c.stamp != nil {
+		ts = m.Get(c.stamp).Float()
+	}
+
+	t, err := futu.ParseTimeTimestamp(m.Get(c.field).String(), ts, loc)
+	return t, err == nil
+}
+
+func (w *Writer[T]) enumValue(fd protoreflect.FieldDescriptor, n protoreflect.EnumNumber) any {
+	if !w.enumNames {
+		return int32(n)
+	}
+
+	et, err := protoregistry.GlobalTypes.FindEnumByName(fd.Enum().FullName())
+	if err != nil {
+		return int32(n)
+	}
+	if named, ok := et.New(n).(interface{ Name() string }); ok {
+		return named.Name()
+	}
+	return int32(n)
+}
+
+func csvValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case []byte:
+		return string(v)
+	}
+	return ""
+}
+
+// WriteCSV writes list as CSV.
+func WriteCSV[T proto.Message](w io.Writer, list []T, opts ...Option) error {
+	return writeAll(NewWriter[T](w, CSV, opts...), list)
+}
+
+// WriteJSONL writes list as JSON Lines.
+func WriteJSONL[T proto.Message](w io.Writer, list []T, opts ...Option) error {
+	return writeAll(NewWriter[T](w, JSONL, opts...), list)
+}
+
+func writeAll[T proto.Message](w *Writer[T], list []T) error {
+	if err := w.WriteAll(list); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -0,0 +1,91 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/export"
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCSV(t *testing.T) {
+	should := require.New(t)
+
+	kls := []*pb.KLine{{
+		Time:       proto.String("2024-03-01 00:00:00"),
+		IsBlank:    proto.Bool(false),
+		ClosePrice: proto.Float64(300.4),
+		Volume:     proto.Int64(1000),
+	}}
+
+	buf := &bytes.Buffer{}
+	should.NoError(export.WriteCSV(buf, kls, export.WithLocation(futu.LocHongKong)))
+
+	rows, err := csv.NewReader(buf).ReadAll()
+	should.NoError(err)
+	should.Len(rows, 2)
+
+	row := map[string]string{}
+	for i, name := range rows[0] {
+		row[name] = rows[1][i]
+	}
+	should.Equal([]string{"time", "timeISO", "isBlank", "highPrice"}, rows[0][:4])
+	should.Equal("2024-03-01T00:00:00+08:00", row["timeISO"])
+	should.Equal("false", row["isBlank"])
+	should.Equal("300.4", row["closePrice"])
+	should.Equal("1000", row["volume"])
+	should.Equal("", row["openPrice"])
+
+	// header only
+	buf.Reset()
+	should.NoError(export.WriteCSV(buf, []*pb.Ticker{}))
+	should.True(strings.HasPrefix(buf.String(), "time,timeISO,sequence,dir,"))
+}
+
+func TestJSONL(t *testing.T) {
+	should := require.New(t)
+
+	buf := &bytes.Buffer{}
+	w := export.NewWriter[*pb.Order](buf, export.JSONL, export.WithEnumNames(true))
+
+	for i := range 3 {
+		should.NoError(w.Write(&pb.Order{
+			TrdSide:         pb.TrdSide_Buy.Enum(),
+			OrderID:         proto.Uint64(uint64(i + 1)),
+			Code:            proto.String("AAPL"),
+			SecMarket:       pb.TrdSecMarket_US.Enum(),
+			CreateTime:      proto.String("2024-07-01 09:30:00"),
+			CreateTimestamp: proto.Float64(1719840600.5),
+		}))
+	}
+	should.NoError(w.Flush())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	should.Len(lines, 3)
+	should.True(strings.HasPrefix(lines[0], `{"trdSide":"Buy","orderType":null,`))
+
+	row := map[string]any{}
+	should.NoError(json.Unmarshal([]byte(lines[2]), &row))
+	should.Equal("3", row["orderID"])
+	should.Equal("US", row["secMarket"])
+	// from timestamp, in market time
+	should.Equal("2024-07-01T09:30:00.5-04:00", row["createTimeISO"])
+	should.Nil(row["updateTimeISO"])
+
+	// beyond the precision of float64
+	buf.Reset()
+	w = export.NewWriter[*pb.Order](buf, export.JSONL)
+	should.NoError(w.Write(&pb.Order{OrderID: proto.Uint64(1<<53 + 1), Qty: proto.Float64(100)}))
+	should.NoError(w.Flush())
+
+	row = map[string]any{}
+	should.NoError(json.Unmarshal(buf.Bytes(), &row))
+	should.Equal("9007199254740993", row["orderID"])
+	should.Equal(100.0, row["qty"])
+}
@@ -20,6 +20,14 @@ func DatePtr(t time.Time) *string {
 	return proto.String(t.Format(DateFormat))
 }
 
+// datePart returns the date part of a time string, which sorts lexically.
+func datePart(s string) string {
+	if len(s) > len(DateFormat) {
+		return s[:len(DateFormat)]
+	}
+	return s
+}
+
 // find TrdAcc in accList
 // simAccType: Unknown means finding a Real account
 func FindAccount(accList []*pb.TrdAcc, mkt pb.TrdMarket, accType pb.TrdAccType, simAccType pb.SimAccType) *pb.TrdAcc {
@@ -0,0 +1,199 @@
+package indicators
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/santsai/futu-go/pb"
+)
+
+var (
+	ErrUnsupportedField = errors.New("indicator field not supported")
+	ErrInvalidParams    = errors.New("invalid indicator params")
+)
+
+// fieldFunc maps an indicator of V to a float64 field.
+type fieldFunc[V any] struct {
+	ind Indicator[V]
+	fn  func(V) float64
+}
+
+func (f fieldFunc[V]) Update(kl *pb.KLine) (float64, bool) {
+	v, ok := f.ind.Update(kl)
+	return f.fn(v), ok
+}
+
+func params(list []int32, defaults ...int32) ([]int, error) {
+	if len(list) == 0 {
+		list = defaults
+	}
+	if len(list) != len(defaults) {
+		return nil, fmt.Errorf("%w: %v, want %d", ErrInvalidParams, list, len(defaults))
+	}
+
+	ps := make([]int, len(list))
+	for i, p := range list {
+		if p <= 0 {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidParams, list)
+		}
+		ps[i] = int(p)
+	}
+	return ps, nil
+}
+
+var fixedMA = map[pb.CustomIndicatorField]int{
+	pb.CustomIndicatorField_MA5:   5,
+	pb.CustomIndicatorField_MA10:  10,
+	pb.CustomIndicatorField_MA20:  20,
+	pb.CustomIndicatorField_MA30:  30,
+	pb.CustomIndicatorField_MA60:  60,
+	pb.CustomIndicatorField_MA120: 120,
+	pb.CustomIndicatorField_MA250: 250,
+}
+
+var fixedEMA = map[pb.CustomIndicatorField]int{
+	pb.CustomIndicatorField_EMA5:   5,
+	pb.CustomIndicatorField_EMA10:  10,
+	pb.CustomIndicatorField_EMA20:  20,
+	pb.CustomIndicatorField_EMA30:  30,
+	pb.CustomIndicatorField_EMA60:  60,
+	pb.CustomIndicatorField_EMA120: 120,
+	pb.CustomIndicatorField_EMA250: 250,
+}
+
+// NewField creates the indicator of field with params of firstFieldParaList
+// or secondFieldParaList, empty for the defaults of OpenD:
+// RSI [12], MACD [12,26,9], BOLL [20,2] and KDJ [9,3,3].
+// MA and EMA require the period. CustomIndicatorField_Value is not supported.
+func NewField(field pb.CustomIndicatorField, list []int32) (Indicator[float64], error) {
+	if n, ok := fixedMA[field]; ok {
+		return NewMA(n), nil
+	}
+	if n, ok := fixedEMA[field]; ok {
+		return NewEMA(n), nil
+	}
+
+	switch field {
+	case pb.CustomIndicatorField_Price:
+		return NewClose(), nil
+
+	case pb.CustomIndicatorField_MA, pb.CustomIndicatorField_EMA:
+		if len(list) == 0 {
+			return nil, fmt.Errorf("%w: period of %s required", ErrInvalidParams, field.Name())
+		}
+		ps, err := params(list, 0)
+		if err != nil {
+			return nil, err
+		}
+		if field == pb.CustomIndicatorField_MA {
+			return NewMA(ps[0]), nil
+		}
+		return NewEMA(ps[0]), nil
+
+	case pb.CustomIndicatorField_RSI:
+		ps, err := params(list, 12)
+		if err != nil {
+			return nil, err
+		}
+		return NewRSI(ps[0]), nil
+
+	case pb.CustomIndicatorField_MACD_DIFF, pb.CustomIndicatorField_MACD_DEA, pb.CustomIndicatorField_MACD:
+		ps, err := params(list, 12, 26, 9)
+		if err != nil {
+			return nil, err
+		}
+		fn := map[pb.CustomIndicatorField]func(MACDValue) float64{
+			pb.CustomIndicatorField_MACD_DIFF: func(v MACDValue) float64 { return v.DIF },
+			pb.CustomIndicatorField_MACD_DEA:  func(v MACDValue) float64 { return v.DEA },
+			pb.CustomIndicatorField_MACD:      func(v MACDValue) float64 { return v.MACD },
+		}[field]
+		return fieldFunc[MACDValue]{NewMACD(ps[0], ps[1], ps[2]), fn}, nil
+
+	case pb.CustomIndicatorField_BOLL_UPPER, pb.CustomIndicatorField_BOLL_MIDDLER, pb.CustomIndicatorField_BOLL_LOWER:
+		ps, err := params(list, 20, 2)
+		if err != nil {
+			return nil, err
+		}
+		fn := map[pb.CustomIndicatorField]func(BOLLValue) float64{
+			pb.CustomIndicatorField_BOLL_UPPER:   func(v BOLLValue) float64 { return v.Upper },
+			pb.CustomIndicatorField_BOLL_MIDDLER: func(v BOLLValue) float64 { return v.Middle },
+			pb.CustomIndicatorField_BOLL_LOWER:   func(v BOLLValue) float64 { return v.Lower },
+		}[field]
+		return fieldFunc[BOLLValue]{NewBOLL(ps[0], float64(ps[1])), fn}, nil
+
+	case pb.CustomIndicatorField_KDJ_K, pb.CustomIndicatorField_KDJ_D, pb.CustomIndicatorField_KDJ_J:
+		ps, err := params(list, 9, 3, 3)
+		if err != nil {
+			return nil, err
+		}
+		fn := map[pb.CustomIndicatorField]func(KDJValue) float64{
+			pb.CustomIndicatorField_KDJ_K: func(v KDJValue) float64 { return v.K },
+			pb.CustomIndicatorField_KDJ_D: func(v KDJValue) float64 { return v.D },
+			pb.CustomIndicatorField_KDJ_J: func(v KDJValue) float64 { return v.J },
+		}[field]
+		return fieldFunc[KDJValue]{NewKDJ(ps[0], ps[1], ps[2]), fn}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedField, field.Name())
+}
+
+// Match evaluates a CustomIndicatorFilter locally on klines of its klType,
+// requiring the relative position in each of the last consecutivePeriod
+// klines, 1 by default. Crosses compare with the previous kline.
+// isNoFilter is not checked.
+func Match(filter *pb.CustomIndicatorFilter, kls []*pb.KLine) (bool, error) {
+	first, err := NewField(filter.GetFirstFieldName(), filter.GetFirstFieldParaList())
+	if err != nil {
+		return false, err
+	}
+
+	var second Indicator[float64]
+	if filter.GetSecondFieldName() != pb.CustomIndicatorField_Value {
+		second, err = NewField(filter.GetSecondFieldName(), filter.GetSecondFieldParaList())
+		if err != nil {
+			return false, err
+		}
+	}
+
+	type point struct{ a, b float64 }
+	points := []point{}
+	for _, kl := range kls {
+		if kl.GetIsBlank() {
+			continue
+		}
+		a, _ := first.Update(kl)
+		b := filter.GetFieldValue()
+		if second != nil {
+			b, _ = second.Update(kl)
+		}
+		points = append(points, point{a, b})
+	}
+
+	period := max(int(filter.GetConsecutivePeriod()), 1)
+	if len(points) < period {
+		return false, nil
+	}
+
+	// NaN compares false, so klines not ready never match
+	for i := len(points) - period; i < len(points); i++ {
+		p := points[i]
+		var ok bool
+		switch filter.GetRelativePosition() {
+		case pb.RelativePosition_More:
+			ok = p.a > p.b
+		case pb.RelativePosition_Less:
+			ok = p.a < p.b
+		case pb.RelativePosition_CrossUp:
+			ok = i > 0 && points[i-1].a <= points[i-1].b && p.a > p.b
+		case pb.RelativePosition_CrossDown:
+			ok = i > 0 && points[i-1].a >= points[i-1].b && p.a < p.b
+		default:
+			return false, fmt.Errorf("%w: relative position %s", ErrUnsupportedField, filter.GetRelativePosition().Name())
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,400 @@
+// Package indicators computes technical indicators of klines with the
+// definitions of QotStockFilter CustomIndicatorField, so that local values
+// agree with server side filters:
+//
+//	MA(N)        MA(CLOSE, N)
+//	EMA(N)       EMA(CLOSE, N), seeded with the first close
+//	RSI(N)       SMA(MAX(CLOSE-LC, 0), N, 1) / SMA(ABS(CLOSE-LC), N, 1) * 100
+//	MACD(S,L,M)  DIF: EMA(CLOSE, S) - EMA(CLOSE, L), DEA: EMA(DIF, M), MACD: (DIF-DEA)*2
+//	BOLL(N,P)    MID: MA(CLOSE, N), UPPER/LOWER: MID ± P*STD(CLOSE, N)
+//	KDJ(N,M1,M2) RSV: (CLOSE-LLV(LOW, N)) / (HHV(HIGH, N)-LLV(LOW, N)) * 100,
+//	             K: SMA(RSV, M1, 1), D: SMA(K, M2, 1), J: 3*K-2*D
+//
+// where SMA(X, N, M) is (M*X + (N-M)*SMA') / N seeded with the first X,
+// and STD is the sample standard deviation.
+//
+// Recursive indicators, eg: EMA, RSI, MACD and KDJ, depend on all previous
+// klines; feed a few times N klines of history before comparing with OpenD.
+//
+// Periods less than 1 passed to constructors are taken as 1.
+package indicators
+
+import (
+	"math"
+
+	"github.com/santsai/futu-go/pb"
+)
+
+// Indicator is updated by klines in time order, eg: of QotGetHistoryKL then
+// QotUpdateKL. A kline with the time of the last one replaces it, as the
+// current bar is pushed repeatedly until closed. Blank and earlier klines
+// are ignored and the current value is returned.
+//
+// ok is false until there are enough klines, and float values are NaN.
+type Indicator[V any] interface {
+	Update(kl *pb.KLine) (v V, ok bool)
+}
+
+// Series updates ind by kls, returning values aligned with kls.
+func Series[V any](ind Indicator[V], kls []*pb.KLine) []V {
+	values := make([]V, len(kls))
+	for i, kl := range kls {
+		values[i], _ = ind.Update(kl)
+	}
+	return values
+}
+
+// stream tracks the current kline, which is committed to the state of an
+// indicator when a kline of a later time arrives.
+type stream struct {
+	last *pb.KLine
+}
+
+// next returns the kline to commit before computing kl, if any,
+// and false if kl is ignored.
+func (s *stream) next(kl *pb.KLine) (commit *pb.KLine, ok bool) {
+	if kl.GetIsBlank() {
+		return nil, false
+	}
+	if s.last != nil {
+		if kl.GetTime() < s.last.GetTime() {
+			return nil, false
+		}
+		if kl.GetTime() > s.last.GetTime() {
+			commit = s.last
+		}
+	}
+	s.last = kl
+	return commit, true
+}
+
+// window holds the last n-1 committed values, which make n with the current one.
+type window struct {
+	n      int
+	values []float64
+}
+
+func newWindow(n int) window {
+	return window{n: max(n, 1)}
+}
+
+func (w *window) add(x float64) {
+	w.values = append(w.values, x)
+	if len(w.values) >= w.n {
+		w.values = w.values[len(w.values)-w.n+1:]
+	}
+}
+
+func (w *window) full() bool {
+	return len(w.values) == w.n-1
+}
+
+func (w *window) mean(x float64) float64 {
+	sum := x
+	for _, v := range w.values {
+		sum += v
+	}
+	return sum / float64(len(w.values)+1)
+}
+
+// std returns the sample standard deviation.
+func (w *window) std(x, mean float64) float64 {
+	n := len(w.values) + 1
+	if n < 2 {
+		return 0
+	}
+	sum := (x - mean) * (x - mean)
+	for _, v := range w.values {
+		sum += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(sum / float64(n-1))
+}
+
+func (w *window) max(x float64) float64 {
+	for _, v := range w.values {
+		x = max(x, v)
+	}
+	return x
+}
+
+func (w *window) min(x float64) float64 {
+	for _, v := range w.values {
+		x = min(x, v)
+	}
+	return x
+}
+
+// sma is SMA(X, N, M), and EMA(X, N) as SMA(X, N+1, 2).
+type sma struct {
+	n, m float64
+	y    float64
+	has  bool
+}
+
+func newEMA(n int) sma {
+	return sma{n: float64(max(n, 1) + 1), m: 2}
+}
+
+func newSMA(n, m int) sma {
+	return sma{n: float64(max(n, 1)), m: float64(m)}
+}
+
+func (s *sma) value(x float64) float64 {
+	if !s.has {
+		return x
+	}
+	return (s.m*x + (s.n-s.m)*s.y) / s.n
+}
+
+func (s *sma) add(x float64) {
+	s.y = s.value(x)
+	s.has = true
+}
+
+// Close is the close price, for CustomIndicatorField_Price.
+type Close struct {
+	stream
+	value float64
+}
+
+func NewClose() *Close {
+	return &Close{value: math.NaN()}
+}
+
+func (c *Close) Update(kl *pb.KLine) (float64, bool) {
+	if _, ok := c.next(kl); ok {
+		c.value = kl.GetClosePrice()
+	}
+	return c.value, c.last != nil
+}
+
+// MA is the simple moving average of close prices, ready after n klines.
+type MA struct {
+	stream
+	win   window
+	value float64
+	ok    bool
+}
+
+func NewMA(n int) *MA {
+	return &MA{win: newWindow(n), value: math.NaN()}
+}
+
+func (m *MA) Update(kl *pb.KLine) (float64, bool) {
+	commit, ok := m.next(kl)
+	if !ok {
+		return m.value, m.ok
+	}
+	if commit != nil {
+		m.win.add(commit.GetClosePrice())
+	}
+
+	m.ok = m.win.full()
+	m.value = math.NaN()
+	if m.ok {
+		m.value = m.win.mean(kl.GetClosePrice())
+	}
+	return m.value, m.ok
+}
+
+// EMA is the exponential moving average of close prices.
+type EMA struct {
+	stream
+	ema   sma
+	value float64
+}
+
+func NewEMA(n int) *EMA {
+	return &EMA{ema: newEMA(n), value: math.NaN()}
+}
+
+func (e *EMA) Update(kl *pb.KLine) (float64, bool) {
+	commit, ok := e.next(kl)
+	if !ok {
+		return e.value, e.last != nil
+	}
+	if commit != nil {
+		e.ema.add(commit.GetClosePrice())
+	}
+
+	e.value = e.ema.value(kl.GetClosePrice())
+	return e.value, true
+}
+
+// RSI is the relative strength index, ready after 2 klines.
+// It is 50 if prices never changed.
+type RSI struct {
+	stream
+	up, abs sma
+	prev    float64
+	hasPrev bool
+	value   float64
+}
+
+func NewRSI(n int) *RSI {
+	return &RSI{up: newSMA(n, 1), abs: newSMA(n, 1), value: math.NaN()}
+}
+
+func (r *RSI) Update(kl *pb.KLine) (float64, bool) {
+	commit, ok := r.next(kl)
+	if !ok {
+		return r.value, r.hasPrev
+	}
+	if commit != nil {
+		c := commit.GetClosePrice()
+		if r.hasPrev {
+			r.up.add(max(c-r.prev, 0))
+			r.abs.add(math.Abs(c - r.prev))
+		}
+		r.prev, r.hasPrev = c, true
+	}
+
+	if !r.hasPrev {
+		return r.value, false
+	}
+
+	d := kl.GetClosePrice() - r.prev
+	up, abs := r.up.value(max(d, 0)), r.abs.value(math.Abs(d))
+	r.value = 50
+	if abs != 0 {
+		r.value = up / abs * 100
+	}
+	return r.value, true
+}
+
+type MACDValue struct {
+	DIF  float64
+	DEA  float64
+	MACD float64
+}
+
+// MACD is the moving average convergence divergence of close prices.
+type MACD struct {
+	stream
+	short, long, dea sma
+	value            MACDValue
+}
+
+func NewMACD(short, long, m int) *MACD {
+	nan := math.NaN()
+	return &MACD{
+		short: newEMA(short),
+		long:  newEMA(long),
+		dea:   newEMA(m),
+		value: MACDValue{nan, nan, nan},
+	}
+}
+
+func (m *MACD) Update(kl *pb.KLine) (MACDValue, bool) {
+	commit, ok := m.next(kl)
+	if !ok {
+		return m.value, m.last != nil
+	}
+	if commit != nil {
+		c := commit.GetClosePrice()
+		dif := m.short.value(c) - m.long.value(c)
+		m.short.add(c)
+		m.long.add(c)
+		m.dea.add(dif)
+	}
+
+	c := kl.GetClosePrice()
+	dif := m.short.value(c) - m.long.value(c)
+	dea := m.dea.value(dif)
+	m.value = MACDValue{DIF: dif, DEA: dea, MACD: (dif - dea) * 2}
+	return m.value, true
+}
+
+type BOLLValue struct {
+	Upper  float64
+	Middle float64
+	Lower  float64
+}
+
+// BOLL is the Bollinger bands of close prices, ready after n klines.
+type BOLL struct {
+	stream
+	win   window
+	p     float64
+	value BOLLValue
+	ok    bool
+}
+
+func NewBOLL(n int, p float64) *BOLL {
+	nan := math.NaN()
+	return &BOLL{win: newWindow(n), p: p, value: BOLLValue{nan, nan, nan}}
+}
+
+func (b *BOLL) Update(kl *pb.KLine) (BOLLValue, bool) {
+	commit, ok := b.next(kl)
+	if !ok {
+		return b.value, b.ok
+	}
+	if commit != nil {
+		b.win.add(commit.GetClosePrice())
+	}
+
+	b.ok = b.win.full()
+	if !b.ok {
+		return b.value, false
+	}
+
+	c := kl.GetClosePrice()
+	mid := b.win.mean(c)
+	std := b.win.std(c, mid)
+	b.value = BOLLValue{Upper: mid + b.p*std, Middle: mid, Lower: mid - b.p*std}
+	return b.value, true
+}
+
+type KDJValue struct {
+	K float64
+	D float64
+	J float64
+}
+
+// KDJ is the stochastic oscillator. HHV and LLV take the klines available
+// before n klines, and RSV is 50 if the range is zero.
+type KDJ struct {
+	stream
+	high, low window
+	k, d      sma
+	value     KDJValue
+}
+
+func NewKDJ(n, m1, m2 int) *KDJ {
+	nan := math.NaN()
+	return &KDJ{
+		high:  newWindow(n),
+		low:   newWindow(n),
+		k:     newSMA(m1, 1),
+		d:     newSMA(m2, 1),
+		value: KDJValue{nan, nan, nan},
+	}
+}
+
+func (k *KDJ) rsv(kl *pb.KLine) float64 {
+	high, low := k.high.max(kl.GetHighPrice()), k.low.min(kl.GetLowPrice())
+	if high == low {
+		return 50
+	}
+	return (kl.GetClosePrice() - low) / (high - low) * 100
+}
+
+func (k *KDJ) Update(kl *pb.KLine) (KDJValue, bool) {
+	commit, ok := k.next(kl)
+	if !ok {
+		return k.value, k.last != nil
+	}
+	if commit != nil {
+		rsv := k.rsv(commit)
+		k.d.add(k.k.value(rsv))
+		k.k.add(rsv)
+		k.high.add(commit.GetHighPrice())
+		k.low.add(commit.GetLowPrice())
+	}
+
+	kv := k.k.value(k.rsv(kl))
+	dv := k.d.value(kv)
+	k.value = KDJValue{K: kv, D: dv, J: 3*kv - 2*dv}
+	return k.value, true
+}
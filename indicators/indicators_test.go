@@ -0,0 +1,195 @@
+package indicators_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/santsai/futu-go/indicators"
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func kline(day int, high, low, close float64) *pb.KLine {
+	return &pb.KLine{
+		Time:       proto.String(fmt.Sprintf("2024-03-%02d 00:00:00", day)),
+		IsBlank:    proto.Bool(false),
+		HighPrice:  proto.Float64(high),
+		LowPrice:   proto.Float64(low),
+		ClosePrice: proto.Float64(close),
+	}
+}
+
+func closes(prices ...float64) []*pb.KLine {
+	kls := []*pb.KLine{}
+	for i, p := range prices {
+		kls = append(kls, kline(i+1, p+1, p-1, p))
+	}
+	return kls
+}
+
+func TestMA(t *testing.T) {
+	should := require.New(t)
+
+	values := indicators.Series(indicators.NewMA(3), closes(1, 2, 3, 4, 8))
+	should.True(math.IsNaN(values[0]))
+	should.True(math.IsNaN(values[1]))
+	should.Equal([]float64{2, 3, 5}, values[2:])
+
+	values = indicators.Series(indicators.NewEMA(3), closes(1, 2, 3))
+	should.Equal([]float64{1, 1.5, 2.25}, values)
+}
+
+func TestNonPositivePeriods(t *testing.T) {
+	should := require.New(t)
+
+	kls := closes(1, 2, 3)
+	should.Equal(indicators.Series(indicators.NewMA(1), kls), indicators.Series(indicators.NewMA(0), kls))
+	should.Equal(indicators.Series(indicators.NewEMA(1), kls), indicators.Series(indicators.NewEMA(-1), kls))
+	should.Equal(indicators.Series(indicators.NewBOLL(1, 2), kls), indicators.Series(indicators.NewBOLL(0, 2), kls))
+	should.Equal(indicators.Series(indicators.NewKDJ(1, 1, 1), kls), indicators.Series(indicators.NewKDJ(0, -1, 0), kls))
+}
+
+func TestUpdateCurrentBar(t *testing.T) {
+	should := require.New(t)
+
+	ema := indicators.NewEMA(3)
+	ema.Update(kline(1, 0, 0, 1))
+
+	// the current bar is pushed repeatedly
+	v, ok := ema.Update(kline(2, 0, 0, 5))
+	should.True(ok)
+	should.Equal(3.0, v)
+	v, _ = ema.Update(kline(2, 0, 0, 2))
+	should.Equal(1.5, v)
+
+	// earlier and blank klines are ignored
+	v, _ = ema.Update(kline(1, 0, 0, 100))
+	should.Equal(1.5, v)
+	v, _ = ema.Update(&pb.KLine{Time: proto.String("2024-03-03 00:00:00"), IsBlank: proto.Bool(true)})
+	should.Equal(1.5, v)
+
+	v, _ = ema.Update(kline(3, 0, 0, 3))
+	should.Equal(2.25, v)
+
+	// same results as the batch
+	kls := closes(10, 11, 9, 12, 14, 13, 15, 11, 10, 12)
+	batch := indicators.Series(indicators.NewMACD(3, 6, 2), kls)
+
+	macd := indicators.NewMACD(3, 6, 2)
+	for i, kl := range kls {
+		macd.Update(kline(i+1, 0, 0, kl.GetClosePrice()*2))
+		v, _ := macd.Update(kl)
+		should.InDelta(batch[i].DIF, v.DIF, 1e-12)
+		should.InDelta(batch[i].DEA, v.DEA, 1e-12)
+		should.InDelta(batch[i].MACD, v.MACD, 1e-12)
+	}
+}
+
+func TestRSI(t *testing.T) {
+	should := require.New(t)
+
+	values := indicators.Series(indicators.NewRSI(2), closes(10, 12, 11, 11))
+	should.True(math.IsNaN(values[0]))
+	// up: 2, (0+2)/2=1, (0+1)/2=0.5; abs: 2, (1+2)/2=1.5, (0+1.5)/2=0.75
+	should.InDeltaSlice([]float64{100, 100.0 / 1.5, 50 / 0.75}, values[1:], 1e-9)
+
+	values = indicators.Series(indicators.NewRSI(2), closes(10, 10))
+	should.Equal(50.0, values[1])
+}
+
+func TestMACD(t *testing.T) {
+	should := require.New(t)
+
+	values := indicators.Series(indicators.NewMACD(1, 3, 3), closes(1, 3))
+	should.Equal(indicators.MACDValue{}, values[0])
+	// EMA1 3, EMA3 (2*3+2*1)/4=2, DIF 1, DEA (2+2*0)/4=0.5
+	should.Equal(indicators.MACDValue{DIF: 1, DEA: 0.5, MACD: 1}, values[1])
+}
+
+func TestBOLL(t *testing.T) {
+	should := require.New(t)
+
+	values := indicators.Series(indicators.NewBOLL(3, 2), closes(1, 2, 3, 5))
+	should.True(math.IsNaN(values[1].Middle))
+	should.Equal(indicators.BOLLValue{Upper: 4, Middle: 2, Lower: 0}, values[2])
+
+	mid := 10.0 / 3
+	std := math.Sqrt(((2-mid)*(2-mid) + (3-mid)*(3-mid) + (5-mid)*(5-mid)) / 2)
+	should.InDelta(mid+2*std, values[3].Upper, 1e-12)
+	should.InDelta(mid-2*std, values[3].Lower, 1e-12)
+}
+
+func TestKDJ(t *testing.T) {
+	should := require.New(t)
+
+	kls := []*pb.KLine{
+		kline(1, 10, 8, 9),
+		kline(2, 12, 9, 12),
+		kline(3, 11, 10, 10),
+	}
+	values := indicators.Series(indicators.NewKDJ(2, 3, 3), kls)
+
+	// RSV 50, K 50, D 50
+	should.Equal(indicators.KDJValue{K: 50, D: 50, J: 50}, values[0])
+	// RSV (12-8)/(12-8)=100, K (100+2*50)/3, D (K+2*50)/3
+	k := 200.0 / 3
+	d := (k + 100) / 3
+	should.InDelta(k, values[1].K, 1e-12)
+	should.InDelta(d, values[1].D, 1e-12)
+	should.InDelta(3*k-2*d, values[1].J, 1e-12)
+	// RSV over days 2-3: (10-9)/(12-9)
+	k = (100.0/3 + 2*k) / 3
+	should.InDelta(k, values[2].K, 1e-12)
+}
+
+func TestMatch(t *testing.T) {
+	should := require.New(t)
+
+	kls := closes(5, 4, 3, 4, 6, 8)
+	filter := &pb.CustomIndicatorFilter{
+		FirstFieldName:      pb.CustomIndicatorField_Price.Enum(),
+		SecondFieldName:     pb.CustomIndicatorField_MA.Enum(),
+		SecondFieldParaList: []int32{3},
+		RelativePosition:    pb.RelativePosition_More.Enum(),
+		KlType:              pb.KLType_Day.Enum(),
+		ConsecutivePeriod:   proto.Int32(2),
+	}
+
+	ok, err := indicators.Match(filter, kls)
+	should.NoError(err)
+	should.True(ok)
+
+	filter.ConsecutivePeriod = proto.Int32(4)
+	ok, _ = indicators.Match(filter, kls)
+	should.False(ok)
+
+	filter.ConsecutivePeriod = nil
+	filter.RelativePosition = pb.RelativePosition_CrossUp.Enum()
+	ok, _ = indicators.Match(filter, kls)
+	should.False(ok)
+	ok, _ = indicators.Match(filter, kls[:4])
+	should.True(ok)
+
+	filter.SecondFieldName = pb.CustomIndicatorField_Value.Enum()
+	filter.FieldValue = proto.Float64(7)
+	ok, _ = indicators.Match(filter, kls)
+	should.True(ok)
+
+	filter.SecondFieldName = pb.CustomIndicatorField_EMA.Enum()
+	filter.SecondFieldParaList = nil
+	_, err = indicators.Match(filter, kls)
+	should.ErrorIs(err, indicators.ErrInvalidParams)
+
+	_, err = indicators.NewField(pb.CustomIndicatorField_KDJ_K, []int32{9, 3})
+	should.ErrorIs(err, indicators.ErrInvalidParams)
+	_, err = indicators.NewField(pb.CustomIndicatorField_Value, nil)
+	should.ErrorIs(err, indicators.ErrUnsupportedField)
+
+	rsi, err := indicators.NewField(pb.CustomIndicatorField_RSI, nil)
+	should.NoError(err)
+	v, ok := rsi.Update(kls[0])
+	should.False(ok)
+	should.True(math.IsNaN(v))
+}
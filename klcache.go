@@ -0,0 +1,301 @@
+package futu
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// KLKey identifies a kline series, cached unadjusted.
+type KLKey struct {
+	Security *pb.Security
+	KLType   pb.KLType
+}
+
+func (k KLKey) location() *time.Location {
+	return QotMarketLocation(k.Security.GetMarket())
+}
+
+// format formats t in exchange time, as kline time strings.
+func (k KLKey) format(t time.Time) string {
+	return t.In(k.location()).Format(TimeFormat)
+}
+
+// klRange is an inclusive range of exchange time strings, which sort
+// lexically in TimeFormat.
+type klRange [2]string
+
+type klCacheMeta struct {
+	Ranges []klRange `json:"ranges"`
+}
+
+// KLCache is an on-disk kline cache, keyed by KLKey.
+// Each key has a data file of delimited KLine records, and a meta file
+// of time ranges which are downloaded.
+type KLCache struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+// NewKLCache creates a KLCache in dir, created if not exists.
+func NewKLCache(dir string) (*KLCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &KLCache{dir: dir}, nil
+}
+
+func (c *KLCache) path(key KLKey, ext string) string {
+	name := key.KLType.Name() + ext
+	return filepath.Join(c.dir, NewSecurityCode(key.Security), name)
+}
+
+func (c *KLCache) readMeta(key KLKey) (*klCacheMeta, error) {
+	meta := &klCacheMeta{}
+
+	b, err := os.ReadFile(c.path(key, ".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return meta, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return meta, json.Unmarshal(b, meta)
+}
+
+func (c *KLCache) writeMeta(key KLKey, meta *klCacheMeta) error {
+	b, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(c.path(key, ".json"), b)
+}
+
+func writeFileAtomic(path string, b []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Ranges returns the downloaded time ranges of key.
+func (c *KLCache) Ranges(key KLKey) ([][2]time.Time, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	meta, err := c.readMeta(key)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := [][2]time.Time{}
+	for _, r := range meta.Ranges {
+		b, err := ParseTime(r[0], key.location())
+		if err != nil {
+			return nil, err
+		}
+		e, err := ParseTime(r[1], key.location())
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, [2]time.Time{b, e})
+	}
+	return ranges, nil
+}
+
+// missing returns parts of r not downloaded.
+func (c *KLCache) missing(key KLKey, r klRange) ([]klRange, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	meta, err := c.readMeta(key)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := []klRange{}
+	begin, done := r[0], false
+	for _, covered := range meta.Ranges {
+		if covered[1] < begin {
+			continue
+		}
+		if covered[0] > r[1] {
+			break
+		}
+		if covered[0] > begin {
+			missing = append(missing, klRange{begin, covered[0]})
+		}
+		if begin = covered[1]; begin >= r[1] {
+			done = true
+			break
+		}
+	}
+	if !done {
+		missing = append(missing, klRange{begin, r[1]})
+	}
+	return missing, nil
+}
+
+// add appends klines and marks covered as downloaded. replaced reports
+// whether klines of ranges downloaded before are appended, which leaves
+// replaced records to compact.
+func (c *KLCache) add(key KLKey, kls []*pb.KLine, covered klRange) (replaced bool, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(c.path(key, "")), 0o755); err != nil {
+		return false, err
+	}
+
+	meta, err := c.readMeta(key)
+	if err != nil {
+		return false, err
+	}
+
+	for _, kl := range kls {
+		if klRangesContain(meta.Ranges, kl.GetTime()) {
+			replaced = true
+			break
+		}
+	}
+
+	if len(kls) > 0 {
+		f, err := os.OpenFile(c.path(key, ".kl"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return false, err
+		}
+
+		w := bufio.NewWriter(f)
+		for _, kl := range kls {
+			if _, err := protodelim.MarshalTo(w, kl); err != nil {
+				f.Close()
+				return false, err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			f.Close()
+			return false, err
+		}
+		if err := f.Close(); err != nil {
+			return false, err
+		}
+	}
+
+	// data is written before meta, so ranges are never ahead of data
+	meta.Ranges = mergeKLRanges(append(meta.Ranges, covered))
+	return replaced, c.writeMeta(key, meta)
+}
+
+func klRangesContain(ranges []klRange, tm string) bool {
+	for _, r := range ranges {
+		if r[0] <= tm && tm <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// nextKLTime returns the time string a second after s, so that ranges
+// ending at s and beginning at it are contiguous.
+func nextKLTime(s string) string {
+	t, err := time.Parse(TimeFormat, s)
+	if err != nil {
+		return s
+	}
+	return t.Add(time.Second).Format(TimeFormat)
+}
+
+func mergeKLRanges(ranges []klRange) []klRange {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i][0] < ranges[j][0] })
+
+	merged := []klRange{}
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r[0] <= nextKLTime(merged[n-1][1]) {
+			merged[n-1][1] = max(merged[n-1][1], r[1])
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// readAll reads klines of key, sorted by time. Later records of the same
+// time replace earlier ones, eg: a bar updated by top-up.
+func (c *KLCache) readAll(key KLKey) ([]*pb.KLine, int, error) {
+	f, err := os.Open(c.path(key, ".kl"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	byTime := map[string]*pb.KLine{}
+	records := 0
+	r := bufio.NewReader(f)
+	for {
+		kl := &pb.KLine{}
+		if err := protodelim.UnmarshalFrom(r, kl); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, 0, err
+		}
+		byTime[kl.GetTime()] = kl
+		records++
+	}
+
+	kls := make([]*pb.KLine, 0, len(byTime))
+	for _, kl := range byTime {
+		kls = append(kls, kl)
+	}
+	sort.Slice(kls, func(i, j int) bool { return kls[i].GetTime() < kls[j].GetTime() })
+	return kls, records, nil
+}
+
+// Load returns cached klines of key within [begin, end].
+func (c *KLCache) Load(key KLKey, begin, end time.Time) ([]*pb.KLine, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	kls, _, err := c.readAll(key)
+	if err != nil {
+		return nil, err
+	}
+
+	b, e := key.format(begin), key.format(end)
+	lo := sort.Search(len(kls), func(i int) bool { return kls[i].GetTime() >= b })
+	hi := sort.Search(len(kls), func(i int) bool { return kls[i].GetTime() > e })
+	return kls[lo:hi], nil
+}
+
+// compact rewrites the data file of key without replaced records.
+func (c *KLCache) compact(key KLKey) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	kls, records, err := c.readAll(key)
+	if err != nil || records == len(kls) {
+		return err
+	}
+
+	buf := &bytes.Buffer{}
+	for _, kl := range kls {
+		if _, err := protodelim.MarshalTo(buf, kl); err != nil {
+			return err
+		}
+	}
+	return writeFileAtomic(c.path(key, ".kl"), buf.Bytes())
+}
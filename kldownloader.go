@@ -0,0 +1,214 @@
+package futu
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// KLQuery is klines of a KLKey within [Begin, End], adjusted by RehabType.
+type KLQuery struct {
+	KLKey
+	RehabType pb.RehabType
+	Begin     time.Time
+	End       time.Time
+}
+
+// KLPlan is the result of KLDownloader.Plan.
+// Each security downloaded consumes one history kline quota,
+// unless it is downloaded before within the quota period.
+type KLPlan struct {
+	Cached   []KLQuery // fully cached, no request needed
+	Ready    []KLQuery // security already counted by quota
+	New      []KLQuery // consume new quota
+	Deferred []KLQuery // not enough quota
+	Remain   int32     // quota remaining after New
+}
+
+// KLDownloader downloads history klines with QotRequestHistoryKL into a
+// KLCache. Only ranges not cached are requested, each page is saved as it
+// arrives, so an interrupted download resumes from where it stopped.
+//
+// Klines are cached unadjusted, as forward adjusted prices change with every
+// new corporate action. Get adjusts them on read with rehabs of
+// QotRequestRehab.
+type KLDownloader struct {
+	rh    pb.RequestHandler
+	cache *KLCache
+	now   func() time.Time
+
+	mutex   sync.Mutex
+	limiter *pageLimiter
+}
+
+type KLDownloaderOption func(d *KLDownloader)
+
+// WithKLDownloaderClock sets the clock deciding which ranges may still change, eg: for tests.
+func WithKLDownloaderClock(now func() time.Time) KLDownloaderOption {
+	return func(d *KLDownloader) {
+		d.now = now
+	}
+}
+
+// NewKLDownloader creates a KLDownloader.
+func NewKLDownloader(rh pb.RequestHandler, cache *KLCache, opts ...KLDownloaderOption) *KLDownloader {
+	d := &KLDownloader{
+		rh:      rh,
+		cache:   cache,
+		now:     time.Now,
+		limiter: newPageLimiter(pb.ProtoId_QotRequestHistoryKL),
+	}
+	for _, o := range opts {
+		o(d)
+	}
+	return d
+}
+
+// Cache returns the KLCache of d.
+func (d *KLDownloader) Cache() *KLCache {
+	return d.cache
+}
+
+func (q KLQuery) rangeOf() klRange {
+	return klRange{q.format(q.Begin), q.format(q.End)}
+}
+
+// Plan sorts queries by the history kline quota they need, given the
+// quota reported by QotRequestHistoryKLQuota.
+func (d *KLDownloader) Plan(ctx context.Context, queries []KLQuery) (*KLPlan, error) {
+
+	req := &pb.QotRequestHistoryKLQuotaRequest{
+		BGetDetail: proto.Bool(true),
+	}
+	resp, err := req.Dispatch(ctx, d.rh)
+	if err != nil {
+		return nil, err
+	}
+
+	counted := map[string]bool{}
+	for _, v := range resp.GetDetailList() {
+		counted[NewSecurityCode(v.GetSecurity())] = true
+	}
+
+	plan := &KLPlan{Remain: resp.GetRemainQuota()}
+	planned := map[string]bool{}
+
+	for _, q := range queries {
+		missing, err := d.cache.missing(q.KLKey, q.rangeOf())
+		if err != nil {
+			return nil, err
+		}
+
+		code := NewSecurityCode(q.Security)
+		switch {
+		case len(missing) == 0:
+			plan.Cached = append(plan.Cached, q)
+		case counted[code]:
+			plan.Ready = append(plan.Ready, q)
+		case planned[code]:
+			plan.New = append(plan.New, q)
+		case plan.Remain > 0:
+			plan.Remain--
+			planned[code] = true
+			plan.New = append(plan.New, q)
+		default:
+			plan.Deferred = append(plan.Deferred, q)
+		}
+	}
+
+	return plan, nil
+}
+
+// Run downloads Ready and New queries of plan, stopping at the first error.
+func (d *KLDownloader) Run(ctx context.Context, plan *KLPlan) error {
+	for _, list := range [][]KLQuery{plan.Ready, plan.New} {
+		for _, q := range list {
+			if err := d.Download(ctx, q); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Download downloads ranges of q not cached. Ranges reaching the present
+// are covered up to the last kline, which is refreshed by later downloads.
+// The cache is compacted if klines cached before are downloaded again.
+func (d *KLDownloader) Download(ctx context.Context, q KLQuery) error {
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	key := q.KLKey
+	missing, err := d.cache.missing(key, q.rangeOf())
+	if err != nil {
+		return err
+	}
+
+	now := q.format(d.now())
+	replaced := false
+
+	for _, r := range missing {
+		req := &pb.QotRequestHistoryKLRequest{
+			RehabType: pb.RehabType_None.Enum(),
+			KlType:    q.KLType.Enum(),
+			Security:  q.Security,
+			BeginTime: proto.String(r[0]),
+			EndTime:   proto.String(r[1]),
+		}
+
+		for resp, err := range historyKLPages(ctx, d.rh, req, d.limiter) {
+			if err != nil {
+				return err
+			}
+
+			kls := resp.GetKlList()
+			covered := klRange{r[0], r[1]}
+			more := len(resp.GetNextReqKey()) > 0
+			if more || r[1] > now {
+				switch {
+				case len(kls) > 0:
+					covered[1] = kls[len(kls)-1].GetTime()
+				case more:
+					covered[1] = r[0]
+				default:
+					covered[1] = now
+				}
+			}
+
+			added, err := d.cache.add(key, kls, covered)
+			if err != nil {
+				return err
+			}
+			replaced = replaced || added
+		}
+	}
+
+	if !replaced {
+		return nil
+	}
+	return d.cache.compact(key)
+}
+
+// Get returns klines of q, downloading ranges not cached,
+// adjusted by current rehabs unless q.RehabType is RehabType_None.
+func (d *KLDownloader) Get(ctx context.Context, q KLQuery) ([]*pb.KLine, error) {
+	if err := d.Download(ctx, q); err != nil {
+		return nil, err
+	}
+
+	kls, err := d.cache.Load(q.KLKey, q.Begin, q.End)
+	if err != nil || q.RehabType == pb.RehabType_None {
+		return kls, err
+	}
+
+	req := &pb.QotRequestRehabRequest{Security: q.Security}
+	resp, err := req.Dispatch(ctx, d.rh)
+	if err != nil {
+		return nil, err
+	}
+	return AdjustKLines(kls, resp.GetRehabList(), q.RehabType), nil
+}
@@ -0,0 +1,239 @@
+package futu_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// klServer serves daily klines of 2024-01-01..2024-01-12, 6 per page.
+func klServer(m *pbtest.Mock, fail *bool) {
+	m.OnQotRequestHistoryKL(func(req *pb.QotRequestHistoryKLRequest) (*pb.QotRequestHistoryKLResponse, error) {
+		if req.GetRehabType() != pb.RehabType_None {
+			return nil, errors.New("adjusted klines requested")
+		}
+
+		kls := []*pb.KLine{}
+		for d := 1; d <= 12; d++ {
+			tm := fmt.Sprintf("2024-01-%02d 00:00:00", d)
+			if tm >= req.GetBeginTime() && tm <= req.GetEndTime() {
+				kls = append(kls, &pb.KLine{
+					Time:       proto.String(tm),
+					IsBlank:    proto.Bool(false),
+					ClosePrice: proto.Float64(float64(d)),
+				})
+			}
+		}
+
+		offset := 0
+		if key := req.GetNextReqKey(); len(key) > 0 {
+			if *fail {
+				*fail = false
+				return nil, errors.New("connection lost")
+			}
+			offset, _ = strconv.Atoi(string(key))
+		}
+
+		resp := &pb.QotRequestHistoryKLResponse{Security: req.GetSecurity()}
+		end := min(offset+6, len(kls))
+		resp.KlList = kls[offset:end]
+		if end < len(kls) {
+			resp.NextReqKey = []byte(strconv.Itoa(end))
+		}
+		return resp, nil
+	})
+}
+
+func day(d int) time.Time {
+	return time.Date(2024, 1, d, 0, 0, 0, 0, futu.LocHongKong)
+}
+
+func TestKLDownloader(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	fail := true
+	m := pbtest.NewMock()
+	klServer(m, &fail)
+	m.OnQotRequestRehab(func(req *pb.QotRequestRehabRequest) (*pb.QotRequestRehabResponse, error) {
+		// 1 split into 2 on 2024-01-08
+		return &pb.QotRequestRehabResponse{RehabList: []*pb.Rehab{{
+			Time:           proto.String("2024-01-08"),
+			CompanyActFlag: proto.Int64(int64(pb.CompanyAct_Split)),
+			FwdFactorA:     proto.Float64(0.5),
+			FwdFactorB:     proto.Float64(0),
+		}}}, nil
+	})
+
+	dir := t.TempDir()
+	cache, err := futu.NewKLCache(dir)
+	should.NoError(err)
+
+	now := day(12).Add(12 * time.Hour)
+	d := futu.NewKLDownloader(m, cache, futu.WithKLDownloaderClock(func() time.Time { return now }))
+
+	q := futu.KLQuery{
+		KLKey: futu.KLKey{
+			Security: futu.NewSecurity("HK.00700"),
+			KLType:   pb.KLType_Day,
+		},
+		RehabType: pb.RehabType_Forward,
+		Begin:     day(1),
+		End:       day(10),
+	}
+	key := q.KLKey
+
+	// interrupted after the first page
+	should.Error(d.Download(ctx, q))
+	ranges, err := cache.Ranges(key)
+	should.NoError(err)
+	should.Len(ranges, 1)
+	should.True(day(6).Equal(ranges[0][1]))
+
+	// resumed from the last kline saved
+	kls, err := d.Get(ctx, q)
+	should.NoError(err)
+	should.Len(kls, 10)
+	calls := m.QotRequestHistoryKLCalls()
+	should.Equal("2024-01-06 00:00:00", calls[len(calls)-1].GetBeginTime())
+
+	// served from cache
+	n := len(m.QotRequestHistoryKLCalls())
+	kls, err = d.Get(ctx, futu.KLQuery{KLKey: q.KLKey, RehabType: q.RehabType, Begin: day(3), End: day(5)})
+	should.NoError(err)
+	should.Len(kls, 3)
+	should.Equal(1.5, kls[0].GetClosePrice())
+	should.Len(m.QotRequestHistoryKLCalls(), n)
+
+	// cached unadjusted, whatever the RehabType
+	cached, err := cache.Load(key, day(3), day(3))
+	should.NoError(err)
+	should.Equal(3.0, cached[0].GetClosePrice())
+	should.FileExists(filepath.Join(dir, "HK.00700", "Day.kl"))
+
+	// incremental top-up, reaching the present, compacts the kline
+	// at the end of the cached range which is downloaded again
+	before, err := os.Stat(filepath.Join(dir, "HK.00700", "Day.kl"))
+	should.NoError(err)
+	q.End = day(15)
+	kls, err = d.Get(ctx, q)
+	should.NoError(err)
+	should.Len(kls, 12)
+	calls = m.QotRequestHistoryKLCalls()
+	should.Len(calls, n+1)
+	should.Equal("2024-01-10 00:00:00", calls[n].GetBeginTime())
+
+	ranges, err = cache.Ranges(key)
+	should.NoError(err)
+	should.Len(ranges, 1)
+	should.True(day(12).Equal(ranges[0][1]))
+
+	after, err := os.Stat(filepath.Join(dir, "HK.00700", "Day.kl"))
+	should.NoError(err)
+	should.False(os.SameFile(before, after))
+}
+
+func TestKLDownloaderAdjacent(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	fail := false
+	m := pbtest.NewMock()
+	klServer(m, &fail)
+
+	dir := t.TempDir()
+	cache, err := futu.NewKLCache(dir)
+	should.NoError(err)
+	d := futu.NewKLDownloader(m, cache, futu.WithKLDownloaderClock(func() time.Time { return day(20) }))
+
+	key := futu.KLKey{
+		Security: futu.NewSecurity("HK.00700"),
+		KLType:   pb.KLType_Day,
+	}
+	should.NoError(d.Download(ctx, futu.KLQuery{KLKey: key, Begin: day(1), End: day(3).Add(-time.Second)}))
+	before, err := os.Stat(filepath.Join(dir, "HK.00700", "Day.kl"))
+	should.NoError(err)
+	should.NoError(d.Download(ctx, futu.KLQuery{KLKey: key, Begin: day(3), End: day(5)}))
+
+	// appended without compaction
+	after, err := os.Stat(filepath.Join(dir, "HK.00700", "Day.kl"))
+	should.NoError(err)
+	should.True(os.SameFile(before, after))
+
+	ranges, err := cache.Ranges(key)
+	should.NoError(err)
+	should.Len(ranges, 1)
+	should.True(day(1).Equal(ranges[0][0]))
+	should.True(day(5).Equal(ranges[0][1]))
+
+	// no gap left between the downloads
+	n := len(m.QotRequestHistoryKLCalls())
+	kls, err := d.Get(ctx, futu.KLQuery{KLKey: key, Begin: day(1), End: day(5)})
+	should.NoError(err)
+	should.Len(kls, 5)
+	should.Len(m.QotRequestHistoryKLCalls(), n)
+}
+
+func TestKLDownloaderPlan(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	m := pbtest.NewMock()
+	m.OnQotRequestHistoryKLQuota(func(*pb.QotRequestHistoryKLQuotaRequest) (*pb.QotRequestHistoryKLQuotaResponse, error) {
+		return &pb.QotRequestHistoryKLQuotaResponse{
+			UsedQuota:   proto.Int32(99),
+			RemainQuota: proto.Int32(1),
+			DetailList: []*pb.DetailItem{{
+				Security:    futu.NewSecurity("HK.00700"),
+				RequestTime: proto.String("2024-01-01 00:00:00"),
+			}},
+		}, nil
+	})
+
+	cache, err := futu.NewKLCache(t.TempDir())
+	should.NoError(err)
+	d := futu.NewKLDownloader(m, cache)
+
+	query := func(code string, t pb.KLType) futu.KLQuery {
+		return futu.KLQuery{
+			KLKey: futu.KLKey{Security: futu.NewSecurity(code), KLType: t},
+			Begin: day(1),
+			End:   day(10),
+		}
+	}
+
+	plan, err := d.Plan(ctx, []futu.KLQuery{
+		query("HK.00700", pb.KLType_Day),
+		query("HK.09988", pb.KLType_Day),
+		query("HK.09988", pb.KLType_60Min),
+		query("HK.03690", pb.KLType_Day),
+	})
+	should.NoError(err)
+	should.True(m.QotRequestHistoryKLQuotaCalls()[0].GetBGetDetail())
+
+	should.Len(plan.Ready, 1)
+	should.Len(plan.New, 2)
+	should.Len(plan.Deferred, 1)
+	should.Equal("03690", plan.Deferred[0].Security.GetCode())
+	should.Equal(int32(0), plan.Remain)
+
+	// cached queries need no quota
+	fail := false
+	klServer(m, &fail)
+	should.NoError(d.Download(ctx, query("HK.03690", pb.KLType_Day)))
+
+	plan, err = d.Plan(ctx, []futu.KLQuery{query("HK.03690", pb.KLType_Day)})
+	should.NoError(err)
+	should.Len(plan.Cached, 1)
+}
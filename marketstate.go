@@ -0,0 +1,278 @@
+package futu
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/santsai/futu-go/pb"
+)
+
+// MarketStateEvent is a market state transition of a security.
+type MarketStateEvent struct {
+	Security *pb.Security
+	From     pb.QotMarketState
+	To       pb.QotMarketState
+	Time     time.Time
+}
+
+type marketWatcherOptions struct {
+	slow      time.Duration
+	fast      time.Duration
+	window    time.Duration
+	calendars map[pb.QotMarket]*Calendar
+	now       func() time.Time
+}
+
+type MarketWatcherOption func(o *marketWatcherOptions)
+
+// WithPollInterval sets poll intervals, slow normally and fast around
+// expected transitions. Defaults to 1 minute and 5 seconds.
+func WithPollInterval(slow, fast time.Duration) MarketWatcherOption {
+	return func(o *marketWatcherOptions) {
+		o.slow, o.fast = slow, fast
+	}
+}
+
+// WithTransitionWindow sets how long before and after a session boundary
+// is polled fast, defaults to 1 minute.
+func WithTransitionWindow(d time.Duration) MarketWatcherOption {
+	return func(o *marketWatcherOptions) {
+		o.window = d
+	}
+}
+
+// WithMarketCalendar sets the calendar of market for expected transitions,
+// nil to poll market without calendar. Calendars of HK, US and CN markets
+// are created by default.
+func WithMarketCalendar(market pb.QotMarket, c *Calendar) MarketWatcherOption {
+	return func(o *marketWatcherOptions) {
+		o.calendars[market] = c
+	}
+}
+
+// WithMarketWatcherClock sets the clock of event times and polling, eg: for tests.
+func WithMarketWatcherClock(now func() time.Time) MarketWatcherOption {
+	return func(o *marketWatcherOptions) {
+		o.now = now
+	}
+}
+
+func tradeDateMarketOf(m pb.QotMarket) (pb.TradeDateMarket, bool) {
+	switch m {
+	case pb.QotMarket_HK_Security, pb.QotMarket_HK_Future:
+		return pb.TradeDateMarket_HK, true
+	case pb.QotMarket_US_Security:
+		return pb.TradeDateMarket_US, true
+	case pb.QotMarket_CNSH_Security, pb.QotMarket_CNSZ_Security:
+		return pb.TradeDateMarket_CN, true
+	}
+	return 0, false
+}
+
+// MarketWatcher polls QotGetMarketState of securities, eg: one per market,
+// and emits transitions. States are set silently by the first poll.
+type MarketWatcher struct {
+	marketWatcherOptions
+	rh         pb.RequestHandler
+	securities []*pb.Security
+
+	mutex      sync.Mutex
+	states     map[string]pb.QotMarketState // by security code
+	boundaries map[*Calendar]sessionBoundaries
+	onChange   listeners[MarketStateEvent]
+}
+
+// sessionBoundaries are session starts & ends of a date and the next, sorted.
+type sessionBoundaries struct {
+	date  string
+	times []time.Time
+}
+
+// NewMarketWatcher creates a MarketWatcher of securities.
+func NewMarketWatcher(rh pb.RequestHandler, securities []*pb.Security, opts ...MarketWatcherOption) *MarketWatcher {
+	o := marketWatcherOptions{
+		slow:      time.Minute,
+		fast:      5 * time.Second,
+		window:    time.Minute,
+		calendars: map[pb.QotMarket]*Calendar{},
+		now:       time.Now,
+	}
+
+	for _, sec := range securities {
+		m := sec.GetMarket()
+		if _, ok := o.calendars[m]; ok {
+			continue
+		}
+		if dm, ok := tradeDateMarketOf(m); ok {
+			o.calendars[m] = NewCalendar(rh, dm)
+		}
+	}
+
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	return &MarketWatcher{
+		marketWatcherOptions: o,
+		rh:                   rh,
+		securities:           securities,
+		states:               map[string]pb.QotMarketState{},
+		boundaries:           map[*Calendar]sessionBoundaries{},
+	}
+}
+
+// OnChange adds a callback for transitions, called on the polling goroutine.
+func (w *MarketWatcher) OnChange(fn func(MarketStateEvent)) {
+	w.onChange.add(fn)
+}
+
+// State returns the last polled state of security.
+func (w *MarketWatcher) State(security *pb.Security) (pb.QotMarketState, bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	s, ok := w.states[NewSecurityCode(security)]
+	return s, ok
+}
+
+// Poll requests market states once, and emits transitions.
+// Transitions of pages polled before an error are still emitted.
+func (w *MarketWatcher) Poll(ctx context.Context) error {
+
+	size := pageSize(pb.ProtoId_QotGetMarketState, 0)
+	events := []MarketStateEvent{}
+
+	// states are committed page by page, so are their events
+	defer func() {
+		for _, ev := range events {
+			w.onChange.call(ev)
+		}
+	}()
+
+	for begin := 0; begin < len(w.securities); begin += int(size) {
+		end := min(begin+int(size), len(w.securities))
+
+		req := &pb.QotGetMarketStateRequest{
+			SecurityList: w.securities[begin:end],
+		}
+		resp, err := req.Dispatch(ctx, w.rh)
+		if err != nil {
+			return err
+		}
+
+		now := w.now()
+
+		w.mutex.Lock()
+		for _, info := range resp.GetMarketInfoList() {
+			code := NewSecurityCode(info.GetSecurity())
+			to := info.GetMarketState()
+			from, ok := w.states[code]
+			w.states[code] = to
+
+			if ok && from != to {
+				events = append(events, MarketStateEvent{
+					Security: info.GetSecurity(),
+					From:     from,
+					To:       to,
+					Time:     now,
+				})
+			}
+		}
+		w.mutex.Unlock()
+	}
+	return nil
+}
+
+// PollInterval returns how long to wait before the next poll at now:
+// fast within the transition window of a session boundary, otherwise
+// until the next window, at most the slow interval.
+func (w *MarketWatcher) PollInterval(ctx context.Context, now time.Time) time.Duration {
+
+	wait := w.slow
+	seen := map[*Calendar]bool{}
+
+	for _, sec := range w.securities {
+		c := w.calendars[sec.GetMarket()]
+		if c == nil || seen[c] {
+			continue
+		}
+		seen[c] = true
+
+		times, err := w.sessionBoundaries(ctx, c, now)
+		if err != nil {
+			log.Warn().Err(err).Stringer("market", sec.GetMarket()).Msg("MarketWatcher: calendar error")
+			continue
+		}
+
+		// the first boundary not passed by the window
+		i := sort.Search(len(times), func(i int) bool {
+			return times[i].Sub(now) >= -w.window
+		})
+		if i == len(times) {
+			continue
+		}
+
+		d := times[i].Sub(now)
+		if d <= w.window {
+			return w.fast
+		}
+		wait = min(wait, d-w.window)
+	}
+
+	return max(wait, w.fast)
+}
+
+// sessionBoundaries returns boundaries of c for the date of now, computed
+// once per date. Failures are not kept, and retried by the next call.
+func (w *MarketWatcher) sessionBoundaries(ctx context.Context, c *Calendar, now time.Time) ([]time.Time, error) {
+
+	date := now.In(c.Location()).Format(DateFormat)
+
+	w.mutex.Lock()
+	b, ok := w.boundaries[c]
+	w.mutex.Unlock()
+	if ok && b.date == date {
+		return b.times, nil
+	}
+
+	times := []time.Time{}
+	for _, day := range []time.Time{now, now.AddDate(0, 0, 1)} {
+		sessions, err := c.Sessions(ctx, day)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range sessions {
+			times = append(times, s.Start, s.End)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	w.mutex.Lock()
+	w.boundaries[c] = sessionBoundaries{date: date, times: times}
+	w.mutex.Unlock()
+
+	return times, nil
+}
+
+// Run polls until ctx is done. Poll errors are logged and retried.
+func (w *MarketWatcher) Run(ctx context.Context) error {
+	for {
+		if err := w.Poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Warn().Err(err).Msg("MarketWatcher: GetMarketState error")
+		}
+
+		timer := time.NewTimer(w.PollInterval(ctx, w.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package futu_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMarketWatcher(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	var (
+		mutex sync.Mutex
+		state = pb.QotMarketState_Morning
+	)
+
+	m := pbtest.NewMock()
+	m.OnQotGetMarketState(func(req *pb.QotGetMarketStateRequest) (*pb.QotGetMarketStateResponse, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		resp := &pb.QotGetMarketStateResponse{}
+		for _, sec := range req.GetSecurityList() {
+			resp.MarketInfoList = append(resp.MarketInfoList, &pb.MarketInfo{
+				Security:    sec,
+				Name:        proto.String(sec.GetCode()),
+				MarketState: state.Enum(),
+			})
+		}
+		return resp, nil
+	})
+	tradeDates(m, nil, nil)
+
+	tencent := futu.NewSecurity("HK.00700")
+	w := futu.NewMarketWatcher(m, []*pb.Security{tencent},
+		futu.WithPollInterval(time.Hour, 5*time.Second))
+
+	events := make(chan futu.MarketStateEvent, 10)
+	w.OnChange(func(ev futu.MarketStateEvent) { events <- ev })
+
+	_, ok := w.State(tencent)
+	should.False(ok)
+
+	// first poll sets the state silently
+	should.NoError(w.Poll(ctx))
+	s, ok := w.State(tencent)
+	should.True(ok)
+	should.Equal(pb.QotMarketState_Morning, s)
+	should.Len(events, 0)
+
+	mutex.Lock()
+	state = pb.QotMarketState_Rest
+	mutex.Unlock()
+
+	should.NoError(w.Poll(ctx))
+	ev := <-events
+	should.Equal(pb.QotMarketState_Morning, ev.From)
+	should.Equal(pb.QotMarketState_Rest, ev.To)
+	should.Equal("00700", ev.Security.GetCode())
+
+	// polls fast around session boundaries of the calendar
+	at := func(h, min int) time.Time {
+		return time.Date(2024, 3, 28, h, min, 0, 0, futu.LocHongKong)
+	}
+	should.Equal(5*time.Second, w.PollInterval(ctx, at(11, 59)))
+	should.Equal(5*time.Second, w.PollInterval(ctx, at(12, 0)))
+	should.Equal(time.Hour, w.PollInterval(ctx, at(10, 30)))
+	should.Equal(29*time.Minute, w.PollInterval(ctx, at(12, 30)))
+	should.Equal(time.Hour, w.PollInterval(ctx, at(20, 0)))
+
+	// no calendar
+	w = futu.NewMarketWatcher(m, []*pb.Security{tencent},
+		futu.WithPollInterval(time.Minute, 5*time.Second),
+		futu.WithMarketCalendar(pb.QotMarket_HK_Security, nil))
+	should.Equal(time.Minute, w.PollInterval(ctx, at(11, 59)))
+}
+
+func TestMarketWatcherCalendarError(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	m := pbtest.NewMock()
+	m.OnQotRequestTradeDate(func(*pb.QotRequestTradeDateRequest) (*pb.QotRequestTradeDateResponse, error) {
+		return nil, errors.New("connection lost")
+	})
+
+	tencent := futu.NewSecurity("HK.00700")
+	w := futu.NewMarketWatcher(m, []*pb.Security{tencent},
+		futu.WithPollInterval(time.Hour, 5*time.Second))
+
+	// polls slowly without boundaries
+	now := time.Date(2024, 3, 28, 11, 59, 0, 0, futu.LocHongKong)
+	should.Equal(time.Hour, w.PollInterval(ctx, now))
+
+	// failures are retried
+	tradeDates(m, nil, nil)
+	should.Equal(5*time.Second, w.PollInterval(ctx, now))
+}
+
+func TestMarketWatcherPollError(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	state, failAt := pb.QotMarketState_Morning, -1
+	m := pbtest.NewMock()
+	m.OnQotGetMarketState(func(req *pb.QotGetMarketStateRequest) (*pb.QotGetMarketStateResponse, error) {
+		if failAt == 0 {
+			return nil, errors.New("connection lost")
+		}
+		failAt--
+
+		resp := &pb.QotGetMarketStateResponse{}
+		for _, sec := range req.GetSecurityList() {
+			resp.MarketInfoList = append(resp.MarketInfoList, &pb.MarketInfo{
+				Security:    sec,
+				MarketState: state.Enum(),
+			})
+		}
+		return resp, nil
+	})
+
+	// two pages
+	securities := []*pb.Security{}
+	for i := range 401 {
+		securities = append(securities, futu.NewSecurity(fmt.Sprintf("HK.%05d", i)))
+	}
+	w := futu.NewMarketWatcher(m, securities, futu.WithMarketCalendar(pb.QotMarket_HK_Security, nil))
+
+	events := 0
+	w.OnChange(func(ev futu.MarketStateEvent) { events++ })
+	should.NoError(w.Poll(ctx))
+
+	// the second page fails, transitions of the first are emitted
+	state, failAt = pb.QotMarketState_Rest, 1
+	should.Error(w.Poll(ctx))
+	should.Equal(400, events)
+
+	s, _ := w.State(securities[0])
+	should.Equal(pb.QotMarketState_Rest, s)
+	s, _ = w.State(securities[400])
+	should.Equal(pb.QotMarketState_Morning, s)
+}
+
+func TestMarketWatcherRun(t *testing.T) {
+	should := require.New(t)
+
+	var (
+		mutex sync.Mutex
+		polls int
+	)
+
+	m := pbtest.NewMock()
+	m.OnQotGetMarketState(func(req *pb.QotGetMarketStateRequest) (*pb.QotGetMarketStateResponse, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		polls++
+		state := pb.QotMarketState_PreMarketBegin
+		if polls > 1 {
+			state = pb.QotMarketState_Morning
+		}
+		return &pb.QotGetMarketStateResponse{
+			MarketInfoList: []*pb.MarketInfo{{
+				Security:    req.GetSecurityList()[0],
+				Name:        proto.String("AAPL"),
+				MarketState: state.Enum(),
+			}},
+		}, nil
+	})
+
+	apple := futu.NewSecurity("US.AAPL")
+	w := futu.NewMarketWatcher(m, []*pb.Security{apple},
+		futu.WithPollInterval(10*time.Millisecond, 10*time.Millisecond),
+		futu.WithMarketCalendar(pb.QotMarket_US_Security, nil))
+
+	events := make(chan futu.MarketStateEvent, 10)
+	w.OnChange(func(ev futu.MarketStateEvent) { events <- ev })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- w.Run(ctx) }()
+
+	select {
+	case ev := <-events:
+		should.Equal(pb.QotMarketState_Morning, ev.To)
+	case <-time.After(time.Second):
+		should.Fail("no event")
+	}
+
+	cancel()
+	should.ErrorIs(<-done, context.Canceled)
+}
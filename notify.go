@@ -0,0 +1,213 @@
+package futu
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/santsai/futu-go/pb"
+)
+
+type listeners[T any] struct {
+	mutex sync.Mutex
+	fns   []func(T)
+}
+
+func (l *listeners[T]) add(fn func(T)) {
+	l.mutex.Lock()
+	l.fns = append(l.fns, fn)
+	l.mutex.Unlock()
+}
+
+func (l *listeners[T]) call(v T) {
+	l.mutex.Lock()
+	fns := l.fns
+	l.mutex.Unlock()
+
+	for _, fn := range fns {
+		fn(v)
+	}
+}
+
+// NotifyDispatcher dispatches Notify pushes to typed callbacks by NotifyType.
+// Callbacks are called on response workers, and should not block.
+type NotifyDispatcher struct {
+	onNotify        listeners[*pb.NotifyResponse]
+	onGtwEvent      listeners[*pb.GtwEventNotice]
+	onProgramStatus listeners[*pb.ProgramStatus]
+	onConnectStatus listeners[*pb.ConnectStatusNotice]
+	onQotRight      listeners[*pb.QotRightNotice]
+	onAPIQuota      listeners[*pb.APIQuotaNotice]
+	onUsedQuota     listeners[*pb.UsedQuotaNotice]
+}
+
+// OnNotify adds a callback for all Notify pushes.
+func (d *NotifyDispatcher) OnNotify(fn func(*pb.NotifyResponse)) {
+	d.onNotify.add(fn)
+}
+
+// OnGtwEvent adds a callback for NotifyType_GtwEvent.
+func (d *NotifyDispatcher) OnGtwEvent(fn func(*pb.GtwEventNotice)) {
+	d.onGtwEvent.add(fn)
+}
+
+// OnProgramStatus adds a callback for NotifyType_ProgramStatus.
+func (d *NotifyDispatcher) OnProgramStatus(fn func(*pb.ProgramStatus)) {
+	d.onProgramStatus.add(fn)
+}
+
+// OnConnectStatus adds a callback for NotifyType_ConnStatus.
+func (d *NotifyDispatcher) OnConnectStatus(fn func(*pb.ConnectStatusNotice)) {
+	d.onConnectStatus.add(fn)
+}
+
+// OnQotRight adds a callback for NotifyType_QotRight.
+func (d *NotifyDispatcher) OnQotRight(fn func(*pb.QotRightNotice)) {
+	d.onQotRight.add(fn)
+}
+
+// OnAPIQuota adds a callback for NotifyType_APIQuota.
+func (d *NotifyDispatcher) OnAPIQuota(fn func(*pb.APIQuotaNotice)) {
+	d.onAPIQuota.add(fn)
+}
+
+// OnUsedQuota adds a callback for NotifyType_UsedQuota.
+func (d *NotifyDispatcher) OnUsedQuota(fn func(*pb.UsedQuotaNotice)) {
+	d.onUsedQuota.add(fn)
+}
+
+// DispatchNotify calls the callbacks matching msg's NotifyType.
+func (d *NotifyDispatcher) DispatchNotify(msg *pb.NotifyResponse) {
+
+	d.onNotify.call(msg)
+
+	switch msg.GetType() {
+	case pb.NotifyType_GtwEvent:
+		if v := msg.GetEvent(); v != nil {
+			d.onGtwEvent.call(v)
+		}
+	case pb.NotifyType_ProgramStatus:
+		if v := msg.GetProgramStatus().GetProgramStatus(); v != nil {
+			d.onProgramStatus.call(v)
+		}
+	case pb.NotifyType_ConnStatus:
+		if v := msg.GetConnectStatus(); v != nil {
+			d.onConnectStatus.call(v)
+		}
+	case pb.NotifyType_QotRight:
+		if v := msg.GetQotRight(); v != nil {
+			d.onQotRight.call(v)
+		}
+	case pb.NotifyType_APIQuota:
+		if v := msg.GetApiQuota(); v != nil {
+			d.onAPIQuota.call(v)
+		}
+	case pb.NotifyType_UsedQuota:
+		if v := msg.GetUsedQuota(); v != nil {
+			d.onUsedQuota.call(v)
+		}
+	}
+}
+
+// OnNotify adds a callback for all Notify pushes.
+func (client *Client) OnNotify(fn func(*pb.NotifyResponse)) {
+	client.notify.OnNotify(fn)
+}
+
+// OnGtwEvent adds a callback for NotifyType_GtwEvent.
+func (client *Client) OnGtwEvent(fn func(*pb.GtwEventNotice)) {
+	client.notify.OnGtwEvent(fn)
+}
+
+// OnProgramStatus adds a callback for NotifyType_ProgramStatus.
+func (client *Client) OnProgramStatus(fn func(*pb.ProgramStatus)) {
+	client.notify.OnProgramStatus(fn)
+}
+
+// OnConnectStatus adds a callback for NotifyType_ConnStatus.
+func (client *Client) OnConnectStatus(fn func(*pb.ConnectStatusNotice)) {
+	client.notify.OnConnectStatus(fn)
+}
+
+// OnQotRight adds a callback for NotifyType_QotRight.
+func (client *Client) OnQotRight(fn func(*pb.QotRightNotice)) {
+	client.notify.OnQotRight(fn)
+}
+
+// OnAPIQuota adds a callback for NotifyType_APIQuota.
+func (client *Client) OnAPIQuota(fn func(*pb.APIQuotaNotice)) {
+	client.notify.OnAPIQuota(fn)
+}
+
+// OnUsedQuota adds a callback for NotifyType_UsedQuota.
+func (client *Client) OnUsedQuota(fn func(*pb.UsedQuotaNotice)) {
+	client.notify.OnUsedQuota(fn)
+}
+
+// built-in reactions to gateway events
+func (client *Client) onGtwEvent(ev *pb.GtwEventNotice) {
+	switch ev.GetEventType() {
+	case pb.GtwEventType_KickedOut,
+		pb.GtwEventType_LoginPwdChanged,
+		pb.GtwEventType_BanLogin:
+		client.setUnusable(fmt.Errorf("%w: %s: %s",
+			ErrClientUnusable, ev.GetEventType(), ev.GetDesc()))
+
+	case pb.GtwEventType_TradePwdChanged:
+		client.trdLocked.Store(true)
+	}
+}
+
+func (client *Client) setUnusable(err error) {
+	client.stateMutex.Lock()
+	if client.unusableErr == nil {
+		client.unusableErr = err
+	}
+	client.stateMutex.Unlock()
+}
+
+// Err returns non-nil if OpenD was logged out, eg: kicked out by
+// another login. A new client is needed after OpenD logs in again.
+func (client *Client) Err() error {
+	client.stateMutex.Lock()
+	defer client.stateMutex.Unlock()
+	return client.unusableErr
+}
+
+// TradeLocked reports whether trading is locked as far as this client knows.
+// OpenD has no query of the unlock state, so it is true until TrdUnlockTrade
+// succeeds on this client, even if OpenD was unlocked by another client,
+// and again after the trade password was changed.
+func (client *Client) TradeLocked() bool {
+	return client.trdLocked.Load()
+}
+
+// QotRight returns the quote rights got at connect, or the latest pushed by
+// OpenD. nil if GetUserInfo failed at connect and nothing was pushed.
+func (client *Client) QotRight() *pb.QotRightNotice {
+	return client.qotRight.Load()
+}
+
+// qotRightNotice converts quote rights of GetUserInfo to QotRightNotice.
+func qotRightNotice(info *pb.GetUserInfoResponse) *pb.QotRightNotice {
+	return &pb.QotRightNotice{
+		HkQotRight:            info.HkQotRight,
+		UsQotRight:            info.UsQotRight,
+		CnQotRight:            info.CnQotRight,
+		HkOptionQotRight:      info.HkOptionQotRight,
+		HasUSOptionQotRight:   info.HasUSOptionQotRight,
+		HkFutureQotRight:      info.HkFutureQotRight,
+		UsFutureQotRight:      info.UsFutureQotRight,
+		UsOptionQotRight:      info.UsOptionQotRight,
+		UsIndexQotRight:       info.UsIndexQotRight,
+		UsOtcQotRight:         info.UsOtcQotRight,
+		SgFutureQotRight:      info.SgFutureQotRight,
+		JpFutureQotRight:      info.JpFutureQotRight,
+		UsCMEFutureQotRight:   info.UsCMEFutureQotRight,
+		UsCBOTFutureQotRight:  info.UsCBOTFutureQotRight,
+		UsNYMEXFutureQotRight: info.UsNYMEXFutureQotRight,
+		UsCOMEXFutureQotRight: info.UsCOMEXFutureQotRight,
+		UsCBOEFutureQotRight:  info.UsCBOEFutureQotRight,
+		ShQotRight:            info.ShQotRight,
+		SzQotRight:            info.SzQotRight,
+	}
+}
@@ -0,0 +1,212 @@
+package futu_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNotifyDispatcher(t *testing.T) {
+	should := require.New(t)
+
+	var (
+		d        futu.NotifyDispatcher
+		all      int
+		events   []pb.GtwEventType
+		qotRight *pb.QotRightNotice
+	)
+
+	d.OnNotify(func(*pb.NotifyResponse) { all++ })
+	d.OnGtwEvent(func(ev *pb.GtwEventNotice) { events = append(events, ev.GetEventType()) })
+	d.OnQotRight(func(v *pb.QotRightNotice) { qotRight = v })
+
+	d.DispatchNotify(&pb.NotifyResponse{
+		Type: pb.NotifyType_GtwEvent.Enum(),
+		Event: &pb.GtwEventNotice{
+			EventType: pb.GtwEventType_KickedOut.Enum(),
+			Desc:      proto.String("kicked out"),
+		},
+	})
+
+	d.DispatchNotify(&pb.NotifyResponse{
+		Type: pb.NotifyType_QotRight.Enum(),
+		QotRight: &pb.QotRightNotice{
+			HkQotRight: pb.QotRight_Level2.Enum(),
+		},
+	})
+
+	// type mismatched with content
+	d.DispatchNotify(&pb.NotifyResponse{
+		Type: pb.NotifyType_ConnStatus.Enum(),
+		Event: &pb.GtwEventNotice{
+			EventType: pb.GtwEventType_BanLogin.Enum(),
+		},
+	})
+
+	should.Equal(3, all)
+	should.Equal([]pb.GtwEventType{pb.GtwEventType_KickedOut}, events)
+	should.Equal(pb.QotRight_Level2, qotRight.GetHkQotRight())
+}
+
+type openDHeader struct {
+	HeaderFlag   [2]byte
+	ProtoID      pb.ProtoId
+	ProtoFmtType uint8
+	ProtoVer     uint8
+	SerialNo     uint32
+	BodyLen      uint32
+	BodySHA1     [20]byte
+	Reserved     [8]byte
+}
+
+// fakeOpenD answers InitConnect, GetUserInfo and TrdUnlockTrade of one
+// connection without encryption, and sends pushes.
+type fakeOpenD struct {
+	ln    net.Listener
+	mutex sync.Mutex
+	conn  net.Conn
+}
+
+func newFakeOpenD(should *require.Assertions) *fakeOpenD {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	should.NoError(err)
+
+	f := &fakeOpenD{ln: ln}
+	go f.serve()
+	return f
+}
+
+func (f *fakeOpenD) Close() {
+	f.ln.Close()
+}
+
+func (f *fakeOpenD) serve() {
+	conn, err := f.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	f.mutex.Lock()
+	f.conn = conn
+	f.mutex.Unlock()
+
+	succeed := pb.RetType_RetType_Succeed.Enum()
+	for {
+		var h openDHeader
+		if err := binary.Read(conn, binary.LittleEndian, &h); err != nil {
+			return
+		}
+		if _, err := io.CopyN(io.Discard, conn, int64(h.BodyLen)); err != nil {
+			return
+		}
+
+		var resp proto.Message
+		switch h.ProtoID {
+		case pb.ProtoId_InitConnect:
+			resp = &pb.InitConnectResponse_Internal{RetType: succeed, Payload: &pb.InitConnectResponse{
+				ServerVer:         proto.Int32(900),
+				LoginUserID:       proto.Uint64(1),
+				ConnID:            proto.Uint64(1),
+				ConnAESKey:        proto.String(""),
+				KeepAliveInterval: proto.Int32(0),
+			}}
+		case pb.ProtoId_GetUserInfo:
+			resp = &pb.GetUserInfoResponse_Internal{RetType: succeed, Payload: &pb.GetUserInfoResponse{
+				HkQotRight: pb.QotRight_Level2.Enum(),
+			}}
+		case pb.ProtoId_TrdUnlockTrade:
+			resp = &pb.TrdUnlockTradeResponse_Internal{RetType: succeed}
+		default:
+			continue
+		}
+		if err := f.write(h.ProtoID, h.SerialNo, resp); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeOpenD) write(id pb.ProtoId, sn uint32, msg proto.Message) error {
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	h := openDHeader{
+		HeaderFlag: [2]byte{'F', 'T'},
+		ProtoID:    id,
+		SerialNo:   sn,
+		BodyLen:    uint32(len(body)),
+		BodySHA1:   sha1.Sum(body),
+	}
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, &h)
+	buf.Write(body)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	_, err = buf.WriteTo(f.conn)
+	return err
+}
+
+func (f *fakeOpenD) pushGtwEvent(should *require.Assertions, ev pb.GtwEventType) {
+	should.NoError(f.write(pb.ProtoId_Notify, 0, &pb.NotifyResponse_Internal{
+		RetType: pb.RetType_RetType_Succeed.Enum(),
+		Payload: &pb.NotifyResponse{
+			Type:  pb.NotifyType_GtwEvent.Enum(),
+			Event: &pb.GtwEventNotice{EventType: ev.Enum(), Desc: proto.String(ev.String())},
+		},
+	}))
+}
+
+func TestClientGtwEvent(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	for _, ev := range []pb.GtwEventType{pb.GtwEventType_KickedOut, pb.GtwEventType_BanLogin} {
+		f := newFakeOpenD(should)
+		client, err := futu.NewClient(futu.WithOpenDAddr(f.ln.Addr().String()), futu.WithTimeout(time.Second))
+		should.NoError(err)
+
+		// seeded at connect
+		should.Equal(pb.QotRight_Level2, client.QotRight().GetHkQotRight())
+		should.NoError(client.Err())
+
+		f.pushGtwEvent(should, ev)
+		should.Eventually(func() bool { return client.Err() != nil }, time.Second, 10*time.Millisecond)
+		should.ErrorIs(client.Err(), futu.ErrClientUnusable)
+
+		// requests fail early
+		_, err = client.GetUserInfo(ctx)
+		should.ErrorIs(err, futu.ErrClientUnusable)
+
+		client.Close()
+		f.Close()
+	}
+
+	f := newFakeOpenD(should)
+	defer f.Close()
+	client, err := futu.NewClient(futu.WithOpenDAddr(f.ln.Addr().String()), futu.WithTimeout(time.Second))
+	should.NoError(err)
+	defer client.Close()
+
+	should.True(client.TradeLocked())
+	should.NoError(client.UnlockTrade(ctx, true))
+	should.False(client.TradeLocked())
+
+	f.pushGtwEvent(should, pb.GtwEventType_TradePwdChanged)
+	should.Eventually(client.TradeLocked, time.Second, 10*time.Millisecond)
+	should.NoError(client.Err())
+}
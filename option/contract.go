@@ -0,0 +1,161 @@
+package option
+
+import (
+	"errors"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+)
+
+var (
+	ErrNotOption = errors.New("not an option")
+)
+
+// DefaultBinomialSteps is the steps of the binomial model of American options.
+const DefaultBinomialSteps = 200
+
+// Contract is an option contract from SecurityStaticInfo.
+type Contract struct {
+	Security   *pb.Security
+	Owner      *pb.Security
+	Type       pb.OptionType
+	Strike     float64
+	Expiry     time.Time
+	Area       pb.OptionAreaType
+	IndexType  pb.IndexOptionType
+	Multiplier float64 // units of underlying per contract
+}
+
+// HK index options, eg: HSI and HHI, or mini options of them
+var indexMultipliers = map[pb.IndexOptionType]float64{
+	pb.IndexOptionType_Normal: 50,
+	pb.IndexOptionType_Small:  10,
+}
+
+// NewContract creates a Contract of info with optionExData. qot is optional,
+// its contractMultiplier and optionAreaType are preferred if set.
+//
+// The multiplier is from contractMultiplier, contractSize of qot, or 50 and
+// 10 for normal and small HK index options, whose lotSize is not the
+// multiplier, or lotSize of info.
+// The area type defaults to European for index options, American otherwise.
+// Options expire at 16:00 of the strike date in the zone of the market.
+func NewContract(info *pb.SecurityStaticInfo, qot *pb.BasicQot) (*Contract, error) {
+	ex := info.GetOptionExData()
+	if ex == nil {
+		return nil, ErrNotOption
+	}
+
+	c := &Contract{
+		Security:  info.GetBasic().GetSecurity(),
+		Owner:     ex.GetOwner(),
+		Type:      ex.GetType(),
+		Strike:    ex.GetStrikePrice(),
+		IndexType: ex.GetIndexOptionType(),
+	}
+
+	qex := qot.GetOptionExData()
+	if qex.GetIndexOptionType() != pb.IndexOptionType_Unknown {
+		c.IndexType = qex.GetIndexOptionType()
+	}
+
+	for _, m := range []float64{
+		qex.GetContractMultiplier(),
+		qex.GetContractSizeFloat(),
+		float64(qex.GetContractSize()),
+		indexMultipliers[c.IndexType],
+		float64(info.GetBasic().GetLotSize()),
+	} {
+		if m > 0 {
+			c.Multiplier = m
+			break
+		}
+	}
+
+	c.Area = qex.GetOptionAreaType()
+	if c.Area == pb.OptionAreaType_Unknown {
+		c.Area = pb.OptionAreaType_American
+		if c.IndexType != pb.IndexOptionType_Unknown {
+			c.Area = pb.OptionAreaType_European
+		}
+	}
+
+	loc := futu.QotMarketLocation(c.Security.GetMarket())
+	expiry, err := futu.ParseTimeTimestamp(ex.GetStrikeTime(), ex.GetStrikeTimestamp(), loc)
+	if err != nil {
+		return nil, err
+	}
+	if h, m, s := expiry.Clock(); h == 0 && m == 0 && s == 0 {
+		expiry = expiry.Add(16 * time.Hour)
+	}
+	c.Expiry = expiry
+
+	return c, nil
+}
+
+// Years returns the time to expiry at now, in years of 365 days.
+func (c *Contract) Years(now time.Time) float64 {
+	return max(c.Expiry.Sub(now).Hours()/24/365, 0)
+}
+
+// Params returns the model params at now.
+func (c *Contract) Params(spot, vol, rate, dividend float64, now time.Time) Params {
+	return Params{
+		Type:     c.Type,
+		Spot:     spot,
+		Strike:   c.Strike,
+		Years:    c.Years(now),
+		Rate:     rate,
+		Dividend: dividend,
+		Vol:      vol,
+	}
+}
+
+// Model returns the binomial model of DefaultBinomialSteps for American
+// options, BlackScholes otherwise.
+func (c *Contract) Model() Model {
+	if c.Area == pb.OptionAreaType_American {
+		return Binomial(DefaultBinomialSteps, true)
+	}
+	return BlackScholes
+}
+
+// Valuation is the analysis of a contract at a price.
+type Valuation struct {
+	Vol    float64 // implied volatility by Implied
+	Price  float64 // per unit of underlying
+	Value  float64 // per contract
+	Greeks Greeks  // per unit of underlying
+	Total  Greeks  // per contract
+}
+
+// Value prices the contract with model, nil for c.Model().
+func (c *Contract) Value(model Model, p Params) Valuation {
+	if model == nil {
+		model = c.Model()
+	}
+	price, g := model.Price(p), model.Greeks(p)
+	return Valuation{
+		Vol:    p.Vol,
+		Price:  price,
+		Value:  price * c.Multiplier,
+		Greeks: g,
+		Total:  g.Scale(c.Multiplier),
+	}
+}
+
+// Implied solves the implied volatility of the option price, eg: curPrice
+// of a quote, with model, nil for c.Model(), and values the contract at it.
+// p.Vol is ignored.
+func (c *Contract) Implied(model Model, price float64, p Params) (Valuation, error) {
+	if model == nil {
+		model = c.Model()
+	}
+	vol, err := ImpliedVol(model, price, p)
+	if err != nil {
+		return Valuation{}, err
+	}
+	p.Vol = vol
+	return c.Value(model, p), nil
+}
@@ -0,0 +1,275 @@
+// Package option prices options and computes implied volatilities and
+// Greeks with Black-Scholes, Black-76 and binomial models, to recompute
+// the values of OptionBasicQotExData at own underlying prices and rates.
+//
+// Rates, dividend yields and volatilities are decimals, eg: 0.2 for 20%,
+// while OpenD reports impliedVolatility in percent.
+package option
+
+import (
+	"errors"
+	"math"
+
+	"github.com/santsai/futu-go/pb"
+)
+
+var (
+	ErrNoImpliedVol = errors.New("no implied volatility for price")
+)
+
+// Params are the inputs of a model.
+type Params struct {
+	Type     pb.OptionType
+	Spot     float64 // underlying price, futures price for Black76
+	Strike   float64
+	Years    float64 // time to expiry
+	Rate     float64 // risk free rate, continuous
+	Dividend float64 // dividend yield, continuous, ignored by Black76
+	Vol      float64
+}
+
+// Greeks are sensitivities of the price of one unit of underlying:
+// Vega and Rho per 1% change of volatility and rate, Theta per calendar day.
+type Greeks struct {
+	Delta float64
+	Gamma float64
+	Vega  float64
+	Theta float64
+	Rho   float64
+}
+
+// Scale returns g multiplied by n, eg: the contract multiplier.
+func (g Greeks) Scale(n float64) Greeks {
+	return Greeks{g.Delta * n, g.Gamma * n, g.Vega * n, g.Theta * n, g.Rho * n}
+}
+
+type Model interface {
+	Price(p Params) float64
+	Greeks(p Params) Greeks
+}
+
+var (
+	// BlackScholes is the Black-Scholes-Merton model of European options.
+	BlackScholes Model = blackScholes{}
+	// Black76 is the Black model of European options on futures.
+	Black76 Model = black76{}
+)
+
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
+
+func intrinsic(t pb.OptionType, s, k float64) float64 {
+	if t == pb.OptionType_Put {
+		return max(k-s, 0)
+	}
+	return max(s-k, 0)
+}
+
+type blackScholes struct{}
+
+// bs returns the price and Greeks of Black-Scholes-Merton, with Greeks
+// per unit changes and Theta per year.
+func bs(p Params) (float64, Greeks) {
+	call := p.Type != pb.OptionType_Put
+	dq, dr := math.Exp(-p.Dividend*p.Years), math.Exp(-p.Rate*p.Years)
+	fwd := p.Spot * dq / dr
+
+	if p.Years <= 0 || p.Vol <= 0 {
+		price := dr * intrinsic(p.Type, fwd, p.Strike)
+		g := Greeks{}
+		switch {
+		case call && fwd > p.Strike:
+			g.Delta = dq
+		case !call && fwd < p.Strike:
+			g.Delta = -dq
+		}
+		return price, g
+	}
+
+	sqrtT := math.Sqrt(p.Years)
+	d1 := (math.Log(p.Spot/p.Strike) + (p.Rate-p.Dividend+p.Vol*p.Vol/2)*p.Years) / (p.Vol * sqrtT)
+	d2 := d1 - p.Vol*sqrtT
+	nd1 := normPDF(d1)
+
+	g := Greeks{
+		Gamma: dq * nd1 / (p.Spot * p.Vol * sqrtT),
+		Vega:  p.Spot * dq * nd1 * sqrtT,
+		Theta: -p.Spot * dq * nd1 * p.Vol / (2 * sqrtT),
+	}
+
+	var price float64
+	if call {
+		price = p.Spot*dq*normCDF(d1) - p.Strike*dr*normCDF(d2)
+		g.Delta = dq * normCDF(d1)
+		g.Theta += -p.Rate*p.Strike*dr*normCDF(d2) + p.Dividend*p.Spot*dq*normCDF(d1)
+		g.Rho = p.Strike * p.Years * dr * normCDF(d2)
+	} else {
+		price = p.Strike*dr*normCDF(-d2) - p.Spot*dq*normCDF(-d1)
+		g.Delta = -dq * normCDF(-d1)
+		g.Theta += p.Rate*p.Strike*dr*normCDF(-d2) - p.Dividend*p.Spot*dq*normCDF(-d1)
+		g.Rho = -p.Strike * p.Years * dr * normCDF(-d2)
+	}
+	return price, g
+}
+
+// perDay converts Greeks per unit changes and Theta per year.
+func perDay(g Greeks) Greeks {
+	g.Vega /= 100
+	g.Rho /= 100
+	g.Theta /= 365
+	return g
+}
+
+func (blackScholes) Price(p Params) float64 {
+	price, _ := bs(p)
+	return price
+}
+
+func (blackScholes) Greeks(p Params) Greeks {
+	_, g := bs(p)
+	return perDay(g)
+}
+
+type black76 struct{}
+
+// Black76 is Black-Scholes-Merton with the dividend yield equal to the rate,
+// except Rho as the futures price doesn't change with the rate.
+func (black76) Price(p Params) float64 {
+	p.Dividend = p.Rate
+	price, _ := bs(p)
+	return price
+}
+
+func (black76) Greeks(p Params) Greeks {
+	p.Dividend = p.Rate
+	price, g := bs(p)
+	g.Rho = -p.Years * price
+	return perDay(g)
+}
+
+type binomial struct {
+	steps    int
+	american bool
+}
+
+// Binomial is the Cox-Ross-Rubinstein model with steps, of American options
+// if american. Use Dividend equal to Rate for options on futures.
+// Steps are at least 3, as Greeks take the first three steps of the tree.
+func Binomial(steps int, american bool) Model {
+	return binomial{steps: max(steps, 3), american: american}
+}
+
+// tree returns option values at the first three steps, values[i][j] is of
+// step i with j up moves.
+func (b binomial) tree(p Params) (values [3][]float64, dt, u float64) {
+	n := b.steps
+	dt = p.Years / float64(n)
+	u = math.Exp(p.Vol * math.Sqrt(dt))
+	d := 1 / u
+	q := (math.Exp((p.Rate-p.Dividend)*dt) - d) / (u - d)
+	disc := math.Exp(-p.Rate * dt)
+
+	v := make([]float64, n+1)
+	for j := 0; j <= n; j++ {
+		v[j] = intrinsic(p.Type, p.Spot*math.Pow(u, float64(2*j-n)), p.Strike)
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		for j := 0; j <= i; j++ {
+			v[j] = disc * (q*v[j+1] + (1-q)*v[j])
+			if b.american {
+				v[j] = max(v[j], intrinsic(p.Type, p.Spot*math.Pow(u, float64(2*j-i)), p.Strike))
+			}
+		}
+		if i <= 2 {
+			values[i] = append([]float64{}, v[:i+1]...)
+		}
+	}
+	return values, dt, u
+}
+
+func (b binomial) Price(p Params) float64 {
+	if p.Years <= 0 || p.Vol <= 0 {
+		return BlackScholes.Price(p)
+	}
+	values, _, _ := b.tree(p)
+	return values[0][0]
+}
+
+// Greeks takes Delta, Gamma and Theta from the tree, Vega and Rho by
+// repricing with volatility and rate changed by 1%.
+func (b binomial) Greeks(p Params) Greeks {
+	if p.Years <= 0 || p.Vol <= 0 {
+		return BlackScholes.Greeks(p)
+	}
+
+	values, dt, u := b.tree(p)
+	s := p.Spot
+	su, sd := s*u, s/u
+	suu, sdd := su*u, sd/u
+
+	deltaUp := (values[2][2] - values[2][1]) / (suu - s)
+	deltaDown := (values[2][1] - values[2][0]) / (s - sdd)
+
+	g := Greeks{
+		Delta: (values[1][1] - values[1][0]) / (su - sd),
+		Gamma: (deltaUp - deltaDown) / ((suu - sdd) / 2),
+		Theta: (values[2][1] - values[0][0]) / (2 * dt) / 365,
+	}
+
+	bump := func(fn func(p *Params, h float64)) float64 {
+		up, down := p, p
+		fn(&up, 0.01)
+		fn(&down, -0.01)
+		return (b.Price(up) - b.Price(down)) / 2
+	}
+	g.Vega = bump(func(p *Params, h float64) { p.Vol += h })
+	g.Rho = bump(func(p *Params, h float64) { p.Rate += h })
+	return g
+}
+
+// ImpliedVol solves the volatility of model at which p is priced at price,
+// by Newton's method falling back to bisection in (0.0001, 10].
+func ImpliedVol(model Model, price float64, p Params) (float64, error) {
+	lo, hi := 1e-4, 10.0
+
+	p.Vol = lo
+	if price < model.Price(p)-1e-12 {
+		return 0, ErrNoImpliedVol
+	}
+	p.Vol = hi
+	if price > model.Price(p)+1e-12 {
+		return 0, ErrNoImpliedVol
+	}
+
+	vol := 0.3
+	for range 100 {
+		p.Vol = vol
+		diff := model.Price(p) - price
+		if math.Abs(diff) < 1e-10 {
+			return vol, nil
+		}
+		if diff > 0 {
+			hi = vol
+		} else {
+			lo = vol
+		}
+
+		// vega per 1%
+		vega := model.Greeks(p).Vega * 100
+		next := vol - diff/vega
+		if vega <= 1e-12 || next <= lo || next >= hi {
+			next = (lo + hi) / 2
+		}
+		if math.Abs(next-vol) < 1e-12 {
+			return next, nil
+		}
+		vol = next
+	}
+	return vol, nil
+}
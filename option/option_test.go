@@ -0,0 +1,171 @@
+package option_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/option"
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func params(t pb.OptionType) option.Params {
+	return option.Params{Type: t, Spot: 100, Strike: 100, Years: 1, Rate: 0.05, Vol: 0.2}
+}
+
+func TestBlackScholes(t *testing.T) {
+	should := require.New(t)
+
+	call, put := params(pb.OptionType_Call), params(pb.OptionType_Put)
+	should.InDelta(10.4506, option.BlackScholes.Price(call), 1e-4)
+	should.InDelta(5.5735, option.BlackScholes.Price(put), 1e-4)
+
+	g := option.BlackScholes.Greeks(call)
+	should.InDelta(0.6368, g.Delta, 1e-4)
+	should.InDelta(0.01876, g.Gamma, 1e-5)
+	should.InDelta(0.37524, g.Vega, 1e-5)
+	should.InDelta(-6.4140/365, g.Theta, 1e-6)
+	should.InDelta(0.53232, g.Rho, 1e-5)
+
+	// greeks match finite differences
+	h := 1e-4
+	bumped := call
+	bumped.Spot += h
+	should.InDelta(g.Delta, (option.BlackScholes.Price(bumped)-option.BlackScholes.Price(call))/h, 1e-3)
+
+	g = option.BlackScholes.Greeks(put)
+	should.InDelta(-0.3632, g.Delta, 1e-4)
+
+	// put-call parity with dividends
+	call.Dividend, put.Dividend = 0.03, 0.03
+	parity := option.BlackScholes.Price(call) - option.BlackScholes.Price(put)
+	should.InDelta(100*math.Exp(-0.03)-100*math.Exp(-0.05), parity, 1e-9)
+
+	// expired
+	call.Years, call.Spot = 0, 110
+	should.Equal(10.0, option.BlackScholes.Price(call))
+	should.Equal(1.0, option.BlackScholes.Greeks(call).Delta)
+}
+
+func TestBlack76(t *testing.T) {
+	should := require.New(t)
+
+	p := params(pb.OptionType_Call)
+	// e^-0.05 * (100*N(0.1) - 100*N(-0.1))
+	should.InDelta(7.5771, option.Black76.Price(p), 1e-4)
+
+	g := option.Black76.Greeks(p)
+	should.InDelta(-option.Black76.Price(p)/100, g.Rho, 1e-9)
+}
+
+func TestBinomial(t *testing.T) {
+	should := require.New(t)
+
+	call, put := params(pb.OptionType_Call), params(pb.OptionType_Put)
+
+	european := option.Binomial(500, false)
+	should.InDelta(option.BlackScholes.Price(call), european.Price(call), 1e-2)
+	should.InDelta(option.BlackScholes.Price(put), european.Price(put), 1e-2)
+
+	bsg, g := option.BlackScholes.Greeks(put), european.Greeks(put)
+	should.InDelta(bsg.Delta, g.Delta, 1e-3)
+	should.InDelta(bsg.Gamma, g.Gamma, 1e-3)
+	should.InDelta(bsg.Vega, g.Vega, 1e-3)
+	should.InDelta(bsg.Theta, g.Theta, 1e-4)
+	should.InDelta(bsg.Rho, g.Rho, 1e-3)
+
+	american := option.Binomial(500, true)
+	should.InDelta(6.0896, american.Price(put), 5e-3)
+	// no early exercise of calls without dividends
+	should.InDelta(european.Price(call), american.Price(call), 1e-9)
+
+	// small steps are clamped to the three Greeks need
+	for _, steps := range []int{0, 1, 2} {
+		small := option.Binomial(steps, true)
+		should.NotPanics(func() { small.Greeks(put) })
+		should.Equal(option.Binomial(3, true).Price(put), small.Price(put))
+		should.Equal(option.Binomial(3, true).Greeks(put), small.Greeks(put))
+	}
+}
+
+func TestImpliedVol(t *testing.T) {
+	should := require.New(t)
+
+	for _, model := range []option.Model{option.BlackScholes, option.Black76, option.Binomial(200, true)} {
+		for _, vol := range []float64{0.05, 0.2, 0.8} {
+			p := params(pb.OptionType_Put)
+			p.Strike, p.Vol = 90, vol
+			price := model.Price(p)
+
+			iv, err := option.ImpliedVol(model, price, p)
+			should.NoError(err)
+			should.InDelta(vol, iv, 1e-4)
+		}
+	}
+
+	// below intrinsic
+	p := params(pb.OptionType_Call)
+	p.Strike = 50
+	_, err := option.ImpliedVol(option.BlackScholes, 40, p)
+	should.ErrorIs(err, option.ErrNoImpliedVol)
+}
+
+func TestContract(t *testing.T) {
+	should := require.New(t)
+
+	info := &pb.SecurityStaticInfo{
+		Basic: &pb.SecurityStaticBasic{
+			Security: futu.NewSecurity("HK.HSI240328C17000"),
+			LotSize:  proto.Int32(0),
+		},
+		OptionExData: &pb.OptionStaticExData{
+			Type:            pb.OptionType_Call.Enum(),
+			Owner:           futu.NewSecurity("HK.800000"),
+			StrikeTime:      proto.String("2024-03-28"),
+			StrikePrice:     proto.Float64(17000),
+			IndexOptionType: pb.IndexOptionType_Small.Enum(),
+		},
+	}
+
+	c, err := option.NewContract(info, nil)
+	should.NoError(err)
+	should.Equal(10.0, c.Multiplier)
+	should.Equal(pb.OptionAreaType_European, c.Area)
+	should.Equal(time.Date(2024, 3, 28, 16, 0, 0, 0, futu.LocHongKong), c.Expiry)
+
+	now := time.Date(2023, 3, 29, 16, 0, 0, 0, futu.LocHongKong)
+	should.InDelta(1, c.Years(now), 1e-2)
+	should.Equal(0.0, c.Years(c.Expiry.Add(time.Hour)))
+
+	p := c.Params(17000, 0.2, 0.03, 0, now)
+	v := c.Value(nil, p)
+	should.InDelta(v.Price*10, v.Value, 1e-9)
+	should.InDelta(v.Greeks.Delta*10, v.Total.Delta, 1e-9)
+
+	iv, err := c.Implied(nil, v.Price, p)
+	should.NoError(err)
+	should.InDelta(0.2, iv.Vol, 1e-6)
+
+	// quote fields are preferred
+	qot := &pb.BasicQot{OptionExData: &pb.OptionBasicQotExData{
+		ContractMultiplier: proto.Float64(50),
+		OptionAreaType:     pb.OptionAreaType_American.Enum(),
+	}}
+	c, err = option.NewContract(info, qot)
+	should.NoError(err)
+	should.Equal(50.0, c.Multiplier)
+	should.Equal(pb.OptionAreaType_American, c.Area)
+
+	// index multiplier is preferred to lotSize
+	info.Basic.LotSize = proto.Int32(1)
+	info.OptionExData.IndexOptionType = pb.IndexOptionType_Normal.Enum()
+	c, err = option.NewContract(info, nil)
+	should.NoError(err)
+	should.Equal(50.0, c.Multiplier)
+
+	_, err = option.NewContract(&pb.SecurityStaticInfo{}, nil)
+	should.ErrorIs(err, option.ErrNotOption)
+}
@@ -0,0 +1,349 @@
+package futu
+
+import (
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/santsai/futu-go/pb"
+)
+
+// OptionKey indexes options of a chain, Expiry is the strike date, eg: 2024-03-28.
+type OptionKey struct {
+	Expiry string
+	Strike float64
+	Type   pb.OptionType
+}
+
+// ChainOption is an option of an OptionChain, Snapshot is set by Enrich.
+type ChainOption struct {
+	OptionKey
+	Info     *pb.SecurityStaticInfo
+	Snapshot *pb.Snapshot
+}
+
+// OptionChain is the options of an underlying across expiries,
+// indexed by expiry, strike and type.
+type OptionChain struct {
+	Owner *pb.Security
+
+	dates   map[string]*pb.OptionExpirationDate
+	options map[OptionKey]*ChainOption
+	strikes map[string][]float64 // sorted, by expiry
+}
+
+type optionChainOptions struct {
+	indexType  pb.IndexOptionType
+	optionType pb.OptionType
+	condition  pb.OptionCondType
+	filter     *pb.DataFilter
+	begin, end string
+	snapshots  bool
+}
+
+type OptionChainOption func(o *optionChainOptions)
+
+// WithChainIndexOptionType sets the index option type, for HSI and HHI only.
+func WithChainIndexOptionType(t pb.IndexOptionType) OptionChainOption {
+	return func(o *optionChainOptions) {
+		o.indexType = t
+	}
+}
+
+// WithChainOptionType loads calls or puts only.
+func WithChainOptionType(t pb.OptionType) OptionChainOption {
+	return func(o *optionChainOptions) {
+		o.optionType = t
+	}
+}
+
+// WithChainCondition loads options in or out of the money only.
+func WithChainCondition(c pb.OptionCondType) OptionChainOption {
+	return func(o *optionChainOptions) {
+		o.condition = c
+	}
+}
+
+// WithChainDataFilter sets the DataFilter of QotGetOptionChain, eg: greeks and open interest.
+func WithChainDataFilter(f *pb.DataFilter) OptionChainOption {
+	return func(o *optionChainOptions) {
+		o.filter = f
+	}
+}
+
+// WithChainExpiries loads expiries within dates [begin, end], empty for no limit.
+func WithChainExpiries(begin, end string) OptionChainOption {
+	return func(o *optionChainOptions) {
+		o.begin, o.end = begin, end
+	}
+}
+
+// WithChainSnapshots enriches the chain with snapshots after loading.
+func WithChainSnapshots() OptionChainOption {
+	return func(o *optionChainOptions) {
+		o.snapshots = true
+	}
+}
+
+// maxOptionChainDays is the longest expiry range of QotGetOptionChain.
+const maxOptionChainDays = 30
+
+// LoadOptionChain loads all expiries of owner from QotGetOptionExpirationDate,
+// then requests QotGetOptionChain by windows of expiries within a month,
+// waiting for the rate limit between requests.
+func LoadOptionChain(ctx context.Context, rh pb.RequestHandler, owner *pb.Security, opts ...OptionChainOption) (*OptionChain, error) {
+	o := optionChainOptions{}
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	dateReq := &pb.QotGetOptionExpirationDateRequest{Owner: owner}
+	if o.indexType != pb.IndexOptionType_Unknown {
+		dateReq.IndexOptionType = o.indexType.Enum()
+	}
+	dateResp, err := dateReq.Dispatch(ctx, rh)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := &OptionChain{
+		Owner:   owner,
+		dates:   map[string]*pb.OptionExpirationDate{},
+		options: map[OptionKey]*ChainOption{},
+		strikes: map[string][]float64{},
+	}
+
+	expiries := []string{}
+	for _, d := range dateResp.GetDateList() {
+		expiry := datePart(d.GetStrikeTime())
+		if (o.begin != "" && expiry < o.begin) || (o.end != "" && expiry > o.end) {
+			continue
+		}
+		if _, ok := chain.dates[expiry]; !ok {
+			expiries = append(expiries, expiry)
+		}
+		chain.dates[expiry] = d
+	}
+	slices.Sort(expiries)
+
+	limiter := newPageLimiter(pb.ProtoId_QotGetOptionChain)
+	for _, w := range expiryWindows(expiries) {
+		if err := limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		req := &pb.QotGetOptionChainRequest{
+			Owner:      owner,
+			BeginTime:  &w[0],
+			EndTime:    &w[1],
+			DataFilter: o.filter,
+		}
+		if o.indexType != pb.IndexOptionType_Unknown {
+			req.IndexOptionType = o.indexType.Enum()
+		}
+		if o.optionType != pb.OptionType_Unknown {
+			req.Type = o.optionType.Enum()
+		}
+		if o.condition != pb.OptionCondType_Unknown {
+			req.Condition = o.condition.Enum()
+		}
+
+		resp, err := req.Dispatch(ctx, rh)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range resp.GetOptionChain() {
+			for _, item := range c.GetOption() {
+				chain.add(datePart(c.GetStrikeTime()), item.GetCall())
+				chain.add(datePart(c.GetStrikeTime()), item.GetPut())
+			}
+		}
+	}
+
+	for _, strikes := range chain.strikes {
+		slices.Sort(strikes)
+	}
+
+	if o.snapshots {
+		if err := chain.Enrich(ctx, rh); err != nil {
+			return nil, err
+		}
+	}
+	return chain, nil
+}
+
+// expiryWindows groups sorted expiries into [begin, end] date ranges of at
+// most maxOptionChainDays, from the first to the last expiry of each.
+func expiryWindows(expiries []string) [][2]string {
+	windows := [][2]string{}
+	for i := 0; i < len(expiries); {
+		begin, err := time.Parse(time.DateOnly, expiries[i])
+		if err != nil {
+			i++
+			continue
+		}
+		limit := begin.AddDate(0, 0, maxOptionChainDays).Format(time.DateOnly)
+
+		j := i + 1
+		for j < len(expiries) && expiries[j] <= limit {
+			j++
+		}
+		windows = append(windows, [2]string{expiries[i], expiries[j-1]})
+		i = j
+	}
+	return windows
+}
+
+func (c *OptionChain) add(expiry string, info *pb.SecurityStaticInfo) {
+	ex := info.GetOptionExData()
+	if ex == nil {
+		return
+	}
+
+	key := OptionKey{Expiry: expiry, Strike: ex.GetStrikePrice(), Type: ex.GetType()}
+	if !slices.Contains(c.strikes[expiry], key.Strike) {
+		c.strikes[expiry] = append(c.strikes[expiry], key.Strike)
+	}
+	c.options[key] = &ChainOption{OptionKey: key, Info: info}
+}
+
+// Enrich sets snapshots of all options by QotGetSecuritySnapshot,
+// waiting for the rate limit between requests.
+func (c *OptionChain) Enrich(ctx context.Context, rh pb.RequestHandler) error {
+	byCode := map[string]*ChainOption{}
+	securities := []*pb.Security{}
+	for _, opt := range c.Options() {
+		sec := opt.Info.GetBasic().GetSecurity()
+		byCode[NewSecurityCode(sec)] = opt
+		securities = append(securities, sec)
+	}
+
+	size := int(pageSize(pb.ProtoId_QotGetSecuritySnapshot, 0))
+	limiter := newPageLimiter(pb.ProtoId_QotGetSecuritySnapshot)
+
+	for begin := 0; begin < len(securities); begin += size {
+		if err := limiter.wait(ctx); err != nil {
+			return err
+		}
+
+		req := &pb.QotGetSecuritySnapshotRequest{
+			SecurityList: securities[begin:min(begin+size, len(securities))],
+		}
+		resp, err := req.Dispatch(ctx, rh)
+		if err != nil {
+			return err
+		}
+		for _, s := range resp.GetSnapshotList() {
+			if opt, ok := byCode[NewSecurityCode(s.GetBasic().GetSecurity())]; ok {
+				opt.Snapshot = s
+			}
+		}
+	}
+	return nil
+}
+
+// Len returns the number of options.
+func (c *OptionChain) Len() int {
+	return len(c.options)
+}
+
+// Expiries returns expiries with options, sorted.
+func (c *OptionChain) Expiries() []string {
+	expiries := []string{}
+	for expiry := range c.strikes {
+		expiries = append(expiries, expiry)
+	}
+	slices.Sort(expiries)
+	return expiries
+}
+
+// ExpirationDate returns the QotGetOptionExpirationDate entry of expiry, eg: for cycle.
+func (c *OptionChain) ExpirationDate(expiry string) *pb.OptionExpirationDate {
+	return c.dates[expiry]
+}
+
+// Strikes returns strikes of expiry, sorted.
+func (c *OptionChain) Strikes(expiry string) []float64 {
+	return c.strikes[expiry]
+}
+
+// NearestStrike returns the strike of expiry nearest to price, eg: at the money.
+func (c *OptionChain) NearestStrike(expiry string, price float64) (float64, bool) {
+	strikes := c.strikes[expiry]
+	if len(strikes) == 0 {
+		return 0, false
+	}
+
+	nearest := strikes[0]
+	for _, s := range strikes[1:] {
+		if math.Abs(s-price) < math.Abs(nearest-price) {
+			nearest = s
+		}
+	}
+	return nearest, true
+}
+
+// Get returns the option of expiry, strike and type, nil if not found.
+func (c *OptionChain) Get(expiry string, strike float64, t pb.OptionType) *ChainOption {
+	return c.options[OptionKey{Expiry: expiry, Strike: strike, Type: t}]
+}
+
+// Options returns all options sorted by expiry, strike and type.
+func (c *OptionChain) Options() []*ChainOption {
+	options := []*ChainOption{}
+	for _, expiry := range c.Expiries() {
+		for _, strike := range c.strikes[expiry] {
+			for _, t := range []pb.OptionType{pb.OptionType_Call, pb.OptionType_Put} {
+				if opt := c.Get(expiry, strike, t); opt != nil {
+					options = append(options, opt)
+				}
+			}
+		}
+	}
+	return options
+}
+
+// Filter returns a chain of options whose snapshots match f, as DataFilter
+// of QotGetOptionChain, eg: to narrow a loaded chain without requests.
+// Options without snapshots are dropped, see Enrich. A nil f matches all.
+func (c *OptionChain) Filter(f *pb.DataFilter) *OptionChain {
+	filtered := &OptionChain{
+		Owner:   c.Owner,
+		dates:   c.dates,
+		options: map[OptionKey]*ChainOption{},
+		strikes: map[string][]float64{},
+	}
+
+	for _, opt := range c.Options() {
+		if opt.Snapshot != nil && matchDataFilter(f, opt.Snapshot) {
+			filtered.options[opt.OptionKey] = opt
+			strikes := filtered.strikes[opt.Expiry]
+			if len(strikes) == 0 || strikes[len(strikes)-1] != opt.Strike {
+				filtered.strikes[opt.Expiry] = append(strikes, opt.Strike)
+			}
+		}
+	}
+	return filtered
+}
+
+func matchDataFilter(f *pb.DataFilter, s *pb.Snapshot) bool {
+	if f == nil {
+		return true
+	}
+
+	ex := s.GetOptionExData()
+	in := func(v float64, fmin, fmax *float64) bool {
+		return (fmin == nil || v >= *fmin) && (fmax == nil || v <= *fmax)
+	}
+
+	return in(ex.GetImpliedVolatility(), f.ImpliedVolatilityMin, f.ImpliedVolatilityMax) &&
+		in(ex.GetDelta(), f.DeltaMin, f.DeltaMax) &&
+		in(ex.GetGamma(), f.GammaMin, f.GammaMax) &&
+		in(ex.GetVega(), f.VegaMin, f.VegaMax) &&
+		in(ex.GetTheta(), f.ThetaMin, f.ThetaMax) &&
+		in(ex.GetRho(), f.RhoMin, f.RhoMax) &&
+		in(float64(ex.GetNetOpenInterest()), f.NetOpenInterestMin, f.NetOpenInterestMax) &&
+		in(float64(ex.GetOpenInterest()), f.OpenInterestMin, f.OpenInterestMax) &&
+		in(float64(s.GetBasic().GetVolume()), f.VolMin, f.VolMax)
+}
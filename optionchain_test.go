@@ -0,0 +1,122 @@
+package futu_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func optionInfo(expiry string, strike float64, t pb.OptionType) *pb.SecurityStaticInfo {
+	side := "C"
+	if t == pb.OptionType_Put {
+		side = "P"
+	}
+	return &pb.SecurityStaticInfo{
+		Basic: &pb.SecurityStaticBasic{
+			Security: futu.NewSecurity(fmt.Sprintf("US.AAPL%s%s%.0f", expiry, side, strike)),
+		},
+		OptionExData: &pb.OptionStaticExData{
+			Type:        t.Enum(),
+			Owner:       futu.NewSecurity("US.AAPL"),
+			StrikeTime:  proto.String(expiry),
+			StrikePrice: proto.Float64(strike),
+		},
+	}
+}
+
+func TestLoadOptionChain(t *testing.T) {
+	should := require.New(t)
+
+	expiries := []string{"2024-03-15", "2024-03-22", "2024-04-19", "2024-06-21"}
+
+	m := pbtest.NewMock()
+	m.OnQotGetOptionExpirationDate(func(req *pb.QotGetOptionExpirationDateRequest) (*pb.QotGetOptionExpirationDateResponse, error) {
+		resp := &pb.QotGetOptionExpirationDateResponse{}
+		for _, e := range expiries {
+			resp.DateList = append(resp.DateList, &pb.OptionExpirationDate{StrikeTime: proto.String(e)})
+		}
+		return resp, nil
+	})
+	m.OnQotGetOptionChain(func(req *pb.QotGetOptionChainRequest) (*pb.QotGetOptionChainResponse, error) {
+		resp := &pb.QotGetOptionChainResponse{}
+		for _, e := range expiries {
+			if e < req.GetBeginTime() || e > req.GetEndTime() {
+				continue
+			}
+			c := &pb.OptionChain{StrikeTime: proto.String(e)}
+			for _, strike := range []float64{180, 170} {
+				c.Option = append(c.Option, &pb.OptionItem{
+					Call: optionInfo(e, strike, pb.OptionType_Call),
+					Put:  optionInfo(e, strike, pb.OptionType_Put),
+				})
+			}
+			resp.OptionChain = append(resp.OptionChain, c)
+		}
+		return resp, nil
+	})
+	m.OnQotGetSecuritySnapshot(func(req *pb.QotGetSecuritySnapshotRequest) (*pb.QotGetSecuritySnapshotResponse, error) {
+		resp := &pb.QotGetSecuritySnapshotResponse{}
+		for i, sec := range req.GetSecurityList() {
+			resp.SnapshotList = append(resp.SnapshotList, &pb.Snapshot{
+				Basic: &pb.SnapshotBasicData{Security: sec, Volume: proto.Int64(int64(i))},
+				OptionExData: &pb.OptionSnapshotExData{
+					Delta:        proto.Float64(float64(i) / 10),
+					OpenInterest: proto.Int32(100),
+				},
+			})
+		}
+		return resp, nil
+	})
+
+	ctx := context.Background()
+	filter := &pb.DataFilter{OpenInterestMin: proto.Float64(10)}
+	chain, err := futu.LoadOptionChain(ctx, m, futu.NewSecurity("US.AAPL"),
+		futu.WithChainExpiries("2024-03-01", "2024-04-30"),
+		futu.WithChainDataFilter(filter),
+		futu.WithChainSnapshots())
+	should.NoError(err)
+
+	// windows within a month
+	calls := m.QotGetOptionChainCalls()
+	should.Len(calls, 2)
+	should.Equal("2024-03-15", calls[0].GetBeginTime())
+	should.Equal("2024-03-22", calls[0].GetEndTime())
+	should.Equal("2024-04-19", calls[1].GetBeginTime())
+	should.Equal("2024-04-19", calls[1].GetEndTime())
+	should.Equal(10.0, calls[1].GetDataFilter().GetOpenInterestMin())
+
+	should.Equal(12, chain.Len())
+	should.Equal([]string{"2024-03-15", "2024-03-22", "2024-04-19"}, chain.Expiries())
+	should.Equal([]float64{170, 180}, chain.Strikes("2024-03-22"))
+	should.Equal("2024-04-19", chain.ExpirationDate("2024-04-19").GetStrikeTime())
+
+	put := chain.Get("2024-03-22", 170, pb.OptionType_Put)
+	should.NotNil(put)
+	should.Equal("AAPL2024-03-22P170", put.Info.GetBasic().GetSecurity().GetCode())
+	should.NotNil(put.Snapshot)
+	should.Nil(chain.Get("2024-03-22", 175, pb.OptionType_Put))
+
+	strike, ok := chain.NearestStrike("2024-03-15", 177)
+	should.True(ok)
+	should.Equal(180.0, strike)
+
+	options := chain.Options()
+	should.Len(options, 12)
+	should.Equal(futu.OptionKey{Expiry: "2024-03-15", Strike: 170, Type: pb.OptionType_Call}, options[0].OptionKey)
+	should.Equal(pb.OptionType_Put, options[1].Type)
+	should.Len(m.QotGetSecuritySnapshotCalls(), 1)
+
+	// local filter by snapshots
+	filtered := chain.Filter(&pb.DataFilter{DeltaMin: proto.Float64(0.35), DeltaMax: proto.Float64(0.8)})
+	should.Equal(5, filtered.Len())
+	should.Equal([]string{"2024-03-22", "2024-04-19"}, filtered.Expiries())
+	should.Equal([]float64{170, 180}, filtered.Strikes("2024-03-22"))
+	should.Equal(0, chain.Filter(&pb.DataFilter{OpenInterestMax: proto.Float64(10)}).Len())
+	should.Equal(chain.Len(), chain.Filter(nil).Len())
+}
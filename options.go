@@ -2,6 +2,8 @@ package futu
 
 import (
 	"time"
+
+	"github.com/santsai/futu-go/pb"
 )
 
 // Options are futu client options.
@@ -13,6 +15,9 @@ type clientOptions struct {
 	numWorkers int
 	numBuffers int
 	timeout    time.Duration
+
+	readyPollInterval time.Duration
+	trdHeader         *pb.TrdHeader
 }
 
 type ClientOption func(o *clientOptions)
@@ -26,6 +31,8 @@ func newClientOptions(opts []ClientOption) clientOptions {
 		numBuffers: 100,
 		numWorkers: 2,
 		timeout:    5 * time.Second,
+
+		readyPollInterval: time.Second,
 	}
 
 	for _, o := range opts {
@@ -81,3 +88,17 @@ func WithNumWorkers(n int) ClientOption {
 		o.numWorkers = n
 	}
 }
+
+// WithReadyPollInterval sets how often WaitReady polls OpenD's global state.
+func WithReadyPollInterval(d time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.readyPollInterval = d
+	}
+}
+
+// WithTrdHeader sets the default trade header, see Client.SetTrdHeader.
+func WithTrdHeader(h *pb.TrdHeader) ClientOption {
+	return func(o *clientOptions) {
+		o.trdHeader = h
+	}
+}
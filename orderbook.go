@@ -0,0 +1,331 @@
+package futu
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultOrderBookStaleAfter is how long a book without pushes is considered stale.
+const DefaultOrderBookStaleAfter = 30 * time.Second
+
+// OrderBookLevel is one price level of an OrderBook.
+type OrderBookLevel struct {
+	Price      float64
+	Volume     int64
+	OrderCount int32
+	CumVolume  int64                 // volume of this and better levels
+	Details    []*pb.OrderBookDetail // order queue, SF quote only
+}
+
+type orderBookOptions struct {
+	staleAfter time.Duration
+	now        func() time.Time
+}
+
+type OrderBookOption func(o *orderBookOptions)
+
+// WithStaleAfter sets how long a book without pushes is considered stale.
+func WithStaleAfter(d time.Duration) OrderBookOption {
+	return func(o *orderBookOptions) {
+		o.staleAfter = d
+	}
+}
+
+// WithOrderBookClock sets the clock used for staleness, eg: for tests.
+func WithOrderBookClock(now func() time.Time) OrderBookOption {
+	return func(o *orderBookOptions) {
+		o.now = now
+	}
+}
+
+func newOrderBookOptions(opts []OrderBookOption) orderBookOptions {
+	o := orderBookOptions{
+		staleAfter: DefaultOrderBookStaleAfter,
+		now:        time.Now,
+	}
+	for _, fn := range opts {
+		fn(&o)
+	}
+	return o
+}
+
+// OrderBook is a local order book of one security, seeded by Seed
+// and kept up to date by Apply with QotUpdateOrderBook pushes.
+// OpenD pushes all levels of both sides, so each push replaces the book.
+type OrderBook struct {
+	orderBookOptions
+	security *pb.Security
+
+	mutex     sync.RWMutex
+	name      string
+	asks      []OrderBookLevel
+	bids      []OrderBookLevel
+	version   uint64
+	updatedAt time.Time
+}
+
+// NewOrderBook creates an empty OrderBook of security.
+func NewOrderBook(security *pb.Security, opts ...OrderBookOption) *OrderBook {
+	return &OrderBook{
+		orderBookOptions: newOrderBookOptions(opts),
+		security:         proto.Clone(security).(*pb.Security),
+	}
+}
+
+// Security returns the security of the book.
+func (b *OrderBook) Security() *pb.Security {
+	return b.security
+}
+
+// Seed fetches num levels with QotGetOrderBook. It does nothing if a push
+// is applied while requesting, the push being newer.
+// SubType_OrderBook must be subscribed.
+func (b *OrderBook) Seed(ctx context.Context, rh pb.RequestHandler, num int32) error {
+
+	b.mutex.RLock()
+	version := b.version
+	b.mutex.RUnlock()
+
+	req := &pb.QotGetOrderBookRequest{
+		Security: b.security,
+		Num:      proto.Int32(num),
+	}
+	resp, err := req.Dispatch(ctx, rh)
+	if err != nil {
+		return err
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.version == version {
+		b.set(resp.GetName(), resp.GetOrderBookAskList(), resp.GetOrderBookBidList())
+	}
+	return nil
+}
+
+// Apply replaces the book with a push. It returns false if the push is
+// of another security.
+func (b *OrderBook) Apply(msg *pb.QotUpdateOrderBookResponse) bool {
+
+	if !sameSecurity(b.security, msg.GetSecurity()) {
+		return false
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.set(msg.GetName(), msg.GetOrderBookAskList(), msg.GetOrderBookBidList())
+	return true
+}
+
+func (b *OrderBook) set(name string, asks, bids []*pb.OrderBook) {
+	if name != "" {
+		b.name = name
+	}
+	b.asks = orderBookLevels(asks)
+	b.bids = orderBookLevels(bids)
+	b.version++
+	b.updatedAt = b.now()
+}
+
+func orderBookLevels(list []*pb.OrderBook) []OrderBookLevel {
+	levels := make([]OrderBookLevel, 0, len(list))
+	cum := int64(0)
+	for _, v := range list {
+		cum += v.GetVolume()
+		levels = append(levels, OrderBookLevel{
+			Price:      v.GetPrice(),
+			Volume:     v.GetVolume(),
+			OrderCount: v.GetOrederCount(),
+			CumVolume:  cum,
+			Details:    v.GetDetailList(),
+		})
+	}
+	return levels
+}
+
+func sameSecurity(a, b *pb.Security) bool {
+	return a.GetMarket() == b.GetMarket() && a.GetCode() == b.GetCode()
+}
+
+// Name returns the security name, if OpenD sent it.
+func (b *OrderBook) Name() string {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.name
+}
+
+// Asks returns ask levels, best first. The slice must not be modified.
+func (b *OrderBook) Asks() []OrderBookLevel {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.asks
+}
+
+// Bids returns bid levels, best first. The slice must not be modified.
+func (b *OrderBook) Bids() []OrderBookLevel {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.bids
+}
+
+// Depth returns the number of bid and ask levels.
+func (b *OrderBook) Depth() (bids, asks int) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return len(b.bids), len(b.asks)
+}
+
+// BestBid returns the best bid level, false if there is no bid.
+func (b *OrderBook) BestBid() (OrderBookLevel, bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if len(b.bids) == 0 {
+		return OrderBookLevel{}, false
+	}
+	return b.bids[0], true
+}
+
+// BestAsk returns the best ask level, false if there is no ask.
+func (b *OrderBook) BestAsk() (OrderBookLevel, bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if len(b.asks) == 0 {
+		return OrderBookLevel{}, false
+	}
+	return b.asks[0], true
+}
+
+// Mid returns the mid price of best bid and ask, false if either is missing.
+func (b *OrderBook) Mid() (float64, bool) {
+	bid, ask, ok := b.top()
+	if !ok {
+		return 0, false
+	}
+	return (bid + ask) / 2, true
+}
+
+// Spread returns best ask minus best bid, false if either is missing.
+func (b *OrderBook) Spread() (float64, bool) {
+	bid, ask, ok := b.top()
+	if !ok {
+		return 0, false
+	}
+	return ask - bid, true
+}
+
+func (b *OrderBook) top() (bid, ask float64, ok bool) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if len(b.bids) == 0 || len(b.asks) == 0 {
+		return 0, 0, false
+	}
+	return b.bids[0].Price, b.asks[0].Price, true
+}
+
+// UpdatedAt returns when the book was last seeded or updated by a push.
+func (b *OrderBook) UpdatedAt() time.Time {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.updatedAt
+}
+
+// Stale reports whether the book was never updated, or not updated
+// within the stale duration, eg: pushes stopped after reconnecting.
+func (b *OrderBook) Stale() bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	if b.updatedAt.IsZero() {
+		return true
+	}
+	return b.staleAfter > 0 && b.now().Sub(b.updatedAt) > b.staleAfter
+}
+
+// OrderBooks routes QotUpdateOrderBook pushes to OrderBook by security.
+//
+//	books := futu.NewOrderBooks()
+//	client.RegisterHandler(pb.ProtoId_QotUpdateOrderBook, books.Handle)
+type OrderBooks struct {
+	opts []OrderBookOption
+
+	mutex sync.RWMutex
+	books map[subKey]*OrderBook
+}
+
+// NewOrderBooks creates OrderBooks, opts are applied to each OrderBook.
+func NewOrderBooks(opts ...OrderBookOption) *OrderBooks {
+	return &OrderBooks{
+		opts:  opts,
+		books: map[subKey]*OrderBook{},
+	}
+}
+
+func orderBookKey(security *pb.Security) subKey {
+	return subKey{security.GetMarket(), security.GetCode(), pb.SubType_OrderBook}
+}
+
+// Add returns the OrderBook of security, created if not exists.
+func (s *OrderBooks) Add(security *pb.Security) *OrderBook {
+	key := orderBookKey(security)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	b, ok := s.books[key]
+	if !ok {
+		b = NewOrderBook(security, s.opts...)
+		s.books[key] = b
+	}
+	return b
+}
+
+// Get returns the OrderBook of security, nil if not added.
+func (s *OrderBooks) Get(security *pb.Security) *OrderBook {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.books[orderBookKey(security)]
+}
+
+// Remove removes the OrderBook of security.
+func (s *OrderBooks) Remove(security *pb.Security) {
+	s.mutex.Lock()
+	delete(s.books, orderBookKey(security))
+	s.mutex.Unlock()
+}
+
+// Stale returns books which are stale.
+func (s *OrderBooks) Stale() []*OrderBook {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	stale := []*OrderBook{}
+	for _, b := range s.books {
+		if b.Stale() {
+			stale = append(stale, b)
+		}
+	}
+	return stale
+}
+
+// Handle is a Handler of ProtoId_QotUpdateOrderBook.
+// Pushes of securities not added are ignored.
+func (s *OrderBooks) Handle(s2c proto.Message) error {
+	msg, ok := s2c.(*pb.QotUpdateOrderBookResponse)
+	if !ok {
+		return nil
+	}
+
+	if b := s.Get(msg.GetSecurity()); b != nil {
+		b.Apply(msg)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package futu_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func bookLevel(price float64, volume int64) *pb.OrderBook {
+	return &pb.OrderBook{
+		Price:       proto.Float64(price),
+		Volume:      proto.Int64(volume),
+		OrederCount: proto.Int32(1),
+	}
+}
+
+func TestOrderBook(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	now := time.Unix(1700000000, 0)
+	clock := func() time.Time { return now }
+
+	tencent := futu.NewSecurity("HK.00700")
+
+	m := pbtest.NewMock()
+	m.OnQotGetOrderBook(func(req *pb.QotGetOrderBookRequest) (*pb.QotGetOrderBookResponse, error) {
+		return &pb.QotGetOrderBookResponse{
+			Security:         req.GetSecurity(),
+			Name:             proto.String("TENCENT"),
+			OrderBookAskList: []*pb.OrderBook{bookLevel(300.2, 100), bookLevel(300.4, 200)},
+			OrderBookBidList: []*pb.OrderBook{bookLevel(300.0, 300), bookLevel(299.8, 400)},
+		}, nil
+	})
+
+	books := futu.NewOrderBooks(futu.WithStaleAfter(10*time.Second), futu.WithOrderBookClock(clock))
+	book := books.Add(tencent)
+	should.Same(book, books.Add(tencent))
+
+	should.True(book.Stale())
+	_, ok := book.Mid()
+	should.False(ok)
+
+	should.NoError(book.Seed(ctx, m, 10))
+	should.Equal(int32(10), m.QotGetOrderBookCalls()[0].GetNum())
+	should.Equal("TENCENT", book.Name())
+
+	bids, asks := book.Depth()
+	should.Equal(2, bids)
+	should.Equal(2, asks)
+
+	bid, ok := book.BestBid()
+	should.True(ok)
+	should.Equal(300.0, bid.Price)
+
+	ask, ok := book.BestAsk()
+	should.True(ok)
+	should.Equal(300.2, ask.Price)
+
+	mid, _ := book.Mid()
+	should.InDelta(300.1, mid, 1e-9)
+	spread, _ := book.Spread()
+	should.InDelta(0.2, spread, 1e-9)
+
+	should.Equal(int64(700), book.Bids()[1].CumVolume)
+	should.Equal(int64(300), book.Asks()[1].CumVolume)
+
+	// pushes replace the book, other securities are ignored
+	should.NoError(books.Handle(&pb.QotUpdateOrderBookResponse{
+		Security:         futu.NewSecurity("HK.09988"),
+		OrderBookBidList: []*pb.OrderBook{bookLevel(80, 1)},
+	}))
+	bid, _ = book.BestBid()
+	should.Equal(300.0, bid.Price)
+
+	sf := bookLevel(301.0, 500)
+	sf.DetailList = []*pb.OrderBookDetail{
+		{OrderID: proto.Int64(1), Volume: proto.Int64(200)},
+		{OrderID: proto.Int64(2), Volume: proto.Int64(300)},
+	}
+
+	now = now.Add(5 * time.Second)
+	should.NoError(books.Handle(&pb.QotUpdateOrderBookResponse{
+		Security:         tencent,
+		OrderBookAskList: []*pb.OrderBook{bookLevel(301.2, 100)},
+		OrderBookBidList: []*pb.OrderBook{sf},
+	}))
+
+	bid, _ = book.BestBid()
+	should.Equal(301.0, bid.Price)
+	should.Len(bid.Details, 2)
+	should.Equal("TENCENT", book.Name())
+	should.Equal(now, book.UpdatedAt())
+	should.False(book.Stale())
+	should.Empty(books.Stale())
+
+	// pushes stopped
+	now = now.Add(11 * time.Second)
+	should.True(book.Stale())
+	should.Equal([]*futu.OrderBook{book}, books.Stale())
+
+	books.Remove(tencent)
+	should.Nil(books.Get(tencent))
+}
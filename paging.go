@@ -0,0 +1,198 @@
+package futu
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// pageLimiter spaces page requests by the protocol's rate limit.
+type pageLimiter struct {
+	interval time.Duration
+	last     time.Time
+}
+
+func newPageLimiter(id pb.ProtoId) *pageLimiter {
+	l := &pageLimiter{}
+	if m, ok := pb.ProtoMeta(id); ok && m.RateLimit > 0 {
+		l.interval = m.RateWindow / time.Duration(m.RateLimit)
+	}
+	return l
+}
+
+func (l *pageLimiter) wait(ctx context.Context) error {
+	if d := l.interval - time.Since(l.last); !l.last.IsZero() && d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	} else if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	l.last = time.Now()
+	return nil
+}
+
+func pageSize(id pb.ProtoId, num int32) int32 {
+	if num > 0 {
+		return num
+	}
+	if m, ok := pb.ProtoMeta(id); ok && m.PageSize > 0 {
+		return int32(m.PageSize)
+	}
+	return 100
+}
+
+// offsetPages yields items of begin/num paged protocols,
+// until fetch returns the last page.
+func offsetPages[T any](ctx context.Context, id pb.ProtoId, begin, num int32,
+	fetch func(begin, num int32) ([]T, bool, error)) iter.Seq2[T, error] {
+
+	return func(yield func(T, error) bool) {
+		var zero T
+		limiter := newPageLimiter(id)
+		begin, num := begin, pageSize(id, num)
+
+		for {
+			if err := limiter.wait(ctx); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			list, lastPage, err := fetch(begin, num)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, v := range list {
+				if !yield(v, nil) {
+					return
+				}
+			}
+
+			if lastPage || len(list) == 0 {
+				return
+			}
+			begin += int32(len(list))
+		}
+	}
+}
+
+// HistoryKL yields klines of req, following nextReqKey until all pages are read.
+// req is not modified.
+//
+//	for kl, err := range futu.HistoryKL(ctx, client, req) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func HistoryKL(ctx context.Context, rh pb.RequestHandler, req *pb.QotRequestHistoryKLRequest) iter.Seq2[*pb.KLine, error] {
+	return func(yield func(*pb.KLine, error) bool) {
+		pages := historyKLPages(ctx, rh, req, newPageLimiter(pb.ProtoId_QotRequestHistoryKL))
+		for resp, err := range pages {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, kl := range resp.GetKlList() {
+				if !yield(kl, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// historyKLPages yields responses of req, following nextReqKey.
+func historyKLPages(ctx context.Context, rh pb.RequestHandler, req *pb.QotRequestHistoryKLRequest,
+	limiter *pageLimiter) iter.Seq2[*pb.QotRequestHistoryKLResponse, error] {
+
+	return func(yield func(*pb.QotRequestHistoryKLResponse, error) bool) {
+		req := proto.Clone(req).(*pb.QotRequestHistoryKLRequest)
+
+		for {
+			if err := limiter.wait(ctx); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			resp, err := req.Dispatch(ctx, rh)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(resp, nil) {
+				return
+			}
+
+			key := resp.GetNextReqKey()
+			if len(key) == 0 {
+				return
+			}
+			req.NextReqKey = key
+		}
+	}
+}
+
+// StockFilter yields all stocks matching req, starting from req.begin,
+// req.num is used as page size. req is not modified.
+func StockFilter(ctx context.Context, rh pb.RequestHandler, req *pb.QotStockFilterRequest) iter.Seq2[*pb.StockData, error] {
+	return offsetPages(ctx, pb.ProtoId_QotStockFilter, req.GetBegin(), req.GetNum(),
+		func(begin, num int32) ([]*pb.StockData, bool, error) {
+			page := proto.Clone(req).(*pb.QotStockFilterRequest).WithBegin(begin).WithNum(num)
+			resp, err := page.Dispatch(ctx, rh)
+			if err != nil {
+				return nil, false, err
+			}
+			return resp.GetDataList(), resp.GetLastPage(), nil
+		})
+}
+
+// Warrants yields all warrants matching req, starting from req.begin,
+// req.num is used as page size. req is not modified.
+func Warrants(ctx context.Context, rh pb.RequestHandler, req *pb.QotGetWarrantRequest) iter.Seq2[*pb.WarrantData, error] {
+	return offsetPages(ctx, pb.ProtoId_QotGetWarrant, req.GetBegin(), req.GetNum(),
+		func(begin, num int32) ([]*pb.WarrantData, bool, error) {
+			page := proto.Clone(req).(*pb.QotGetWarrantRequest).WithBegin(begin).WithNum(num)
+			resp, err := page.Dispatch(ctx, rh)
+			if err != nil {
+				return nil, false, err
+			}
+			return resp.GetWarrantDataList(), resp.GetLastPage(), nil
+		})
+}
+
+// PlateSecurities yields securities of a plate.
+// OpenD returns the full list in one response, it is iterated for symmetry.
+func PlateSecurities(ctx context.Context, rh pb.RequestHandler, req *pb.QotGetPlateSecurityRequest) iter.Seq2[*pb.SecurityStaticInfo, error] {
+	return func(yield func(*pb.SecurityStaticInfo, error) bool) {
+		if err := ctx.Err(); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		resp, err := req.Dispatch(ctx, rh)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		for _, v := range resp.GetStaticInfoList() {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package futu_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestHistoryKLPaging(t *testing.T) {
+	should := require.New(t)
+
+	m := pbtest.NewMock()
+	m.OnQotRequestHistoryKL(func(req *pb.QotRequestHistoryKLRequest) (*pb.QotRequestHistoryKLResponse, error) {
+		resp := &pb.QotRequestHistoryKLResponse{Security: req.GetSecurity()}
+		if req.NextReqKey == nil {
+			resp.KlList = []*pb.KLine{{Time: proto.String("2024-01-02")}, {Time: proto.String("2024-01-03")}}
+			resp.NextReqKey = []byte("page2")
+		} else {
+			resp.KlList = []*pb.KLine{{Time: proto.String("2024-01-04")}}
+		}
+		return resp, nil
+	})
+
+	req := &pb.QotRequestHistoryKLRequest{
+		Security:  futu.NewSecurity("HK.00700"),
+		KlType:    pb.KLType_Day.Enum(),
+		RehabType: pb.RehabType_Forward.Enum(),
+		BeginTime: proto.String("2024-01-01"),
+		EndTime:   proto.String("2024-01-31"),
+	}
+
+	times := []string{}
+	for kl, err := range futu.HistoryKL(context.Background(), m, req) {
+		should.NoError(err)
+		times = append(times, kl.GetTime())
+	}
+	should.Equal([]string{"2024-01-02", "2024-01-03", "2024-01-04"}, times)
+
+	calls := m.QotRequestHistoryKLCalls()
+	should.Len(calls, 2)
+	should.Equal([]byte("page2"), calls[1].GetNextReqKey())
+	should.Nil(req.NextReqKey)
+
+	// early break doesn't fetch next page
+	m.Reset()
+	for range futu.HistoryKL(context.Background(), m, req) {
+		break
+	}
+	should.Len(m.Calls(), 1)
+}
+
+func TestWarrantsPaging(t *testing.T) {
+	should := require.New(t)
+
+	m := pbtest.NewMock()
+	m.OnQotGetWarrant(func(req *pb.QotGetWarrantRequest) (*pb.QotGetWarrantResponse, error) {
+		list := []*pb.WarrantData{}
+		for i := req.GetBegin(); i < min(req.GetBegin()+req.GetNum(), 3); i++ {
+			list = append(list, &pb.WarrantData{Name: proto.String(string(rune('a' + i)))})
+		}
+		return &pb.QotGetWarrantResponse{
+			LastPage:        proto.Bool(req.GetBegin()+req.GetNum() >= 3),
+			AllCount:        proto.Int32(3),
+			WarrantDataList: list,
+		}, nil
+	})
+
+	req := &pb.QotGetWarrantRequest{
+		Begin:     proto.Int32(0),
+		Num:       proto.Int32(2),
+		SortField: pb.SortField_Code.Enum(),
+		Ascend:    proto.Bool(true),
+	}
+
+	names := ""
+	for w, err := range futu.Warrants(context.Background(), m, req) {
+		should.NoError(err)
+		names += w.GetName()
+	}
+	should.Equal("abc", names)
+	should.Len(m.QotGetWarrantCalls(), 2)
+	should.Equal(int32(2), m.QotGetWarrantCalls()[1].GetBegin())
+	should.Equal(int32(0), req.GetBegin())
+
+	// cancelled context stops with its error
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, err := range futu.Warrants(ctx, m, req) {
+		should.ErrorIs(err, context.Canceled)
+	}
+}
@@ -75,16 +75,6 @@ func (x RetType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *RetType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = RetType(num)
-	return nil
-}
-
 // Deprecated: Use RetType.Descriptor instead.
 func (RetType) EnumDescriptor() ([]byte, []int) {
 	return file_Common_proto_rawDescGZIP(), []int{0}
@@ -138,16 +128,6 @@ func (x PacketEncAlgo) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *PacketEncAlgo) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = PacketEncAlgo(num)
-	return nil
-}
-
 // Deprecated: Use PacketEncAlgo.Descriptor instead.
 func (PacketEncAlgo) EnumDescriptor() ([]byte, []int) {
 	return file_Common_proto_rawDescGZIP(), []int{1}
@@ -195,16 +175,6 @@ func (x ProtoFmt) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *ProtoFmt) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = ProtoFmt(num)
-	return nil
-}
-
 // Deprecated: Use ProtoFmt.Descriptor instead.
 func (ProtoFmt) EnumDescriptor() ([]byte, []int) {
 	return file_Common_proto_rawDescGZIP(), []int{2}
@@ -267,16 +237,6 @@ func (x UserAttribution) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *UserAttribution) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = UserAttribution(num)
-	return nil
-}
-
 // Deprecated: Use UserAttribution.Descriptor instead.
 func (UserAttribution) EnumDescriptor() ([]byte, []int) {
 	return file_Common_proto_rawDescGZIP(), []int{3}
@@ -357,16 +317,6 @@ func (x ProgramStatusType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *ProgramStatusType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = ProgramStatusType(num)
-	return nil
-}
-
 // Deprecated: Use ProgramStatusType.Descriptor instead.
 func (ProgramStatusType) EnumDescriptor() ([]byte, []int) {
 	return file_Common_proto_rawDescGZIP(), []int{4}
@@ -422,16 +372,6 @@ func (x Session) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *Session) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = Session(num)
-	return nil
-}
-
 // Deprecated: Use Session.Descriptor instead.
 func (Session) EnumDescriptor() ([]byte, []int) {
 	return file_Common_proto_rawDescGZIP(), []int{5}
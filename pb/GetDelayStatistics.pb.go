@@ -68,16 +68,6 @@ func (x DelayStatisticsType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *DelayStatisticsType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = DelayStatisticsType(num)
-	return nil
-}
-
 // Deprecated: Use DelayStatisticsType.Descriptor instead.
 func (DelayStatisticsType) EnumDescriptor() ([]byte, []int) {
 	return file_GetDelayStatistics_proto_rawDescGZIP(), []int{0}
@@ -139,16 +129,6 @@ func (x QotPushStage) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *QotPushStage) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = QotPushStage(num)
-	return nil
-}
-
 // Deprecated: Use QotPushStage.Descriptor instead.
 func (QotPushStage) EnumDescriptor() ([]byte, []int) {
 	return file_GetDelayStatistics_proto_rawDescGZIP(), []int{1}
@@ -205,16 +185,6 @@ func (x QotPushType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *QotPushType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = QotPushType(num)
-	return nil
-}
-
 // Deprecated: Use QotPushType.Descriptor instead.
 func (QotPushType) EnumDescriptor() ([]byte, []int) {
 	return file_GetDelayStatistics_proto_rawDescGZIP(), []int{2}
@@ -65,16 +65,6 @@ func (x UpdateType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *UpdateType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = UpdateType(num)
-	return nil
-}
-
 // Deprecated: Use UpdateType.Descriptor instead.
 func (UpdateType) EnumDescriptor() ([]byte, []int) {
 	return file_GetUserInfo_proto_rawDescGZIP(), []int{0}
@@ -133,16 +123,6 @@ func (x UserInfoField) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *UserInfoField) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = UserInfoField(num)
-	return nil
-}
-
 // Deprecated: Use UserInfoField.Descriptor instead.
 func (UserInfoField) EnumDescriptor() ([]byte, []int) {
 	return file_GetUserInfo_proto_rawDescGZIP(), []int{1}
@@ -80,16 +80,6 @@ func (x NotifyType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *NotifyType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = NotifyType(num)
-	return nil
-}
-
 // Deprecated: Use NotifyType.Descriptor instead.
 func (NotifyType) EnumDescriptor() ([]byte, []int) {
 	return file_Notify_proto_rawDescGZIP(), []int{0}
@@ -178,16 +168,6 @@ func (x GtwEventType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *GtwEventType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = GtwEventType(num)
-	return nil
-}
-
 // Deprecated: Use GtwEventType.Descriptor instead.
 func (GtwEventType) EnumDescriptor() ([]byte, []int) {
 	return file_Notify_proto_rawDescGZIP(), []int{1}
@@ -83,16 +83,6 @@ func (x CodeChangeType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *CodeChangeType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = CodeChangeType(num)
-	return nil
-}
-
 // Deprecated: Use CodeChangeType.Descriptor instead.
 func (CodeChangeType) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_GetCodeChange_proto_rawDescGZIP(), []int{0}
@@ -145,16 +135,6 @@ func (x TimeFilterType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *TimeFilterType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = TimeFilterType(num)
-	return nil
-}
-
 // Deprecated: Use TimeFilterType.Descriptor instead.
 func (TimeFilterType) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_GetCodeChange_proto_rawDescGZIP(), []int{1}
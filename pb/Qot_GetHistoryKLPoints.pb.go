@@ -66,16 +66,6 @@ func (x NoDataMode) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *NoDataMode) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = NoDataMode(num)
-	return nil
-}
-
 // Deprecated: Use NoDataMode.Descriptor instead.
 func (NoDataMode) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_GetHistoryKLPoints_proto_rawDescGZIP(), []int{0}
@@ -129,16 +119,6 @@ func (x DataStatus) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *DataStatus) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = DataStatus(num)
-	return nil
-}
-
 // Deprecated: Use DataStatus.Descriptor instead.
 func (DataStatus) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_GetHistoryKLPoints_proto_rawDescGZIP(), []int{1}
@@ -65,16 +65,6 @@ func (x OptionCondType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *OptionCondType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = OptionCondType(num)
-	return nil
-}
-
 // Deprecated: Use OptionCondType.Descriptor instead.
 func (OptionCondType) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_GetOptionChain_proto_rawDescGZIP(), []int{0}
@@ -65,16 +65,6 @@ func (x ReferenceType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *ReferenceType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = ReferenceType(num)
-	return nil
-}
-
 // Deprecated: Use ReferenceType.Descriptor instead.
 func (ReferenceType) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_GetReference_proto_rawDescGZIP(), []int{0}
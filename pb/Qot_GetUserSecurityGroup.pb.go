@@ -69,16 +69,6 @@ func (x GroupType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *GroupType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = GroupType(num)
-	return nil
-}
-
 // Deprecated: Use GroupType.Descriptor instead.
 func (GroupType) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_GetUserSecurityGroup_proto_rawDescGZIP(), []int{0}
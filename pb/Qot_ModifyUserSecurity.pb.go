@@ -68,16 +68,6 @@ func (x ModifyUserSecurityOp) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *ModifyUserSecurityOp) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = ModifyUserSecurityOp(num)
-	return nil
-}
-
 // Deprecated: Use ModifyUserSecurityOp.Descriptor instead.
 func (ModifyUserSecurityOp) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_ModifyUserSecurity_proto_rawDescGZIP(), []int{0}
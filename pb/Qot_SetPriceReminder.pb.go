@@ -77,16 +77,6 @@ func (x SetPriceReminderOp) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *SetPriceReminderOp) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = SetPriceReminderOp(num)
-	return nil
-}
-
 // Deprecated: Use SetPriceReminderOp.Descriptor instead.
 func (SetPriceReminderOp) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_SetPriceReminder_proto_rawDescGZIP(), []int{0}
@@ -124,16 +124,6 @@ func (x StockField) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *StockField) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = StockField(num)
-	return nil
-}
-
 // Deprecated: Use StockField.Descriptor instead.
 func (StockField) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_StockFilter_proto_rawDescGZIP(), []int{0}
@@ -193,16 +183,6 @@ func (x AccumulateField) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *AccumulateField) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = AccumulateField(num)
-	return nil
-}
-
 // Deprecated: Use AccumulateField.Descriptor instead.
 func (AccumulateField) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_StockFilter_proto_rawDescGZIP(), []int{1}
@@ -393,16 +373,6 @@ func (x FinancialField) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *FinancialField) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = FinancialField(num)
-	return nil
-}
-
 // Deprecated: Use FinancialField.Descriptor instead.
 func (FinancialField) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_StockFilter_proto_rawDescGZIP(), []int{2}
@@ -531,16 +501,6 @@ func (x CustomIndicatorField) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *CustomIndicatorField) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = CustomIndicatorField(num)
-	return nil
-}
-
 // Deprecated: Use CustomIndicatorField.Descriptor instead.
 func (CustomIndicatorField) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_StockFilter_proto_rawDescGZIP(), []int{3}
@@ -645,16 +605,6 @@ func (x PatternField) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *PatternField) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = PatternField(num)
-	return nil
-}
-
 // Deprecated: Use PatternField.Descriptor instead.
 func (PatternField) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_StockFilter_proto_rawDescGZIP(), []int{4}
@@ -714,16 +664,6 @@ func (x FinancialQuarter) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *FinancialQuarter) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = FinancialQuarter(num)
-	return nil
-}
-
 // Deprecated: Use FinancialQuarter.Descriptor instead.
 func (FinancialQuarter) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_StockFilter_proto_rawDescGZIP(), []int{5}
@@ -780,16 +720,6 @@ func (x RelativePosition) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *RelativePosition) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = RelativePosition(num)
-	return nil
-}
-
 // Deprecated: Use RelativePosition.Descriptor instead.
 func (RelativePosition) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_StockFilter_proto_rawDescGZIP(), []int{6}
@@ -840,16 +770,6 @@ func (x SortDir) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *SortDir) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = SortDir(num)
-	return nil
-}
-
 // Deprecated: Use SortDir.Descriptor instead.
 func (SortDir) EnumDescriptor() ([]byte, []int) {
 	return file_Qot_StockFilter_proto_rawDescGZIP(), []int{7}
@@ -65,16 +65,6 @@ func (x TrdCashFlowDirection) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *TrdCashFlowDirection) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = TrdCashFlowDirection(num)
-	return nil
-}
-
 // Deprecated: Use TrdCashFlowDirection.Descriptor instead.
 func (TrdCashFlowDirection) EnumDescriptor() ([]byte, []int) {
 	return file_Trd_FlowSummary_proto_rawDescGZIP(), []int{0}
@@ -65,16 +65,6 @@ func (x VerificationType) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *VerificationType) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = VerificationType(num)
-	return nil
-}
-
 // Deprecated: Use VerificationType.Descriptor instead.
 func (VerificationType) EnumDescriptor() ([]byte, []int) {
 	return file_Verification_proto_rawDescGZIP(), []int{0}
@@ -124,16 +114,6 @@ func (x VerificationOp) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Do not use.
-func (x *VerificationOp) UnmarshalJSON(b []byte) error {
-	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
-	if err != nil {
-		return err
-	}
-	*x = VerificationOp(num)
-	return nil
-}
-
 // Deprecated: Use VerificationOp.Descriptor instead.
 func (VerificationOp) EnumDescriptor() ([]byte, []int) {
 	return file_Verification_proto_rawDescGZIP(), []int{1}
@@ -0,0 +1,7889 @@
+// Code generated by protoc-gen-go-futu. DO NOT EDIT.
+
+package pb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrUnknownEnumName = errors.New("unknown enum name")
+
+func normEnumName(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+// enumJSONText returns the text of a JSON string or number, eg: "Real" or 1.
+func enumJSONText(b []byte) (string, error) {
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		err := json.Unmarshal(b, &s)
+		return s, err
+	}
+	return string(b), nil
+}
+
+// Name returns the value name without the AccumulateField_ prefix.
+func (x AccumulateField) Name() string {
+	switch x {
+	case AccumulateField_Unknown:
+		return "Unknown"
+	case AccumulateField_ChangeRate:
+		return "ChangeRate"
+	case AccumulateField_Amplitude:
+		return "Amplitude"
+	case AccumulateField_Volume:
+		return "Volume"
+	case AccumulateField_Turnover:
+		return "Turnover"
+	case AccumulateField_TurnoverRate:
+		return "TurnoverRate"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_AccumulateField = map[string]AccumulateField{
+	"accumulatefieldamplitude":    AccumulateField_Amplitude,
+	"accumulatefieldchangerate":   AccumulateField_ChangeRate,
+	"accumulatefieldturnover":     AccumulateField_Turnover,
+	"accumulatefieldturnoverrate": AccumulateField_TurnoverRate,
+	"accumulatefieldunknown":      AccumulateField_Unknown,
+	"accumulatefieldvolume":       AccumulateField_Volume,
+	"amplitude":                   AccumulateField_Amplitude,
+	"changerate":                  AccumulateField_ChangeRate,
+	"turnover":                    AccumulateField_Turnover,
+	"turnoverrate":                AccumulateField_TurnoverRate,
+	"unknown":                     AccumulateField_Unknown,
+	"volume":                      AccumulateField_Volume,
+}
+
+// ParseAccumulateField parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseAccumulateField(s string) (AccumulateField, error) {
+	if v, ok := parseNames_AccumulateField[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := AccumulateField_name[int32(n)]; ok {
+			return AccumulateField(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: AccumulateField %q", ErrUnknownEnumName, s)
+}
+
+func (x AccumulateField) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *AccumulateField) UnmarshalText(b []byte) error {
+	v, err := ParseAccumulateField(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *AccumulateField) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the AssetClass_ prefix.
+func (x AssetClass) Name() string {
+	switch x {
+	case AssetClass_Unknown:
+		return "Unknown"
+	case AssetClass_Stock:
+		return "Stock"
+	case AssetClass_Bond:
+		return "Bond"
+	case AssetClass_Commodity:
+		return "Commodity"
+	case AssetClass_CurrencyMarket:
+		return "CurrencyMarket"
+	case AssetClass_Future:
+		return "Future"
+	case AssetClass_Swap:
+		return "Swap"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_AssetClass = map[string]AssetClass{
+	"assetclassbond":           AssetClass_Bond,
+	"assetclasscommodity":      AssetClass_Commodity,
+	"assetclasscurrencymarket": AssetClass_CurrencyMarket,
+	"assetclassfuture":         AssetClass_Future,
+	"assetclassstock":          AssetClass_Stock,
+	"assetclassswap":           AssetClass_Swap,
+	"assetclassunknown":        AssetClass_Unknown,
+	"bond":                     AssetClass_Bond,
+	"commodity":                AssetClass_Commodity,
+	"currencymarket":           AssetClass_CurrencyMarket,
+	"future":                   AssetClass_Future,
+	"stock":                    AssetClass_Stock,
+	"swap":                     AssetClass_Swap,
+	"unknown":                  AssetClass_Unknown,
+}
+
+// ParseAssetClass parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseAssetClass(s string) (AssetClass, error) {
+	if v, ok := parseNames_AssetClass[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := AssetClass_name[int32(n)]; ok {
+			return AssetClass(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: AssetClass %q", ErrUnknownEnumName, s)
+}
+
+func (x AssetClass) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *AssetClass) UnmarshalText(b []byte) error {
+	v, err := ParseAssetClass(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *AssetClass) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the CltRiskLevel_ prefix.
+func (x CltRiskLevel) Name() string {
+	switch x {
+	case CltRiskLevel_Unknown:
+		return "Unknown"
+	case CltRiskLevel_Safe:
+		return "Safe"
+	case CltRiskLevel_Warning:
+		return "Warning"
+	case CltRiskLevel_Danger:
+		return "Danger"
+	case CltRiskLevel_AbsoluteSafe:
+		return "AbsoluteSafe"
+	case CltRiskLevel_OptDanger:
+		return "OptDanger"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_CltRiskLevel = map[string]CltRiskLevel{
+	"absolutesafe":             CltRiskLevel_AbsoluteSafe,
+	"cltrisklevelabsolutesafe": CltRiskLevel_AbsoluteSafe,
+	"cltriskleveldanger":       CltRiskLevel_Danger,
+	"cltriskleveloptdanger":    CltRiskLevel_OptDanger,
+	"cltrisklevelsafe":         CltRiskLevel_Safe,
+	"cltrisklevelunknown":      CltRiskLevel_Unknown,
+	"cltrisklevelwarning":      CltRiskLevel_Warning,
+	"danger":                   CltRiskLevel_Danger,
+	"optdanger":                CltRiskLevel_OptDanger,
+	"safe":                     CltRiskLevel_Safe,
+	"unknown":                  CltRiskLevel_Unknown,
+	"warning":                  CltRiskLevel_Warning,
+}
+
+// ParseCltRiskLevel parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseCltRiskLevel(s string) (CltRiskLevel, error) {
+	if v, ok := parseNames_CltRiskLevel[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := CltRiskLevel_name[int32(n)]; ok {
+			return CltRiskLevel(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: CltRiskLevel %q", ErrUnknownEnumName, s)
+}
+
+func (x CltRiskLevel) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *CltRiskLevel) UnmarshalText(b []byte) error {
+	v, err := ParseCltRiskLevel(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *CltRiskLevel) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the CltRiskStatus_ prefix.
+func (x CltRiskStatus) Name() string {
+	switch x {
+	case CltRiskStatus_Unknown:
+		return "Unknown"
+	case CltRiskStatus_Level1:
+		return "Level1"
+	case CltRiskStatus_Level2:
+		return "Level2"
+	case CltRiskStatus_Level3:
+		return "Level3"
+	case CltRiskStatus_Level4:
+		return "Level4"
+	case CltRiskStatus_Level5:
+		return "Level5"
+	case CltRiskStatus_Level6:
+		return "Level6"
+	case CltRiskStatus_Level7:
+		return "Level7"
+	case CltRiskStatus_Level8:
+		return "Level8"
+	case CltRiskStatus_Level9:
+		return "Level9"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_CltRiskStatus = map[string]CltRiskStatus{
+	"cltriskstatuslevel1":  CltRiskStatus_Level1,
+	"cltriskstatuslevel2":  CltRiskStatus_Level2,
+	"cltriskstatuslevel3":  CltRiskStatus_Level3,
+	"cltriskstatuslevel4":  CltRiskStatus_Level4,
+	"cltriskstatuslevel5":  CltRiskStatus_Level5,
+	"cltriskstatuslevel6":  CltRiskStatus_Level6,
+	"cltriskstatuslevel7":  CltRiskStatus_Level7,
+	"cltriskstatuslevel8":  CltRiskStatus_Level8,
+	"cltriskstatuslevel9":  CltRiskStatus_Level9,
+	"cltriskstatusunknown": CltRiskStatus_Unknown,
+	"level1":               CltRiskStatus_Level1,
+	"level2":               CltRiskStatus_Level2,
+	"level3":               CltRiskStatus_Level3,
+	"level4":               CltRiskStatus_Level4,
+	"level5":               CltRiskStatus_Level5,
+	"level6":               CltRiskStatus_Level6,
+	"level7":               CltRiskStatus_Level7,
+	"level8":               CltRiskStatus_Level8,
+	"level9":               CltRiskStatus_Level9,
+	"unknown":              CltRiskStatus_Unknown,
+}
+
+// ParseCltRiskStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseCltRiskStatus(s string) (CltRiskStatus, error) {
+	if v, ok := parseNames_CltRiskStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := CltRiskStatus_name[int32(n)]; ok {
+			return CltRiskStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: CltRiskStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x CltRiskStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *CltRiskStatus) UnmarshalText(b []byte) error {
+	v, err := ParseCltRiskStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *CltRiskStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the CodeChangeType_ prefix.
+func (x CodeChangeType) Name() string {
+	switch x {
+	case CodeChangeType_Unkown:
+		return "Unkown"
+	case CodeChangeType_GemToMain:
+		return "GemToMain"
+	case CodeChangeType_Unpaid:
+		return "Unpaid"
+	case CodeChangeType_ChangeLot:
+		return "ChangeLot"
+	case CodeChangeType_Split:
+		return "Split"
+	case CodeChangeType_Joint:
+		return "Joint"
+	case CodeChangeType_JointSplit:
+		return "JointSplit"
+	case CodeChangeType_SplitJoint:
+		return "SplitJoint"
+	case CodeChangeType_Other:
+		return "Other"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_CodeChangeType = map[string]CodeChangeType{
+	"changelot":                CodeChangeType_ChangeLot,
+	"codechangechangelot":      CodeChangeType_ChangeLot,
+	"codechangegemtomain":      CodeChangeType_GemToMain,
+	"codechangejoint":          CodeChangeType_Joint,
+	"codechangejointsplit":     CodeChangeType_JointSplit,
+	"codechangeother":          CodeChangeType_Other,
+	"codechangesplit":          CodeChangeType_Split,
+	"codechangesplitjoint":     CodeChangeType_SplitJoint,
+	"codechangetypechangelot":  CodeChangeType_ChangeLot,
+	"codechangetypegemtomain":  CodeChangeType_GemToMain,
+	"codechangetypejoint":      CodeChangeType_Joint,
+	"codechangetypejointsplit": CodeChangeType_JointSplit,
+	"codechangetypeother":      CodeChangeType_Other,
+	"codechangetypesplit":      CodeChangeType_Split,
+	"codechangetypesplitjoint": CodeChangeType_SplitJoint,
+	"codechangetypeunkown":     CodeChangeType_Unkown,
+	"codechangetypeunpaid":     CodeChangeType_Unpaid,
+	"codechangeunkown":         CodeChangeType_Unkown,
+	"codechangeunpaid":         CodeChangeType_Unpaid,
+	"gemtomain":                CodeChangeType_GemToMain,
+	"joint":                    CodeChangeType_Joint,
+	"jointsplit":               CodeChangeType_JointSplit,
+	"other":                    CodeChangeType_Other,
+	"split":                    CodeChangeType_Split,
+	"splitjoint":               CodeChangeType_SplitJoint,
+	"unkown":                   CodeChangeType_Unkown,
+	"unpaid":                   CodeChangeType_Unpaid,
+}
+
+// ParseCodeChangeType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseCodeChangeType(s string) (CodeChangeType, error) {
+	if v, ok := parseNames_CodeChangeType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := CodeChangeType_name[int32(n)]; ok {
+			return CodeChangeType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: CodeChangeType %q", ErrUnknownEnumName, s)
+}
+
+func (x CodeChangeType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *CodeChangeType) UnmarshalText(b []byte) error {
+	v, err := ParseCodeChangeType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *CodeChangeType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the CompanyAct_ prefix.
+func (x CompanyAct) Name() string {
+	switch x {
+	case CompanyAct_None:
+		return "None"
+	case CompanyAct_Split:
+		return "Split"
+	case CompanyAct_Join:
+		return "Join"
+	case CompanyAct_Bonus:
+		return "Bonus"
+	case CompanyAct_Transfer:
+		return "Transfer"
+	case CompanyAct_Allot:
+		return "Allot"
+	case CompanyAct_Add:
+		return "Add"
+	case CompanyAct_Dividend:
+		return "Dividend"
+	case CompanyAct_SPDividend:
+		return "SPDividend"
+	case CompanyAct_SpinOff:
+		return "SpinOff"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_CompanyAct = map[string]CompanyAct{
+	"add":                  CompanyAct_Add,
+	"allot":                CompanyAct_Allot,
+	"bonus":                CompanyAct_Bonus,
+	"companyactadd":        CompanyAct_Add,
+	"companyactallot":      CompanyAct_Allot,
+	"companyactbonus":      CompanyAct_Bonus,
+	"companyactdividend":   CompanyAct_Dividend,
+	"companyactjoin":       CompanyAct_Join,
+	"companyactnone":       CompanyAct_None,
+	"companyactspdividend": CompanyAct_SPDividend,
+	"companyactspinoff":    CompanyAct_SpinOff,
+	"companyactsplit":      CompanyAct_Split,
+	"companyacttransfer":   CompanyAct_Transfer,
+	"dividend":             CompanyAct_Dividend,
+	"join":                 CompanyAct_Join,
+	"none":                 CompanyAct_None,
+	"spdividend":           CompanyAct_SPDividend,
+	"spinoff":              CompanyAct_SpinOff,
+	"split":                CompanyAct_Split,
+	"transfer":             CompanyAct_Transfer,
+}
+
+// ParseCompanyAct parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseCompanyAct(s string) (CompanyAct, error) {
+	if v, ok := parseNames_CompanyAct[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := CompanyAct_name[int32(n)]; ok {
+			return CompanyAct(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: CompanyAct %q", ErrUnknownEnumName, s)
+}
+
+func (x CompanyAct) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *CompanyAct) UnmarshalText(b []byte) error {
+	v, err := ParseCompanyAct(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *CompanyAct) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the Currency_ prefix.
+func (x Currency) Name() string {
+	switch x {
+	case Currency_Unknown:
+		return "Unknown"
+	case Currency_HKD:
+		return "HKD"
+	case Currency_USD:
+		return "USD"
+	case Currency_CNH:
+		return "CNH"
+	case Currency_JPY:
+		return "JPY"
+	case Currency_SGD:
+		return "SGD"
+	case Currency_AUD:
+		return "AUD"
+	case Currency_CAD:
+		return "CAD"
+	case Currency_MYR:
+		return "MYR"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_Currency = map[string]Currency{
+	"aud":             Currency_AUD,
+	"cad":             Currency_CAD,
+	"cnh":             Currency_CNH,
+	"currencyaud":     Currency_AUD,
+	"currencycad":     Currency_CAD,
+	"currencycnh":     Currency_CNH,
+	"currencyhkd":     Currency_HKD,
+	"currencyjpy":     Currency_JPY,
+	"currencymyr":     Currency_MYR,
+	"currencysgd":     Currency_SGD,
+	"currencyunknown": Currency_Unknown,
+	"currencyusd":     Currency_USD,
+	"hkd":             Currency_HKD,
+	"jpy":             Currency_JPY,
+	"myr":             Currency_MYR,
+	"sgd":             Currency_SGD,
+	"unknown":         Currency_Unknown,
+	"usd":             Currency_USD,
+}
+
+// ParseCurrency parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseCurrency(s string) (Currency, error) {
+	if v, ok := parseNames_Currency[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := Currency_name[int32(n)]; ok {
+			return Currency(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: Currency %q", ErrUnknownEnumName, s)
+}
+
+func (x Currency) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *Currency) UnmarshalText(b []byte) error {
+	v, err := ParseCurrency(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *Currency) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the CustomIndicatorField_ prefix.
+func (x CustomIndicatorField) Name() string {
+	switch x {
+	case CustomIndicatorField_Unknown:
+		return "Unknown"
+	case CustomIndicatorField_Price:
+		return "Price"
+	case CustomIndicatorField_MA5:
+		return "MA5"
+	case CustomIndicatorField_MA10:
+		return "MA10"
+	case CustomIndicatorField_MA20:
+		return "MA20"
+	case CustomIndicatorField_MA30:
+		return "MA30"
+	case CustomIndicatorField_MA60:
+		return "MA60"
+	case CustomIndicatorField_MA120:
+		return "MA120"
+	case CustomIndicatorField_MA250:
+		return "MA250"
+	case CustomIndicatorField_RSI:
+		return "RSI"
+	case CustomIndicatorField_EMA5:
+		return "EMA5"
+	case CustomIndicatorField_EMA10:
+		return "EMA10"
+	case CustomIndicatorField_EMA20:
+		return "EMA20"
+	case CustomIndicatorField_EMA30:
+		return "EMA30"
+	case CustomIndicatorField_EMA60:
+		return "EMA60"
+	case CustomIndicatorField_EMA120:
+		return "EMA120"
+	case CustomIndicatorField_EMA250:
+		return "EMA250"
+	case CustomIndicatorField_Value:
+		return "Value"
+	case CustomIndicatorField_MA:
+		return "MA"
+	case CustomIndicatorField_EMA:
+		return "EMA"
+	case CustomIndicatorField_KDJ_K:
+		return "KDJ_K"
+	case CustomIndicatorField_KDJ_D:
+		return "KDJ_D"
+	case CustomIndicatorField_KDJ_J:
+		return "KDJ_J"
+	case CustomIndicatorField_MACD_DIFF:
+		return "MACD_DIFF"
+	case CustomIndicatorField_MACD_DEA:
+		return "MACD_DEA"
+	case CustomIndicatorField_MACD:
+		return "MACD"
+	case CustomIndicatorField_BOLL_UPPER:
+		return "BOLL_UPPER"
+	case CustomIndicatorField_BOLL_MIDDLER:
+		return "BOLL_MIDDLER"
+	case CustomIndicatorField_BOLL_LOWER:
+		return "BOLL_LOWER"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_CustomIndicatorField = map[string]CustomIndicatorField{
+	"bolllower":                       CustomIndicatorField_BOLL_LOWER,
+	"bollmiddler":                     CustomIndicatorField_BOLL_MIDDLER,
+	"bollupper":                       CustomIndicatorField_BOLL_UPPER,
+	"customindicatorfieldbolllower":   CustomIndicatorField_BOLL_LOWER,
+	"customindicatorfieldbollmiddler": CustomIndicatorField_BOLL_MIDDLER,
+	"customindicatorfieldbollupper":   CustomIndicatorField_BOLL_UPPER,
+	"customindicatorfieldema":         CustomIndicatorField_EMA,
+	"customindicatorfieldema10":       CustomIndicatorField_EMA10,
+	"customindicatorfieldema120":      CustomIndicatorField_EMA120,
+	"customindicatorfieldema20":       CustomIndicatorField_EMA20,
+	"customindicatorfieldema250":      CustomIndicatorField_EMA250,
+	"customindicatorfieldema30":       CustomIndicatorField_EMA30,
+	"customindicatorfieldema5":        CustomIndicatorField_EMA5,
+	"customindicatorfieldema60":       CustomIndicatorField_EMA60,
+	"customindicatorfieldkdjd":        CustomIndicatorField_KDJ_D,
+	"customindicatorfieldkdjj":        CustomIndicatorField_KDJ_J,
+	"customindicatorfieldkdjk":        CustomIndicatorField_KDJ_K,
+	"customindicatorfieldma":          CustomIndicatorField_MA,
+	"customindicatorfieldma10":        CustomIndicatorField_MA10,
+	"customindicatorfieldma120":       CustomIndicatorField_MA120,
+	"customindicatorfieldma20":        CustomIndicatorField_MA20,
+	"customindicatorfieldma250":       CustomIndicatorField_MA250,
+	"customindicatorfieldma30":        CustomIndicatorField_MA30,
+	"customindicatorfieldma5":         CustomIndicatorField_MA5,
+	"customindicatorfieldma60":        CustomIndicatorField_MA60,
+	"customindicatorfieldmacd":        CustomIndicatorField_MACD,
+	"customindicatorfieldmacddea":     CustomIndicatorField_MACD_DEA,
+	"customindicatorfieldmacddiff":    CustomIndicatorField_MACD_DIFF,
+	"customindicatorfieldprice":       CustomIndicatorField_Price,
+	"customindicatorfieldrsi":         CustomIndicatorField_RSI,
+	"customindicatorfieldunknown":     CustomIndicatorField_Unknown,
+	"customindicatorfieldvalue":       CustomIndicatorField_Value,
+	"ema":                             CustomIndicatorField_EMA,
+	"ema10":                           CustomIndicatorField_EMA10,
+	"ema120":                          CustomIndicatorField_EMA120,
+	"ema20":                           CustomIndicatorField_EMA20,
+	"ema250":                          CustomIndicatorField_EMA250,
+	"ema30":                           CustomIndicatorField_EMA30,
+	"ema5":                            CustomIndicatorField_EMA5,
+	"ema60":                           CustomIndicatorField_EMA60,
+	"kdjd":                            CustomIndicatorField_KDJ_D,
+	"kdjj":                            CustomIndicatorField_KDJ_J,
+	"kdjk":                            CustomIndicatorField_KDJ_K,
+	"ma":                              CustomIndicatorField_MA,
+	"ma10":                            CustomIndicatorField_MA10,
+	"ma120":                           CustomIndicatorField_MA120,
+	"ma20":                            CustomIndicatorField_MA20,
+	"ma250":                           CustomIndicatorField_MA250,
+	"ma30":                            CustomIndicatorField_MA30,
+	"ma5":                             CustomIndicatorField_MA5,
+	"ma60":                            CustomIndicatorField_MA60,
+	"macd":                            CustomIndicatorField_MACD,
+	"macddea":                         CustomIndicatorField_MACD_DEA,
+	"macddiff":                        CustomIndicatorField_MACD_DIFF,
+	"price":                           CustomIndicatorField_Price,
+	"rsi":                             CustomIndicatorField_RSI,
+	"unknown":                         CustomIndicatorField_Unknown,
+	"value":                           CustomIndicatorField_Value,
+}
+
+// ParseCustomIndicatorField parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseCustomIndicatorField(s string) (CustomIndicatorField, error) {
+	if v, ok := parseNames_CustomIndicatorField[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := CustomIndicatorField_name[int32(n)]; ok {
+			return CustomIndicatorField(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: CustomIndicatorField %q", ErrUnknownEnumName, s)
+}
+
+func (x CustomIndicatorField) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *CustomIndicatorField) UnmarshalText(b []byte) error {
+	v, err := ParseCustomIndicatorField(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *CustomIndicatorField) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the DTStatus_ prefix.
+func (x DTStatus) Name() string {
+	switch x {
+	case DTStatus_Unknown:
+		return "Unknown"
+	case DTStatus_Unlimited:
+		return "Unlimited"
+	case DTStatus_EMCall:
+		return "EMCall"
+	case DTStatus_DTCall:
+		return "DTCall"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_DTStatus = map[string]DTStatus{
+	"dtcall":            DTStatus_DTCall,
+	"dtstatusdtcall":    DTStatus_DTCall,
+	"dtstatusemcall":    DTStatus_EMCall,
+	"dtstatusunknown":   DTStatus_Unknown,
+	"dtstatusunlimited": DTStatus_Unlimited,
+	"emcall":            DTStatus_EMCall,
+	"unknown":           DTStatus_Unknown,
+	"unlimited":         DTStatus_Unlimited,
+}
+
+// ParseDTStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseDTStatus(s string) (DTStatus, error) {
+	if v, ok := parseNames_DTStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := DTStatus_name[int32(n)]; ok {
+			return DTStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: DTStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x DTStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *DTStatus) UnmarshalText(b []byte) error {
+	v, err := ParseDTStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *DTStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the DarkStatus_ prefix.
+func (x DarkStatus) Name() string {
+	switch x {
+	case DarkStatus_None:
+		return "None"
+	case DarkStatus_Trading:
+		return "Trading"
+	case DarkStatus_End:
+		return "End"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_DarkStatus = map[string]DarkStatus{
+	"darkstatusend":     DarkStatus_End,
+	"darkstatusnone":    DarkStatus_None,
+	"darkstatustrading": DarkStatus_Trading,
+	"end":               DarkStatus_End,
+	"none":              DarkStatus_None,
+	"trading":           DarkStatus_Trading,
+}
+
+// ParseDarkStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseDarkStatus(s string) (DarkStatus, error) {
+	if v, ok := parseNames_DarkStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := DarkStatus_name[int32(n)]; ok {
+			return DarkStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: DarkStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x DarkStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *DarkStatus) UnmarshalText(b []byte) error {
+	v, err := ParseDarkStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *DarkStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the DataStatus_ prefix.
+func (x DataStatus) Name() string {
+	switch x {
+	case DataStatus_Null:
+		return "Null"
+	case DataStatus_Current:
+		return "Current"
+	case DataStatus_Previous:
+		return "Previous"
+	case DataStatus_Back:
+		return "Back"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_DataStatus = map[string]DataStatus{
+	"back":               DataStatus_Back,
+	"current":            DataStatus_Current,
+	"datastatusback":     DataStatus_Back,
+	"datastatuscurrent":  DataStatus_Current,
+	"datastatusnull":     DataStatus_Null,
+	"datastatusprevious": DataStatus_Previous,
+	"null":               DataStatus_Null,
+	"previous":           DataStatus_Previous,
+}
+
+// ParseDataStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseDataStatus(s string) (DataStatus, error) {
+	if v, ok := parseNames_DataStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := DataStatus_name[int32(n)]; ok {
+			return DataStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: DataStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x DataStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *DataStatus) UnmarshalText(b []byte) error {
+	v, err := ParseDataStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *DataStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the DelayStatisticsType_ prefix.
+func (x DelayStatisticsType) Name() string {
+	switch x {
+	case DelayStatisticsType_Unknown:
+		return "Unknown"
+	case DelayStatisticsType_QotPush:
+		return "QotPush"
+	case DelayStatisticsType_ReqReply:
+		return "ReqReply"
+	case DelayStatisticsType_PlaceOrder:
+		return "PlaceOrder"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_DelayStatisticsType = map[string]DelayStatisticsType{
+	"delaystatisticsplaceorder":     DelayStatisticsType_PlaceOrder,
+	"delaystatisticsqotpush":        DelayStatisticsType_QotPush,
+	"delaystatisticsreqreply":       DelayStatisticsType_ReqReply,
+	"delaystatisticstypeplaceorder": DelayStatisticsType_PlaceOrder,
+	"delaystatisticstypeqotpush":    DelayStatisticsType_QotPush,
+	"delaystatisticstypereqreply":   DelayStatisticsType_ReqReply,
+	"delaystatisticstypeunknown":    DelayStatisticsType_Unknown,
+	"delaystatisticsunknown":        DelayStatisticsType_Unknown,
+	"placeorder":                    DelayStatisticsType_PlaceOrder,
+	"qotpush":                       DelayStatisticsType_QotPush,
+	"reqreply":                      DelayStatisticsType_ReqReply,
+	"unknown":                       DelayStatisticsType_Unknown,
+}
+
+// ParseDelayStatisticsType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseDelayStatisticsType(s string) (DelayStatisticsType, error) {
+	if v, ok := parseNames_DelayStatisticsType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := DelayStatisticsType_name[int32(n)]; ok {
+			return DelayStatisticsType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: DelayStatisticsType %q", ErrUnknownEnumName, s)
+}
+
+func (x DelayStatisticsType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *DelayStatisticsType) UnmarshalText(b []byte) error {
+	v, err := ParseDelayStatisticsType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *DelayStatisticsType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the ExchType_ prefix.
+func (x ExchType) Name() string {
+	switch x {
+	case ExchType_Unknown:
+		return "Unknown"
+	case ExchType_HK_MainBoard:
+		return "HK_MainBoard"
+	case ExchType_HK_GEMBoard:
+		return "HK_GEMBoard"
+	case ExchType_HK_HKEX:
+		return "HK_HKEX"
+	case ExchType_US_NYSE:
+		return "US_NYSE"
+	case ExchType_US_Nasdaq:
+		return "US_Nasdaq"
+	case ExchType_US_Pink:
+		return "US_Pink"
+	case ExchType_US_AMEX:
+		return "US_AMEX"
+	case ExchType_US_Option:
+		return "US_Option"
+	case ExchType_US_NYMEX:
+		return "US_NYMEX"
+	case ExchType_US_COMEX:
+		return "US_COMEX"
+	case ExchType_US_CBOT:
+		return "US_CBOT"
+	case ExchType_US_CME:
+		return "US_CME"
+	case ExchType_US_CBOE:
+		return "US_CBOE"
+	case ExchType_CN_SH:
+		return "CN_SH"
+	case ExchType_CN_SZ:
+		return "CN_SZ"
+	case ExchType_CN_STIB:
+		return "CN_STIB"
+	case ExchType_SG_SGX:
+		return "SG_SGX"
+	case ExchType_JP_OSE:
+		return "JP_OSE"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_ExchType = map[string]ExchType{
+	"cnsh":                ExchType_CN_SH,
+	"cnstib":              ExchType_CN_STIB,
+	"cnsz":                ExchType_CN_SZ,
+	"exchcnsh":            ExchType_CN_SH,
+	"exchcnstib":          ExchType_CN_STIB,
+	"exchcnsz":            ExchType_CN_SZ,
+	"exchhkgemboard":      ExchType_HK_GEMBoard,
+	"exchhkhkex":          ExchType_HK_HKEX,
+	"exchhkmainboard":     ExchType_HK_MainBoard,
+	"exchjpose":           ExchType_JP_OSE,
+	"exchsgsgx":           ExchType_SG_SGX,
+	"exchtypecnsh":        ExchType_CN_SH,
+	"exchtypecnstib":      ExchType_CN_STIB,
+	"exchtypecnsz":        ExchType_CN_SZ,
+	"exchtypehkgemboard":  ExchType_HK_GEMBoard,
+	"exchtypehkhkex":      ExchType_HK_HKEX,
+	"exchtypehkmainboard": ExchType_HK_MainBoard,
+	"exchtypejpose":       ExchType_JP_OSE,
+	"exchtypesgsgx":       ExchType_SG_SGX,
+	"exchtypeunknown":     ExchType_Unknown,
+	"exchtypeusamex":      ExchType_US_AMEX,
+	"exchtypeuscboe":      ExchType_US_CBOE,
+	"exchtypeuscbot":      ExchType_US_CBOT,
+	"exchtypeuscme":       ExchType_US_CME,
+	"exchtypeuscomex":     ExchType_US_COMEX,
+	"exchtypeusnasdaq":    ExchType_US_Nasdaq,
+	"exchtypeusnymex":     ExchType_US_NYMEX,
+	"exchtypeusnyse":      ExchType_US_NYSE,
+	"exchtypeusoption":    ExchType_US_Option,
+	"exchtypeuspink":      ExchType_US_Pink,
+	"exchunknown":         ExchType_Unknown,
+	"exchusamex":          ExchType_US_AMEX,
+	"exchuscboe":          ExchType_US_CBOE,
+	"exchuscbot":          ExchType_US_CBOT,
+	"exchuscme":           ExchType_US_CME,
+	"exchuscomex":         ExchType_US_COMEX,
+	"exchusnasdaq":        ExchType_US_Nasdaq,
+	"exchusnymex":         ExchType_US_NYMEX,
+	"exchusnyse":          ExchType_US_NYSE,
+	"exchusoption":        ExchType_US_Option,
+	"exchuspink":          ExchType_US_Pink,
+	"hkgemboard":          ExchType_HK_GEMBoard,
+	"hkhkex":              ExchType_HK_HKEX,
+	"hkmainboard":         ExchType_HK_MainBoard,
+	"jpose":               ExchType_JP_OSE,
+	"sgsgx":               ExchType_SG_SGX,
+	"unknown":             ExchType_Unknown,
+	"usamex":              ExchType_US_AMEX,
+	"uscboe":              ExchType_US_CBOE,
+	"uscbot":              ExchType_US_CBOT,
+	"uscme":               ExchType_US_CME,
+	"uscomex":             ExchType_US_COMEX,
+	"usnasdaq":            ExchType_US_Nasdaq,
+	"usnymex":             ExchType_US_NYMEX,
+	"usnyse":              ExchType_US_NYSE,
+	"usoption":            ExchType_US_Option,
+	"uspink":              ExchType_US_Pink,
+}
+
+// ParseExchType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseExchType(s string) (ExchType, error) {
+	if v, ok := parseNames_ExchType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := ExchType_name[int32(n)]; ok {
+			return ExchType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: ExchType %q", ErrUnknownEnumName, s)
+}
+
+func (x ExchType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *ExchType) UnmarshalText(b []byte) error {
+	v, err := ParseExchType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *ExchType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the ExpirationCycle_ prefix.
+func (x ExpirationCycle) Name() string {
+	switch x {
+	case ExpirationCycle_Unknown:
+		return "Unknown"
+	case ExpirationCycle_Week:
+		return "Week"
+	case ExpirationCycle_Month:
+		return "Month"
+	case ExpirationCycle_MonthEnd:
+		return "MonthEnd"
+	case ExpirationCycle_Quarter:
+		return "Quarter"
+	case ExpirationCycle_WeekMon:
+		return "WeekMon"
+	case ExpirationCycle_WeekTue:
+		return "WeekTue"
+	case ExpirationCycle_WeekWed:
+		return "WeekWed"
+	case ExpirationCycle_WeekThu:
+		return "WeekThu"
+	case ExpirationCycle_WeekFri:
+		return "WeekFri"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_ExpirationCycle = map[string]ExpirationCycle{
+	"expirationcyclemonth":    ExpirationCycle_Month,
+	"expirationcyclemonthend": ExpirationCycle_MonthEnd,
+	"expirationcyclequarter":  ExpirationCycle_Quarter,
+	"expirationcycleunknown":  ExpirationCycle_Unknown,
+	"expirationcycleweek":     ExpirationCycle_Week,
+	"expirationcycleweekfri":  ExpirationCycle_WeekFri,
+	"expirationcycleweekmon":  ExpirationCycle_WeekMon,
+	"expirationcycleweekthu":  ExpirationCycle_WeekThu,
+	"expirationcycleweektue":  ExpirationCycle_WeekTue,
+	"expirationcycleweekwed":  ExpirationCycle_WeekWed,
+	"month":                   ExpirationCycle_Month,
+	"monthend":                ExpirationCycle_MonthEnd,
+	"quarter":                 ExpirationCycle_Quarter,
+	"unknown":                 ExpirationCycle_Unknown,
+	"week":                    ExpirationCycle_Week,
+	"weekfri":                 ExpirationCycle_WeekFri,
+	"weekmon":                 ExpirationCycle_WeekMon,
+	"weekthu":                 ExpirationCycle_WeekThu,
+	"weektue":                 ExpirationCycle_WeekTue,
+	"weekwed":                 ExpirationCycle_WeekWed,
+}
+
+// ParseExpirationCycle parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseExpirationCycle(s string) (ExpirationCycle, error) {
+	if v, ok := parseNames_ExpirationCycle[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := ExpirationCycle_name[int32(n)]; ok {
+			return ExpirationCycle(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: ExpirationCycle %q", ErrUnknownEnumName, s)
+}
+
+func (x ExpirationCycle) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *ExpirationCycle) UnmarshalText(b []byte) error {
+	v, err := ParseExpirationCycle(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *ExpirationCycle) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the FinancialField_ prefix.
+func (x FinancialField) Name() string {
+	switch x {
+	case FinancialField_Unknown:
+		return "Unknown"
+	case FinancialField_NetProfit:
+		return "NetProfit"
+	case FinancialField_NetProfitGrowth:
+		return "NetProfitGrowth"
+	case FinancialField_SumOfBusiness:
+		return "SumOfBusiness"
+	case FinancialField_SumOfBusinessGrowth:
+		return "SumOfBusinessGrowth"
+	case FinancialField_NetProfitRate:
+		return "NetProfitRate"
+	case FinancialField_GrossProfitRate:
+		return "GrossProfitRate"
+	case FinancialField_DebtAssetsRate:
+		return "DebtAssetsRate"
+	case FinancialField_ReturnOnEquityRate:
+		return "ReturnOnEquityRate"
+	case FinancialField_ROIC:
+		return "ROIC"
+	case FinancialField_ROATTM:
+		return "ROATTM"
+	case FinancialField_EBITTTM:
+		return "EBITTTM"
+	case FinancialField_EBITDA:
+		return "EBITDA"
+	case FinancialField_OperatingMarginTTM:
+		return "OperatingMarginTTM"
+	case FinancialField_EBITMargin:
+		return "EBITMargin"
+	case FinancialField_EBITDAMargin:
+		return "EBITDAMargin"
+	case FinancialField_FinancialCostRate:
+		return "FinancialCostRate"
+	case FinancialField_OperatingProfitTTM:
+		return "OperatingProfitTTM"
+	case FinancialField_ShareholderNetProfitTTM:
+		return "ShareholderNetProfitTTM"
+	case FinancialField_NetProfitCashCoverTTM:
+		return "NetProfitCashCoverTTM"
+	case FinancialField_CurrentRatio:
+		return "CurrentRatio"
+	case FinancialField_QuickRatio:
+		return "QuickRatio"
+	case FinancialField_CurrentAssetRatio:
+		return "CurrentAssetRatio"
+	case FinancialField_CurrentDebtRatio:
+		return "CurrentDebtRatio"
+	case FinancialField_EquityMultiplier:
+		return "EquityMultiplier"
+	case FinancialField_PropertyRatio:
+		return "PropertyRatio"
+	case FinancialField_CashAndCashEquivalents:
+		return "CashAndCashEquivalents"
+	case FinancialField_TotalAssetTurnover:
+		return "TotalAssetTurnover"
+	case FinancialField_FixedAssetTurnover:
+		return "FixedAssetTurnover"
+	case FinancialField_InventoryTurnover:
+		return "InventoryTurnover"
+	case FinancialField_OperatingCashFlowTTM:
+		return "OperatingCashFlowTTM"
+	case FinancialField_AccountsReceivable:
+		return "AccountsReceivable"
+	case FinancialField_EBITGrowthRate:
+		return "EBITGrowthRate"
+	case FinancialField_OperatingProfitGrowthRate:
+		return "OperatingProfitGrowthRate"
+	case FinancialField_TotalAssetsGrowthRate:
+		return "TotalAssetsGrowthRate"
+	case FinancialField_ProfitToShareholdersGrowthRate:
+		return "ProfitToShareholdersGrowthRate"
+	case FinancialField_ProfitBeforeTaxGrowthRate:
+		return "ProfitBeforeTaxGrowthRate"
+	case FinancialField_EPSGrowthRate:
+		return "EPSGrowthRate"
+	case FinancialField_ROEGrowthRate:
+		return "ROEGrowthRate"
+	case FinancialField_ROICGrowthRate:
+		return "ROICGrowthRate"
+	case FinancialField_NOCFGrowthRate:
+		return "NOCFGrowthRate"
+	case FinancialField_NOCFPerShareGrowthRate:
+		return "NOCFPerShareGrowthRate"
+	case FinancialField_OperatingRevenueCashCover:
+		return "OperatingRevenueCashCover"
+	case FinancialField_OperatingProfitToTotalProfit:
+		return "OperatingProfitToTotalProfit"
+	case FinancialField_BasicEPS:
+		return "BasicEPS"
+	case FinancialField_DilutedEPS:
+		return "DilutedEPS"
+	case FinancialField_NOCFPerShare:
+		return "NOCFPerShare"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_FinancialField = map[string]FinancialField{
+	"accountsreceivable":                           FinancialField_AccountsReceivable,
+	"basiceps":                                     FinancialField_BasicEPS,
+	"cashandcashequivalents":                       FinancialField_CashAndCashEquivalents,
+	"currentassetratio":                            FinancialField_CurrentAssetRatio,
+	"currentdebtratio":                             FinancialField_CurrentDebtRatio,
+	"currentratio":                                 FinancialField_CurrentRatio,
+	"debtassetsrate":                               FinancialField_DebtAssetsRate,
+	"dilutedeps":                                   FinancialField_DilutedEPS,
+	"ebitda":                                       FinancialField_EBITDA,
+	"ebitdamargin":                                 FinancialField_EBITDAMargin,
+	"ebitgrowthrate":                               FinancialField_EBITGrowthRate,
+	"ebitmargin":                                   FinancialField_EBITMargin,
+	"ebitttm":                                      FinancialField_EBITTTM,
+	"epsgrowthrate":                                FinancialField_EPSGrowthRate,
+	"equitymultiplier":                             FinancialField_EquityMultiplier,
+	"financialcostrate":                            FinancialField_FinancialCostRate,
+	"financialfieldaccountsreceivable":             FinancialField_AccountsReceivable,
+	"financialfieldbasiceps":                       FinancialField_BasicEPS,
+	"financialfieldcashandcashequivalents":         FinancialField_CashAndCashEquivalents,
+	"financialfieldcurrentassetratio":              FinancialField_CurrentAssetRatio,
+	"financialfieldcurrentdebtratio":               FinancialField_CurrentDebtRatio,
+	"financialfieldcurrentratio":                   FinancialField_CurrentRatio,
+	"financialfielddebtassetsrate":                 FinancialField_DebtAssetsRate,
+	"financialfielddilutedeps":                     FinancialField_DilutedEPS,
+	"financialfieldebitda":                         FinancialField_EBITDA,
+	"financialfieldebitdamargin":                   FinancialField_EBITDAMargin,
+	"financialfieldebitgrowthrate":                 FinancialField_EBITGrowthRate,
+	"financialfieldebitmargin":                     FinancialField_EBITMargin,
+	"financialfieldebitttm":                        FinancialField_EBITTTM,
+	"financialfieldepsgrowthrate":                  FinancialField_EPSGrowthRate,
+	"financialfieldequitymultiplier":               FinancialField_EquityMultiplier,
+	"financialfieldfinancialcostrate":              FinancialField_FinancialCostRate,
+	"financialfieldfixedassetturnover":             FinancialField_FixedAssetTurnover,
+	"financialfieldgrossprofitrate":                FinancialField_GrossProfitRate,
+	"financialfieldinventoryturnover":              FinancialField_InventoryTurnover,
+	"financialfieldnetprofit":                      FinancialField_NetProfit,
+	"financialfieldnetprofitcashcoverttm":          FinancialField_NetProfitCashCoverTTM,
+	"financialfieldnetprofitgrowth":                FinancialField_NetProfitGrowth,
+	"financialfieldnetprofitrate":                  FinancialField_NetProfitRate,
+	"financialfieldnocfgrowthrate":                 FinancialField_NOCFGrowthRate,
+	"financialfieldnocfpershare":                   FinancialField_NOCFPerShare,
+	"financialfieldnocfpersharegrowthrate":         FinancialField_NOCFPerShareGrowthRate,
+	"financialfieldoperatingcashflowttm":           FinancialField_OperatingCashFlowTTM,
+	"financialfieldoperatingmarginttm":             FinancialField_OperatingMarginTTM,
+	"financialfieldoperatingprofitgrowthrate":      FinancialField_OperatingProfitGrowthRate,
+	"financialfieldoperatingprofittototalprofit":   FinancialField_OperatingProfitToTotalProfit,
+	"financialfieldoperatingprofitttm":             FinancialField_OperatingProfitTTM,
+	"financialfieldoperatingrevenuecashcover":      FinancialField_OperatingRevenueCashCover,
+	"financialfieldprofitbeforetaxgrowthrate":      FinancialField_ProfitBeforeTaxGrowthRate,
+	"financialfieldprofittoshareholdersgrowthrate": FinancialField_ProfitToShareholdersGrowthRate,
+	"financialfieldpropertyratio":                  FinancialField_PropertyRatio,
+	"financialfieldquickratio":                     FinancialField_QuickRatio,
+	"financialfieldreturnonequityrate":             FinancialField_ReturnOnEquityRate,
+	"financialfieldroattm":                         FinancialField_ROATTM,
+	"financialfieldroegrowthrate":                  FinancialField_ROEGrowthRate,
+	"financialfieldroic":                           FinancialField_ROIC,
+	"financialfieldroicgrowthrate":                 FinancialField_ROICGrowthRate,
+	"financialfieldshareholdernetprofitttm":        FinancialField_ShareholderNetProfitTTM,
+	"financialfieldsumofbusiness":                  FinancialField_SumOfBusiness,
+	"financialfieldsumofbusinessgrowth":            FinancialField_SumOfBusinessGrowth,
+	"financialfieldtotalassetsgrowthrate":          FinancialField_TotalAssetsGrowthRate,
+	"financialfieldtotalassetturnover":             FinancialField_TotalAssetTurnover,
+	"financialfieldunknown":                        FinancialField_Unknown,
+	"fixedassetturnover":                           FinancialField_FixedAssetTurnover,
+	"grossprofitrate":                              FinancialField_GrossProfitRate,
+	"inventoryturnover":                            FinancialField_InventoryTurnover,
+	"netprofit":                                    FinancialField_NetProfit,
+	"netprofitcashcoverttm":                        FinancialField_NetProfitCashCoverTTM,
+	"netprofitgrowth":                              FinancialField_NetProfitGrowth,
+	"netprofitrate":                                FinancialField_NetProfitRate,
+	"nocfgrowthrate":                               FinancialField_NOCFGrowthRate,
+	"nocfpershare":                                 FinancialField_NOCFPerShare,
+	"nocfpersharegrowthrate":                       FinancialField_NOCFPerShareGrowthRate,
+	"operatingcashflowttm":                         FinancialField_OperatingCashFlowTTM,
+	"operatingmarginttm":                           FinancialField_OperatingMarginTTM,
+	"operatingprofitgrowthrate":                    FinancialField_OperatingProfitGrowthRate,
+	"operatingprofittototalprofit":                 FinancialField_OperatingProfitToTotalProfit,
+	"operatingprofitttm":                           FinancialField_OperatingProfitTTM,
+	"operatingrevenuecashcover":                    FinancialField_OperatingRevenueCashCover,
+	"profitbeforetaxgrowthrate":                    FinancialField_ProfitBeforeTaxGrowthRate,
+	"profittoshareholdersgrowthrate":               FinancialField_ProfitToShareholdersGrowthRate,
+	"propertyratio":                                FinancialField_PropertyRatio,
+	"quickratio":                                   FinancialField_QuickRatio,
+	"returnonequityrate":                           FinancialField_ReturnOnEquityRate,
+	"roattm":                                       FinancialField_ROATTM,
+	"roegrowthrate":                                FinancialField_ROEGrowthRate,
+	"roic":                                         FinancialField_ROIC,
+	"roicgrowthrate":                               FinancialField_ROICGrowthRate,
+	"shareholdernetprofitttm":                      FinancialField_ShareholderNetProfitTTM,
+	"sumofbusiness":                                FinancialField_SumOfBusiness,
+	"sumofbusinessgrowth":                          FinancialField_SumOfBusinessGrowth,
+	"totalassetsgrowthrate":                        FinancialField_TotalAssetsGrowthRate,
+	"totalassetturnover":                           FinancialField_TotalAssetTurnover,
+	"unknown":                                      FinancialField_Unknown,
+}
+
+// ParseFinancialField parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseFinancialField(s string) (FinancialField, error) {
+	if v, ok := parseNames_FinancialField[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := FinancialField_name[int32(n)]; ok {
+			return FinancialField(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: FinancialField %q", ErrUnknownEnumName, s)
+}
+
+func (x FinancialField) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *FinancialField) UnmarshalText(b []byte) error {
+	v, err := ParseFinancialField(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *FinancialField) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the FinancialQuarter_ prefix.
+func (x FinancialQuarter) Name() string {
+	switch x {
+	case FinancialQuarter_Unknown:
+		return "Unknown"
+	case FinancialQuarter_Annual:
+		return "Annual"
+	case FinancialQuarter_FirstQuarter:
+		return "FirstQuarter"
+	case FinancialQuarter_Interim:
+		return "Interim"
+	case FinancialQuarter_ThirdQuarter:
+		return "ThirdQuarter"
+	case FinancialQuarter_MostRecentQuarter:
+		return "MostRecentQuarter"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_FinancialQuarter = map[string]FinancialQuarter{
+	"annual":                            FinancialQuarter_Annual,
+	"financialquarterannual":            FinancialQuarter_Annual,
+	"financialquarterfirstquarter":      FinancialQuarter_FirstQuarter,
+	"financialquarterinterim":           FinancialQuarter_Interim,
+	"financialquartermostrecentquarter": FinancialQuarter_MostRecentQuarter,
+	"financialquarterthirdquarter":      FinancialQuarter_ThirdQuarter,
+	"financialquarterunknown":           FinancialQuarter_Unknown,
+	"firstquarter":                      FinancialQuarter_FirstQuarter,
+	"interim":                           FinancialQuarter_Interim,
+	"mostrecentquarter":                 FinancialQuarter_MostRecentQuarter,
+	"thirdquarter":                      FinancialQuarter_ThirdQuarter,
+	"unknown":                           FinancialQuarter_Unknown,
+}
+
+// ParseFinancialQuarter parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseFinancialQuarter(s string) (FinancialQuarter, error) {
+	if v, ok := parseNames_FinancialQuarter[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := FinancialQuarter_name[int32(n)]; ok {
+			return FinancialQuarter(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: FinancialQuarter %q", ErrUnknownEnumName, s)
+}
+
+func (x FinancialQuarter) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *FinancialQuarter) UnmarshalText(b []byte) error {
+	v, err := ParseFinancialQuarter(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *FinancialQuarter) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the GroupType_ prefix.
+func (x GroupType) Name() string {
+	switch x {
+	case GroupType_Unknown:
+		return "Unknown"
+	case GroupType_Custom:
+		return "Custom"
+	case GroupType_System:
+		return "System"
+	case GroupType_All:
+		return "All"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_GroupType = map[string]GroupType{
+	"all":              GroupType_All,
+	"custom":           GroupType_Custom,
+	"groupall":         GroupType_All,
+	"groupcustom":      GroupType_Custom,
+	"groupsystem":      GroupType_System,
+	"grouptypeall":     GroupType_All,
+	"grouptypecustom":  GroupType_Custom,
+	"grouptypesystem":  GroupType_System,
+	"grouptypeunknown": GroupType_Unknown,
+	"groupunknown":     GroupType_Unknown,
+	"system":           GroupType_System,
+	"unknown":          GroupType_Unknown,
+}
+
+// ParseGroupType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseGroupType(s string) (GroupType, error) {
+	if v, ok := parseNames_GroupType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := GroupType_name[int32(n)]; ok {
+			return GroupType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: GroupType %q", ErrUnknownEnumName, s)
+}
+
+func (x GroupType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *GroupType) UnmarshalText(b []byte) error {
+	v, err := ParseGroupType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *GroupType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the GtwEventType_ prefix.
+func (x GtwEventType) Name() string {
+	switch x {
+	case GtwEventType_None:
+		return "None"
+	case GtwEventType_LocalCfgLoadFailed:
+		return "LocalCfgLoadFailed"
+	case GtwEventType_APISvrRunFailed:
+		return "APISvrRunFailed"
+	case GtwEventType_ForceUpdate:
+		return "ForceUpdate"
+	case GtwEventType_LoginFailed:
+		return "LoginFailed"
+	case GtwEventType_UnAgreeDisclaimer:
+		return "UnAgreeDisclaimer"
+	case GtwEventType_NetCfgMissing:
+		return "NetCfgMissing"
+	case GtwEventType_KickedOut:
+		return "KickedOut"
+	case GtwEventType_LoginPwdChanged:
+		return "LoginPwdChanged"
+	case GtwEventType_BanLogin:
+		return "BanLogin"
+	case GtwEventType_NeedPicVerifyCode:
+		return "NeedPicVerifyCode"
+	case GtwEventType_NeedPhoneVerifyCode:
+		return "NeedPhoneVerifyCode"
+	case GtwEventType_AppDataNotExist:
+		return "AppDataNotExist"
+	case GtwEventType_NessaryDataMissing:
+		return "NessaryDataMissing"
+	case GtwEventType_TradePwdChanged:
+		return "TradePwdChanged"
+	case GtwEventType_EnableDeviceLock:
+		return "EnableDeviceLock"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_GtwEventType = map[string]GtwEventType{
+	"apisvrrunfailed":                 GtwEventType_APISvrRunFailed,
+	"appdatanotexist":                 GtwEventType_AppDataNotExist,
+	"banlogin":                        GtwEventType_BanLogin,
+	"enabledevicelock":                GtwEventType_EnableDeviceLock,
+	"forceupdate":                     GtwEventType_ForceUpdate,
+	"gtweventapisvrrunfailed":         GtwEventType_APISvrRunFailed,
+	"gtweventappdatanotexist":         GtwEventType_AppDataNotExist,
+	"gtweventbanlogin":                GtwEventType_BanLogin,
+	"gtweventenabledevicelock":        GtwEventType_EnableDeviceLock,
+	"gtweventforceupdate":             GtwEventType_ForceUpdate,
+	"gtweventkickedout":               GtwEventType_KickedOut,
+	"gtweventlocalcfgloadfailed":      GtwEventType_LocalCfgLoadFailed,
+	"gtweventloginfailed":             GtwEventType_LoginFailed,
+	"gtweventloginpwdchanged":         GtwEventType_LoginPwdChanged,
+	"gtweventneedphoneverifycode":     GtwEventType_NeedPhoneVerifyCode,
+	"gtweventneedpicverifycode":       GtwEventType_NeedPicVerifyCode,
+	"gtweventnessarydatamissing":      GtwEventType_NessaryDataMissing,
+	"gtweventnetcfgmissing":           GtwEventType_NetCfgMissing,
+	"gtweventnone":                    GtwEventType_None,
+	"gtweventtradepwdchanged":         GtwEventType_TradePwdChanged,
+	"gtweventtypeapisvrrunfailed":     GtwEventType_APISvrRunFailed,
+	"gtweventtypeappdatanotexist":     GtwEventType_AppDataNotExist,
+	"gtweventtypebanlogin":            GtwEventType_BanLogin,
+	"gtweventtypeenabledevicelock":    GtwEventType_EnableDeviceLock,
+	"gtweventtypeforceupdate":         GtwEventType_ForceUpdate,
+	"gtweventtypekickedout":           GtwEventType_KickedOut,
+	"gtweventtypelocalcfgloadfailed":  GtwEventType_LocalCfgLoadFailed,
+	"gtweventtypeloginfailed":         GtwEventType_LoginFailed,
+	"gtweventtypeloginpwdchanged":     GtwEventType_LoginPwdChanged,
+	"gtweventtypeneedphoneverifycode": GtwEventType_NeedPhoneVerifyCode,
+	"gtweventtypeneedpicverifycode":   GtwEventType_NeedPicVerifyCode,
+	"gtweventtypenessarydatamissing":  GtwEventType_NessaryDataMissing,
+	"gtweventtypenetcfgmissing":       GtwEventType_NetCfgMissing,
+	"gtweventtypenone":                GtwEventType_None,
+	"gtweventtypetradepwdchanged":     GtwEventType_TradePwdChanged,
+	"gtweventtypeunagreedisclaimer":   GtwEventType_UnAgreeDisclaimer,
+	"gtweventunagreedisclaimer":       GtwEventType_UnAgreeDisclaimer,
+	"kickedout":                       GtwEventType_KickedOut,
+	"localcfgloadfailed":              GtwEventType_LocalCfgLoadFailed,
+	"loginfailed":                     GtwEventType_LoginFailed,
+	"loginpwdchanged":                 GtwEventType_LoginPwdChanged,
+	"needphoneverifycode":             GtwEventType_NeedPhoneVerifyCode,
+	"needpicverifycode":               GtwEventType_NeedPicVerifyCode,
+	"nessarydatamissing":              GtwEventType_NessaryDataMissing,
+	"netcfgmissing":                   GtwEventType_NetCfgMissing,
+	"none":                            GtwEventType_None,
+	"tradepwdchanged":                 GtwEventType_TradePwdChanged,
+	"unagreedisclaimer":               GtwEventType_UnAgreeDisclaimer,
+}
+
+// ParseGtwEventType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseGtwEventType(s string) (GtwEventType, error) {
+	if v, ok := parseNames_GtwEventType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := GtwEventType_name[int32(n)]; ok {
+			return GtwEventType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: GtwEventType %q", ErrUnknownEnumName, s)
+}
+
+func (x GtwEventType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *GtwEventType) UnmarshalText(b []byte) error {
+	v, err := ParseGtwEventType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *GtwEventType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the HolderCategory_ prefix.
+func (x HolderCategory) Name() string {
+	switch x {
+	case HolderCategory_Unknown:
+		return "Unknown"
+	case HolderCategory_Agency:
+		return "Agency"
+	case HolderCategory_Fund:
+		return "Fund"
+	case HolderCategory_SeniorManager:
+		return "SeniorManager"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_HolderCategory = map[string]HolderCategory{
+	"agency":                      HolderCategory_Agency,
+	"fund":                        HolderCategory_Fund,
+	"holdercategoryagency":        HolderCategory_Agency,
+	"holdercategoryfund":          HolderCategory_Fund,
+	"holdercategoryseniormanager": HolderCategory_SeniorManager,
+	"holdercategoryunknown":       HolderCategory_Unknown,
+	"seniormanager":               HolderCategory_SeniorManager,
+	"unknown":                     HolderCategory_Unknown,
+}
+
+// ParseHolderCategory parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseHolderCategory(s string) (HolderCategory, error) {
+	if v, ok := parseNames_HolderCategory[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := HolderCategory_name[int32(n)]; ok {
+			return HolderCategory(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: HolderCategory %q", ErrUnknownEnumName, s)
+}
+
+func (x HolderCategory) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *HolderCategory) UnmarshalText(b []byte) error {
+	v, err := ParseHolderCategory(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *HolderCategory) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the IndexOptionType_ prefix.
+func (x IndexOptionType) Name() string {
+	switch x {
+	case IndexOptionType_Unknown:
+		return "Unknown"
+	case IndexOptionType_Normal:
+		return "Normal"
+	case IndexOptionType_Small:
+		return "Small"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_IndexOptionType = map[string]IndexOptionType{
+	"indexoptionnormal":      IndexOptionType_Normal,
+	"indexoptionsmall":       IndexOptionType_Small,
+	"indexoptiontypenormal":  IndexOptionType_Normal,
+	"indexoptiontypesmall":   IndexOptionType_Small,
+	"indexoptiontypeunknown": IndexOptionType_Unknown,
+	"indexoptionunknown":     IndexOptionType_Unknown,
+	"normal":                 IndexOptionType_Normal,
+	"small":                  IndexOptionType_Small,
+	"unknown":                IndexOptionType_Unknown,
+}
+
+// ParseIndexOptionType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseIndexOptionType(s string) (IndexOptionType, error) {
+	if v, ok := parseNames_IndexOptionType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := IndexOptionType_name[int32(n)]; ok {
+			return IndexOptionType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: IndexOptionType %q", ErrUnknownEnumName, s)
+}
+
+func (x IndexOptionType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *IndexOptionType) UnmarshalText(b []byte) error {
+	v, err := ParseIndexOptionType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *IndexOptionType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the IpoPeriod_ prefix.
+func (x IpoPeriod) Name() string {
+	switch x {
+	case IpoPeriod_Unknown:
+		return "Unknown"
+	case IpoPeriod_Today:
+		return "Today"
+	case IpoPeriod_Tomorrow:
+		return "Tomorrow"
+	case IpoPeriod_Nextweek:
+		return "Nextweek"
+	case IpoPeriod_Lastweek:
+		return "Lastweek"
+	case IpoPeriod_Lastmonth:
+		return "Lastmonth"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_IpoPeriod = map[string]IpoPeriod{
+	"ipoperiodlastmonth": IpoPeriod_Lastmonth,
+	"ipoperiodlastweek":  IpoPeriod_Lastweek,
+	"ipoperiodnextweek":  IpoPeriod_Nextweek,
+	"ipoperiodtoday":     IpoPeriod_Today,
+	"ipoperiodtomorrow":  IpoPeriod_Tomorrow,
+	"ipoperiodunknown":   IpoPeriod_Unknown,
+	"lastmonth":          IpoPeriod_Lastmonth,
+	"lastweek":           IpoPeriod_Lastweek,
+	"nextweek":           IpoPeriod_Nextweek,
+	"today":              IpoPeriod_Today,
+	"tomorrow":           IpoPeriod_Tomorrow,
+	"unknown":            IpoPeriod_Unknown,
+}
+
+// ParseIpoPeriod parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseIpoPeriod(s string) (IpoPeriod, error) {
+	if v, ok := parseNames_IpoPeriod[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := IpoPeriod_name[int32(n)]; ok {
+			return IpoPeriod(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: IpoPeriod %q", ErrUnknownEnumName, s)
+}
+
+func (x IpoPeriod) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *IpoPeriod) UnmarshalText(b []byte) error {
+	v, err := ParseIpoPeriod(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *IpoPeriod) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the Issuer_ prefix.
+func (x Issuer) Name() string {
+	switch x {
+	case Issuer_Unknown:
+		return "Unknown"
+	case Issuer_SG:
+		return "SG"
+	case Issuer_BP:
+		return "BP"
+	case Issuer_CS:
+		return "CS"
+	case Issuer_CT:
+		return "CT"
+	case Issuer_EA:
+		return "EA"
+	case Issuer_GS:
+		return "GS"
+	case Issuer_HS:
+		return "HS"
+	case Issuer_JP:
+		return "JP"
+	case Issuer_MB:
+		return "MB"
+	case Issuer_SC:
+		return "SC"
+	case Issuer_UB:
+		return "UB"
+	case Issuer_BI:
+		return "BI"
+	case Issuer_DB:
+		return "DB"
+	case Issuer_DC:
+		return "DC"
+	case Issuer_ML:
+		return "ML"
+	case Issuer_NM:
+		return "NM"
+	case Issuer_RB:
+		return "RB"
+	case Issuer_RS:
+		return "RS"
+	case Issuer_BC:
+		return "BC"
+	case Issuer_HT:
+		return "HT"
+	case Issuer_VT:
+		return "VT"
+	case Issuer_KC:
+		return "KC"
+	case Issuer_MS:
+		return "MS"
+	case Issuer_GJ:
+		return "GJ"
+	case Issuer_XZ:
+		return "XZ"
+	case Issuer_HU:
+		return "HU"
+	case Issuer_KS:
+		return "KS"
+	case Issuer_CI:
+		return "CI"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_Issuer = map[string]Issuer{
+	"bc":            Issuer_BC,
+	"bi":            Issuer_BI,
+	"bp":            Issuer_BP,
+	"ci":            Issuer_CI,
+	"cs":            Issuer_CS,
+	"ct":            Issuer_CT,
+	"db":            Issuer_DB,
+	"dc":            Issuer_DC,
+	"ea":            Issuer_EA,
+	"gj":            Issuer_GJ,
+	"gs":            Issuer_GS,
+	"hs":            Issuer_HS,
+	"ht":            Issuer_HT,
+	"hu":            Issuer_HU,
+	"issuerbc":      Issuer_BC,
+	"issuerbi":      Issuer_BI,
+	"issuerbp":      Issuer_BP,
+	"issuerci":      Issuer_CI,
+	"issuercs":      Issuer_CS,
+	"issuerct":      Issuer_CT,
+	"issuerdb":      Issuer_DB,
+	"issuerdc":      Issuer_DC,
+	"issuerea":      Issuer_EA,
+	"issuergj":      Issuer_GJ,
+	"issuergs":      Issuer_GS,
+	"issuerhs":      Issuer_HS,
+	"issuerht":      Issuer_HT,
+	"issuerhu":      Issuer_HU,
+	"issuerjp":      Issuer_JP,
+	"issuerkc":      Issuer_KC,
+	"issuerks":      Issuer_KS,
+	"issuermb":      Issuer_MB,
+	"issuerml":      Issuer_ML,
+	"issuerms":      Issuer_MS,
+	"issuernm":      Issuer_NM,
+	"issuerrb":      Issuer_RB,
+	"issuerrs":      Issuer_RS,
+	"issuersc":      Issuer_SC,
+	"issuersg":      Issuer_SG,
+	"issuerub":      Issuer_UB,
+	"issuerunknown": Issuer_Unknown,
+	"issuervt":      Issuer_VT,
+	"issuerxz":      Issuer_XZ,
+	"jp":            Issuer_JP,
+	"kc":            Issuer_KC,
+	"ks":            Issuer_KS,
+	"mb":            Issuer_MB,
+	"ml":            Issuer_ML,
+	"ms":            Issuer_MS,
+	"nm":            Issuer_NM,
+	"rb":            Issuer_RB,
+	"rs":            Issuer_RS,
+	"sc":            Issuer_SC,
+	"sg":            Issuer_SG,
+	"ub":            Issuer_UB,
+	"unknown":       Issuer_Unknown,
+	"vt":            Issuer_VT,
+	"xz":            Issuer_XZ,
+}
+
+// ParseIssuer parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseIssuer(s string) (Issuer, error) {
+	if v, ok := parseNames_Issuer[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := Issuer_name[int32(n)]; ok {
+			return Issuer(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: Issuer %q", ErrUnknownEnumName, s)
+}
+
+func (x Issuer) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *Issuer) UnmarshalText(b []byte) error {
+	v, err := ParseIssuer(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *Issuer) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the KLFields_ prefix.
+func (x KLFields) Name() string {
+	switch x {
+	case KLFields_None:
+		return "None"
+	case KLFields_High:
+		return "High"
+	case KLFields_Open:
+		return "Open"
+	case KLFields_Low:
+		return "Low"
+	case KLFields_Close:
+		return "Close"
+	case KLFields_LastClose:
+		return "LastClose"
+	case KLFields_Volume:
+		return "Volume"
+	case KLFields_Turnover:
+		return "Turnover"
+	case KLFields_TurnoverRate:
+		return "TurnoverRate"
+	case KLFields_PE:
+		return "PE"
+	case KLFields_ChangeRate:
+		return "ChangeRate"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_KLFields = map[string]KLFields{
+	"changerate":           KLFields_ChangeRate,
+	"close":                KLFields_Close,
+	"high":                 KLFields_High,
+	"klfieldschangerate":   KLFields_ChangeRate,
+	"klfieldsclose":        KLFields_Close,
+	"klfieldshigh":         KLFields_High,
+	"klfieldslastclose":    KLFields_LastClose,
+	"klfieldslow":          KLFields_Low,
+	"klfieldsnone":         KLFields_None,
+	"klfieldsopen":         KLFields_Open,
+	"klfieldspe":           KLFields_PE,
+	"klfieldsturnover":     KLFields_Turnover,
+	"klfieldsturnoverrate": KLFields_TurnoverRate,
+	"klfieldsvolume":       KLFields_Volume,
+	"lastclose":            KLFields_LastClose,
+	"low":                  KLFields_Low,
+	"none":                 KLFields_None,
+	"open":                 KLFields_Open,
+	"pe":                   KLFields_PE,
+	"turnover":             KLFields_Turnover,
+	"turnoverrate":         KLFields_TurnoverRate,
+	"volume":               KLFields_Volume,
+}
+
+// ParseKLFields parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseKLFields(s string) (KLFields, error) {
+	if v, ok := parseNames_KLFields[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := KLFields_name[int32(n)]; ok {
+			return KLFields(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: KLFields %q", ErrUnknownEnumName, s)
+}
+
+func (x KLFields) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *KLFields) UnmarshalText(b []byte) error {
+	v, err := ParseKLFields(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *KLFields) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the KLType_ prefix.
+func (x KLType) Name() string {
+	switch x {
+	case KLType_Unknown:
+		return "Unknown"
+	case KLType_1Min:
+		return "1Min"
+	case KLType_Day:
+		return "Day"
+	case KLType_Week:
+		return "Week"
+	case KLType_Month:
+		return "Month"
+	case KLType_Year:
+		return "Year"
+	case KLType_5Min:
+		return "5Min"
+	case KLType_15Min:
+		return "15Min"
+	case KLType_30Min:
+		return "30Min"
+	case KLType_60Min:
+		return "60Min"
+	case KLType_3Min:
+		return "3Min"
+	case KLType_Quarter:
+		return "Quarter"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_KLType = map[string]KLType{
+	"15min":         KLType_15Min,
+	"1min":          KLType_1Min,
+	"30min":         KLType_30Min,
+	"3min":          KLType_3Min,
+	"5min":          KLType_5Min,
+	"60min":         KLType_60Min,
+	"day":           KLType_Day,
+	"kl15min":       KLType_15Min,
+	"kl1min":        KLType_1Min,
+	"kl30min":       KLType_30Min,
+	"kl3min":        KLType_3Min,
+	"kl5min":        KLType_5Min,
+	"kl60min":       KLType_60Min,
+	"klday":         KLType_Day,
+	"klmonth":       KLType_Month,
+	"klquarter":     KLType_Quarter,
+	"kltype15min":   KLType_15Min,
+	"kltype1min":    KLType_1Min,
+	"kltype30min":   KLType_30Min,
+	"kltype3min":    KLType_3Min,
+	"kltype5min":    KLType_5Min,
+	"kltype60min":   KLType_60Min,
+	"kltypeday":     KLType_Day,
+	"kltypemonth":   KLType_Month,
+	"kltypequarter": KLType_Quarter,
+	"kltypeunknown": KLType_Unknown,
+	"kltypeweek":    KLType_Week,
+	"kltypeyear":    KLType_Year,
+	"klunknown":     KLType_Unknown,
+	"klweek":        KLType_Week,
+	"klyear":        KLType_Year,
+	"month":         KLType_Month,
+	"quarter":       KLType_Quarter,
+	"unknown":       KLType_Unknown,
+	"week":          KLType_Week,
+	"year":          KLType_Year,
+}
+
+// ParseKLType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseKLType(s string) (KLType, error) {
+	if v, ok := parseNames_KLType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := KLType_name[int32(n)]; ok {
+			return KLType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: KLType %q", ErrUnknownEnumName, s)
+}
+
+func (x KLType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *KLType) UnmarshalText(b []byte) error {
+	v, err := ParseKLType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *KLType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the ModifyOrderOp_ prefix.
+func (x ModifyOrderOp) Name() string {
+	switch x {
+	case ModifyOrderOp_Unknown:
+		return "Unknown"
+	case ModifyOrderOp_Normal:
+		return "Normal"
+	case ModifyOrderOp_Cancel:
+		return "Cancel"
+	case ModifyOrderOp_Disable:
+		return "Disable"
+	case ModifyOrderOp_Enable:
+		return "Enable"
+	case ModifyOrderOp_Delete:
+		return "Delete"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_ModifyOrderOp = map[string]ModifyOrderOp{
+	"cancel":               ModifyOrderOp_Cancel,
+	"delete":               ModifyOrderOp_Delete,
+	"disable":              ModifyOrderOp_Disable,
+	"enable":               ModifyOrderOp_Enable,
+	"modifyorderopcancel":  ModifyOrderOp_Cancel,
+	"modifyorderopdelete":  ModifyOrderOp_Delete,
+	"modifyorderopdisable": ModifyOrderOp_Disable,
+	"modifyorderopenable":  ModifyOrderOp_Enable,
+	"modifyorderopnormal":  ModifyOrderOp_Normal,
+	"modifyorderopunknown": ModifyOrderOp_Unknown,
+	"normal":               ModifyOrderOp_Normal,
+	"unknown":              ModifyOrderOp_Unknown,
+}
+
+// ParseModifyOrderOp parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseModifyOrderOp(s string) (ModifyOrderOp, error) {
+	if v, ok := parseNames_ModifyOrderOp[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := ModifyOrderOp_name[int32(n)]; ok {
+			return ModifyOrderOp(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: ModifyOrderOp %q", ErrUnknownEnumName, s)
+}
+
+func (x ModifyOrderOp) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *ModifyOrderOp) UnmarshalText(b []byte) error {
+	v, err := ParseModifyOrderOp(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *ModifyOrderOp) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the ModifyUserSecurityOp_ prefix.
+func (x ModifyUserSecurityOp) Name() string {
+	switch x {
+	case ModifyUserSecurityOp_Unknown:
+		return "Unknown"
+	case ModifyUserSecurityOp_Add:
+		return "Add"
+	case ModifyUserSecurityOp_Del:
+		return "Del"
+	case ModifyUserSecurityOp_MoveOut:
+		return "MoveOut"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_ModifyUserSecurityOp = map[string]ModifyUserSecurityOp{
+	"add":                         ModifyUserSecurityOp_Add,
+	"del":                         ModifyUserSecurityOp_Del,
+	"modifyusersecurityopadd":     ModifyUserSecurityOp_Add,
+	"modifyusersecurityopdel":     ModifyUserSecurityOp_Del,
+	"modifyusersecurityopmoveout": ModifyUserSecurityOp_MoveOut,
+	"modifyusersecurityopunknown": ModifyUserSecurityOp_Unknown,
+	"moveout":                     ModifyUserSecurityOp_MoveOut,
+	"unknown":                     ModifyUserSecurityOp_Unknown,
+}
+
+// ParseModifyUserSecurityOp parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseModifyUserSecurityOp(s string) (ModifyUserSecurityOp, error) {
+	if v, ok := parseNames_ModifyUserSecurityOp[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := ModifyUserSecurityOp_name[int32(n)]; ok {
+			return ModifyUserSecurityOp(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: ModifyUserSecurityOp %q", ErrUnknownEnumName, s)
+}
+
+func (x ModifyUserSecurityOp) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *ModifyUserSecurityOp) UnmarshalText(b []byte) error {
+	v, err := ParseModifyUserSecurityOp(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *ModifyUserSecurityOp) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the NoDataMode_ prefix.
+func (x NoDataMode) Name() string {
+	switch x {
+	case NoDataMode_Null:
+		return "Null"
+	case NoDataMode_Forward:
+		return "Forward"
+	case NoDataMode_Backward:
+		return "Backward"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_NoDataMode = map[string]NoDataMode{
+	"backward":           NoDataMode_Backward,
+	"forward":            NoDataMode_Forward,
+	"nodatamodebackward": NoDataMode_Backward,
+	"nodatamodeforward":  NoDataMode_Forward,
+	"nodatamodenull":     NoDataMode_Null,
+	"null":               NoDataMode_Null,
+}
+
+// ParseNoDataMode parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseNoDataMode(s string) (NoDataMode, error) {
+	if v, ok := parseNames_NoDataMode[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := NoDataMode_name[int32(n)]; ok {
+			return NoDataMode(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: NoDataMode %q", ErrUnknownEnumName, s)
+}
+
+func (x NoDataMode) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *NoDataMode) UnmarshalText(b []byte) error {
+	v, err := ParseNoDataMode(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *NoDataMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the NotifyType_ prefix.
+func (x NotifyType) Name() string {
+	switch x {
+	case NotifyType_None:
+		return "None"
+	case NotifyType_GtwEvent:
+		return "GtwEvent"
+	case NotifyType_ProgramStatus:
+		return "ProgramStatus"
+	case NotifyType_ConnStatus:
+		return "ConnStatus"
+	case NotifyType_QotRight:
+		return "QotRight"
+	case NotifyType_APILevel:
+		return "APILevel"
+	case NotifyType_APIQuota:
+		return "APIQuota"
+	case NotifyType_UsedQuota:
+		return "UsedQuota"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_NotifyType = map[string]NotifyType{
+	"apilevel":                NotifyType_APILevel,
+	"apiquota":                NotifyType_APIQuota,
+	"connstatus":              NotifyType_ConnStatus,
+	"gtwevent":                NotifyType_GtwEvent,
+	"none":                    NotifyType_None,
+	"notifyapilevel":          NotifyType_APILevel,
+	"notifyapiquota":          NotifyType_APIQuota,
+	"notifyconnstatus":        NotifyType_ConnStatus,
+	"notifygtwevent":          NotifyType_GtwEvent,
+	"notifynone":              NotifyType_None,
+	"notifyprogramstatus":     NotifyType_ProgramStatus,
+	"notifyqotright":          NotifyType_QotRight,
+	"notifytypeapilevel":      NotifyType_APILevel,
+	"notifytypeapiquota":      NotifyType_APIQuota,
+	"notifytypeconnstatus":    NotifyType_ConnStatus,
+	"notifytypegtwevent":      NotifyType_GtwEvent,
+	"notifytypenone":          NotifyType_None,
+	"notifytypeprogramstatus": NotifyType_ProgramStatus,
+	"notifytypeqotright":      NotifyType_QotRight,
+	"notifytypeusedquota":     NotifyType_UsedQuota,
+	"notifyusedquota":         NotifyType_UsedQuota,
+	"programstatus":           NotifyType_ProgramStatus,
+	"qotright":                NotifyType_QotRight,
+	"usedquota":               NotifyType_UsedQuota,
+}
+
+// ParseNotifyType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseNotifyType(s string) (NotifyType, error) {
+	if v, ok := parseNames_NotifyType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := NotifyType_name[int32(n)]; ok {
+			return NotifyType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: NotifyType %q", ErrUnknownEnumName, s)
+}
+
+func (x NotifyType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *NotifyType) UnmarshalText(b []byte) error {
+	v, err := ParseNotifyType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *NotifyType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the OptionAreaType_ prefix.
+func (x OptionAreaType) Name() string {
+	switch x {
+	case OptionAreaType_Unknown:
+		return "Unknown"
+	case OptionAreaType_American:
+		return "American"
+	case OptionAreaType_European:
+		return "European"
+	case OptionAreaType_Bermuda:
+		return "Bermuda"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_OptionAreaType = map[string]OptionAreaType{
+	"american":               OptionAreaType_American,
+	"bermuda":                OptionAreaType_Bermuda,
+	"european":               OptionAreaType_European,
+	"optionareaamerican":     OptionAreaType_American,
+	"optionareabermuda":      OptionAreaType_Bermuda,
+	"optionareaeuropean":     OptionAreaType_European,
+	"optionareatypeamerican": OptionAreaType_American,
+	"optionareatypebermuda":  OptionAreaType_Bermuda,
+	"optionareatypeeuropean": OptionAreaType_European,
+	"optionareatypeunknown":  OptionAreaType_Unknown,
+	"optionareaunknown":      OptionAreaType_Unknown,
+	"unknown":                OptionAreaType_Unknown,
+}
+
+// ParseOptionAreaType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseOptionAreaType(s string) (OptionAreaType, error) {
+	if v, ok := parseNames_OptionAreaType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := OptionAreaType_name[int32(n)]; ok {
+			return OptionAreaType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: OptionAreaType %q", ErrUnknownEnumName, s)
+}
+
+func (x OptionAreaType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *OptionAreaType) UnmarshalText(b []byte) error {
+	v, err := ParseOptionAreaType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *OptionAreaType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the OptionCondType_ prefix.
+func (x OptionCondType) Name() string {
+	switch x {
+	case OptionCondType_Unknown:
+		return "Unknown"
+	case OptionCondType_WithIn:
+		return "WithIn"
+	case OptionCondType_Outside:
+		return "Outside"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_OptionCondType = map[string]OptionCondType{
+	"optioncondoutside":     OptionCondType_Outside,
+	"optioncondtypeoutside": OptionCondType_Outside,
+	"optioncondtypeunknown": OptionCondType_Unknown,
+	"optioncondtypewithin":  OptionCondType_WithIn,
+	"optioncondunknown":     OptionCondType_Unknown,
+	"optioncondwithin":      OptionCondType_WithIn,
+	"outside":               OptionCondType_Outside,
+	"unknown":               OptionCondType_Unknown,
+	"within":                OptionCondType_WithIn,
+}
+
+// ParseOptionCondType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseOptionCondType(s string) (OptionCondType, error) {
+	if v, ok := parseNames_OptionCondType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := OptionCondType_name[int32(n)]; ok {
+			return OptionCondType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: OptionCondType %q", ErrUnknownEnumName, s)
+}
+
+func (x OptionCondType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *OptionCondType) UnmarshalText(b []byte) error {
+	v, err := ParseOptionCondType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *OptionCondType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the OptionSettlementMode_ prefix.
+func (x OptionSettlementMode) Name() string {
+	switch x {
+	case OptionSettlementMode_Unknown:
+		return "Unknown"
+	case OptionSettlementMode_AM:
+		return "AM"
+	case OptionSettlementMode_PM:
+		return "PM"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_OptionSettlementMode = map[string]OptionSettlementMode{
+	"am":                          OptionSettlementMode_AM,
+	"optionsettlementmodeam":      OptionSettlementMode_AM,
+	"optionsettlementmodepm":      OptionSettlementMode_PM,
+	"optionsettlementmodeunknown": OptionSettlementMode_Unknown,
+	"pm":                          OptionSettlementMode_PM,
+	"unknown":                     OptionSettlementMode_Unknown,
+}
+
+// ParseOptionSettlementMode parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseOptionSettlementMode(s string) (OptionSettlementMode, error) {
+	if v, ok := parseNames_OptionSettlementMode[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := OptionSettlementMode_name[int32(n)]; ok {
+			return OptionSettlementMode(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: OptionSettlementMode %q", ErrUnknownEnumName, s)
+}
+
+func (x OptionSettlementMode) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *OptionSettlementMode) UnmarshalText(b []byte) error {
+	v, err := ParseOptionSettlementMode(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *OptionSettlementMode) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the OptionStandardType_ prefix.
+func (x OptionStandardType) Name() string {
+	switch x {
+	case OptionStandardType_Unknown:
+		return "Unknown"
+	case OptionStandardType_Standard:
+		return "Standard"
+	case OptionStandardType_NonStandard:
+		return "NonStandard"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_OptionStandardType = map[string]OptionStandardType{
+	"nonstandard":                   OptionStandardType_NonStandard,
+	"optionstandardnonstandard":     OptionStandardType_NonStandard,
+	"optionstandardstandard":        OptionStandardType_Standard,
+	"optionstandardtypenonstandard": OptionStandardType_NonStandard,
+	"optionstandardtypestandard":    OptionStandardType_Standard,
+	"optionstandardtypeunknown":     OptionStandardType_Unknown,
+	"optionstandardunknown":         OptionStandardType_Unknown,
+	"standard":                      OptionStandardType_Standard,
+	"unknown":                       OptionStandardType_Unknown,
+}
+
+// ParseOptionStandardType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseOptionStandardType(s string) (OptionStandardType, error) {
+	if v, ok := parseNames_OptionStandardType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := OptionStandardType_name[int32(n)]; ok {
+			return OptionStandardType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: OptionStandardType %q", ErrUnknownEnumName, s)
+}
+
+func (x OptionStandardType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *OptionStandardType) UnmarshalText(b []byte) error {
+	v, err := ParseOptionStandardType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *OptionStandardType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the OptionType_ prefix.
+func (x OptionType) Name() string {
+	switch x {
+	case OptionType_Unknown:
+		return "Unknown"
+	case OptionType_Call:
+		return "Call"
+	case OptionType_Put:
+		return "Put"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_OptionType = map[string]OptionType{
+	"call":              OptionType_Call,
+	"optioncall":        OptionType_Call,
+	"optionput":         OptionType_Put,
+	"optiontypecall":    OptionType_Call,
+	"optiontypeput":     OptionType_Put,
+	"optiontypeunknown": OptionType_Unknown,
+	"optionunknown":     OptionType_Unknown,
+	"put":               OptionType_Put,
+	"unknown":           OptionType_Unknown,
+}
+
+// ParseOptionType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseOptionType(s string) (OptionType, error) {
+	if v, ok := parseNames_OptionType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := OptionType_name[int32(n)]; ok {
+			return OptionType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: OptionType %q", ErrUnknownEnumName, s)
+}
+
+func (x OptionType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *OptionType) UnmarshalText(b []byte) error {
+	v, err := ParseOptionType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *OptionType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the OrderFillStatus_ prefix.
+func (x OrderFillStatus) Name() string {
+	switch x {
+	case OrderFillStatus_OK:
+		return "OK"
+	case OrderFillStatus_Cancelled:
+		return "Cancelled"
+	case OrderFillStatus_Changed:
+		return "Changed"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_OrderFillStatus = map[string]OrderFillStatus{
+	"cancelled":                OrderFillStatus_Cancelled,
+	"changed":                  OrderFillStatus_Changed,
+	"ok":                       OrderFillStatus_OK,
+	"orderfillstatuscancelled": OrderFillStatus_Cancelled,
+	"orderfillstatuschanged":   OrderFillStatus_Changed,
+	"orderfillstatusok":        OrderFillStatus_OK,
+}
+
+// ParseOrderFillStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseOrderFillStatus(s string) (OrderFillStatus, error) {
+	if v, ok := parseNames_OrderFillStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := OrderFillStatus_name[int32(n)]; ok {
+			return OrderFillStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: OrderFillStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x OrderFillStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *OrderFillStatus) UnmarshalText(b []byte) error {
+	v, err := ParseOrderFillStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *OrderFillStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the OrderStatus_ prefix.
+func (x OrderStatus) Name() string {
+	switch x {
+	case OrderStatus_Unsubmitted:
+		return "Unsubmitted"
+	case OrderStatus_Unknown:
+		return "Unknown"
+	case OrderStatus_WaitingSubmit:
+		return "WaitingSubmit"
+	case OrderStatus_Submitting:
+		return "Submitting"
+	case OrderStatus_SubmitFailed:
+		return "SubmitFailed"
+	case OrderStatus_TimeOut:
+		return "TimeOut"
+	case OrderStatus_Submitted:
+		return "Submitted"
+	case OrderStatus_Filled_Part:
+		return "Filled_Part"
+	case OrderStatus_Filled_All:
+		return "Filled_All"
+	case OrderStatus_Cancelling_Part:
+		return "Cancelling_Part"
+	case OrderStatus_Cancelling_All:
+		return "Cancelling_All"
+	case OrderStatus_Cancelled_Part:
+		return "Cancelled_Part"
+	case OrderStatus_Cancelled_All:
+		return "Cancelled_All"
+	case OrderStatus_Failed:
+		return "Failed"
+	case OrderStatus_Disabled:
+		return "Disabled"
+	case OrderStatus_Deleted:
+		return "Deleted"
+	case OrderStatus_FillCancelled:
+		return "FillCancelled"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_OrderStatus = map[string]OrderStatus{
+	"cancelledall":              OrderStatus_Cancelled_All,
+	"cancelledpart":             OrderStatus_Cancelled_Part,
+	"cancellingall":             OrderStatus_Cancelling_All,
+	"cancellingpart":            OrderStatus_Cancelling_Part,
+	"deleted":                   OrderStatus_Deleted,
+	"disabled":                  OrderStatus_Disabled,
+	"failed":                    OrderStatus_Failed,
+	"fillcancelled":             OrderStatus_FillCancelled,
+	"filledall":                 OrderStatus_Filled_All,
+	"filledpart":                OrderStatus_Filled_Part,
+	"orderstatuscancelledall":   OrderStatus_Cancelled_All,
+	"orderstatuscancelledpart":  OrderStatus_Cancelled_Part,
+	"orderstatuscancellingall":  OrderStatus_Cancelling_All,
+	"orderstatuscancellingpart": OrderStatus_Cancelling_Part,
+	"orderstatusdeleted":        OrderStatus_Deleted,
+	"orderstatusdisabled":       OrderStatus_Disabled,
+	"orderstatusfailed":         OrderStatus_Failed,
+	"orderstatusfillcancelled":  OrderStatus_FillCancelled,
+	"orderstatusfilledall":      OrderStatus_Filled_All,
+	"orderstatusfilledpart":     OrderStatus_Filled_Part,
+	"orderstatussubmitfailed":   OrderStatus_SubmitFailed,
+	"orderstatussubmitted":      OrderStatus_Submitted,
+	"orderstatussubmitting":     OrderStatus_Submitting,
+	"orderstatustimeout":        OrderStatus_TimeOut,
+	"orderstatusunknown":        OrderStatus_Unknown,
+	"orderstatusunsubmitted":    OrderStatus_Unsubmitted,
+	"orderstatuswaitingsubmit":  OrderStatus_WaitingSubmit,
+	"submitfailed":              OrderStatus_SubmitFailed,
+	"submitted":                 OrderStatus_Submitted,
+	"submitting":                OrderStatus_Submitting,
+	"timeout":                   OrderStatus_TimeOut,
+	"unknown":                   OrderStatus_Unknown,
+	"unsubmitted":               OrderStatus_Unsubmitted,
+	"waitingsubmit":             OrderStatus_WaitingSubmit,
+}
+
+// ParseOrderStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	if v, ok := parseNames_OrderStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := OrderStatus_name[int32(n)]; ok {
+			return OrderStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: OrderStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x OrderStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *OrderStatus) UnmarshalText(b []byte) error {
+	v, err := ParseOrderStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *OrderStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the OrderType_ prefix.
+func (x OrderType) Name() string {
+	switch x {
+	case OrderType_Unknown:
+		return "Unknown"
+	case OrderType_Normal:
+		return "Normal"
+	case OrderType_Market:
+		return "Market"
+	case OrderType_AbsoluteLimit:
+		return "AbsoluteLimit"
+	case OrderType_Auction:
+		return "Auction"
+	case OrderType_AuctionLimit:
+		return "AuctionLimit"
+	case OrderType_SpecialLimit:
+		return "SpecialLimit"
+	case OrderType_SpecialLimit_All:
+		return "SpecialLimit_All"
+	case OrderType_Stop:
+		return "Stop"
+	case OrderType_StopLimit:
+		return "StopLimit"
+	case OrderType_MarketifTouched:
+		return "MarketifTouched"
+	case OrderType_LimitifTouched:
+		return "LimitifTouched"
+	case OrderType_TrailingStop:
+		return "TrailingStop"
+	case OrderType_TrailingStopLimit:
+		return "TrailingStopLimit"
+	case OrderType_TWAP_MARKET:
+		return "TWAP_MARKET"
+	case OrderType_TWAP_LIMIT:
+		return "TWAP_LIMIT"
+	case OrderType_VWAP_MARKET:
+		return "VWAP_MARKET"
+	case OrderType_VWAP_LIMIT:
+		return "VWAP_LIMIT"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_OrderType = map[string]OrderType{
+	"absolutelimit":              OrderType_AbsoluteLimit,
+	"auction":                    OrderType_Auction,
+	"auctionlimit":               OrderType_AuctionLimit,
+	"limitiftouched":             OrderType_LimitifTouched,
+	"market":                     OrderType_Market,
+	"marketiftouched":            OrderType_MarketifTouched,
+	"normal":                     OrderType_Normal,
+	"orderabsolutelimit":         OrderType_AbsoluteLimit,
+	"orderauction":               OrderType_Auction,
+	"orderauctionlimit":          OrderType_AuctionLimit,
+	"orderlimitiftouched":        OrderType_LimitifTouched,
+	"ordermarket":                OrderType_Market,
+	"ordermarketiftouched":       OrderType_MarketifTouched,
+	"ordernormal":                OrderType_Normal,
+	"orderspeciallimit":          OrderType_SpecialLimit,
+	"orderspeciallimitall":       OrderType_SpecialLimit_All,
+	"orderstop":                  OrderType_Stop,
+	"orderstoplimit":             OrderType_StopLimit,
+	"ordertrailingstop":          OrderType_TrailingStop,
+	"ordertrailingstoplimit":     OrderType_TrailingStopLimit,
+	"ordertwaplimit":             OrderType_TWAP_LIMIT,
+	"ordertwapmarket":            OrderType_TWAP_MARKET,
+	"ordertypeabsolutelimit":     OrderType_AbsoluteLimit,
+	"ordertypeauction":           OrderType_Auction,
+	"ordertypeauctionlimit":      OrderType_AuctionLimit,
+	"ordertypelimitiftouched":    OrderType_LimitifTouched,
+	"ordertypemarket":            OrderType_Market,
+	"ordertypemarketiftouched":   OrderType_MarketifTouched,
+	"ordertypenormal":            OrderType_Normal,
+	"ordertypespeciallimit":      OrderType_SpecialLimit,
+	"ordertypespeciallimitall":   OrderType_SpecialLimit_All,
+	"ordertypestop":              OrderType_Stop,
+	"ordertypestoplimit":         OrderType_StopLimit,
+	"ordertypetrailingstop":      OrderType_TrailingStop,
+	"ordertypetrailingstoplimit": OrderType_TrailingStopLimit,
+	"ordertypetwaplimit":         OrderType_TWAP_LIMIT,
+	"ordertypetwapmarket":        OrderType_TWAP_MARKET,
+	"ordertypeunknown":           OrderType_Unknown,
+	"ordertypevwaplimit":         OrderType_VWAP_LIMIT,
+	"ordertypevwapmarket":        OrderType_VWAP_MARKET,
+	"orderunknown":               OrderType_Unknown,
+	"ordervwaplimit":             OrderType_VWAP_LIMIT,
+	"ordervwapmarket":            OrderType_VWAP_MARKET,
+	"speciallimit":               OrderType_SpecialLimit,
+	"speciallimitall":            OrderType_SpecialLimit_All,
+	"stop":                       OrderType_Stop,
+	"stoplimit":                  OrderType_StopLimit,
+	"trailingstop":               OrderType_TrailingStop,
+	"trailingstoplimit":          OrderType_TrailingStopLimit,
+	"twaplimit":                  OrderType_TWAP_LIMIT,
+	"twapmarket":                 OrderType_TWAP_MARKET,
+	"unknown":                    OrderType_Unknown,
+	"vwaplimit":                  OrderType_VWAP_LIMIT,
+	"vwapmarket":                 OrderType_VWAP_MARKET,
+}
+
+// ParseOrderType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseOrderType(s string) (OrderType, error) {
+	if v, ok := parseNames_OrderType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := OrderType_name[int32(n)]; ok {
+			return OrderType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: OrderType %q", ErrUnknownEnumName, s)
+}
+
+func (x OrderType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *OrderType) UnmarshalText(b []byte) error {
+	v, err := ParseOrderType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *OrderType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PacketEncAlgo_ prefix.
+func (x PacketEncAlgo) Name() string {
+	switch x {
+	case PacketEncAlgo_FTAES_ECB:
+		return "FTAES_ECB"
+	case PacketEncAlgo_None:
+		return "None"
+	case PacketEncAlgo_AES_ECB:
+		return "AES_ECB"
+	case PacketEncAlgo_AES_CBC:
+		return "AES_CBC"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PacketEncAlgo = map[string]PacketEncAlgo{
+	"aescbc":                PacketEncAlgo_AES_CBC,
+	"aesecb":                PacketEncAlgo_AES_ECB,
+	"ftaesecb":              PacketEncAlgo_FTAES_ECB,
+	"none":                  PacketEncAlgo_None,
+	"packetencalgoaescbc":   PacketEncAlgo_AES_CBC,
+	"packetencalgoaesecb":   PacketEncAlgo_AES_ECB,
+	"packetencalgoftaesecb": PacketEncAlgo_FTAES_ECB,
+	"packetencalgonone":     PacketEncAlgo_None,
+}
+
+// ParsePacketEncAlgo parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePacketEncAlgo(s string) (PacketEncAlgo, error) {
+	if v, ok := parseNames_PacketEncAlgo[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PacketEncAlgo_name[int32(n)]; ok {
+			return PacketEncAlgo(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PacketEncAlgo %q", ErrUnknownEnumName, s)
+}
+
+func (x PacketEncAlgo) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PacketEncAlgo) UnmarshalText(b []byte) error {
+	v, err := ParsePacketEncAlgo(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PacketEncAlgo) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PatternField_ prefix.
+func (x PatternField) Name() string {
+	switch x {
+	case PatternField_Unknown:
+		return "Unknown"
+	case PatternField_MAAlignmentLong:
+		return "MAAlignmentLong"
+	case PatternField_MAAlignmentShort:
+		return "MAAlignmentShort"
+	case PatternField_EMAAlignmentLong:
+		return "EMAAlignmentLong"
+	case PatternField_EMAAlignmentShort:
+		return "EMAAlignmentShort"
+	case PatternField_RSIGoldCrossLow:
+		return "RSIGoldCrossLow"
+	case PatternField_RSIDeathCrossHigh:
+		return "RSIDeathCrossHigh"
+	case PatternField_RSITopDivergence:
+		return "RSITopDivergence"
+	case PatternField_RSIBottomDivergence:
+		return "RSIBottomDivergence"
+	case PatternField_KDJGoldCrossLow:
+		return "KDJGoldCrossLow"
+	case PatternField_KDJDeathCrossHigh:
+		return "KDJDeathCrossHigh"
+	case PatternField_KDJTopDivergence:
+		return "KDJTopDivergence"
+	case PatternField_KDJBottomDivergence:
+		return "KDJBottomDivergence"
+	case PatternField_MACDGoldCrossLow:
+		return "MACDGoldCrossLow"
+	case PatternField_MACDDeathCrossHigh:
+		return "MACDDeathCrossHigh"
+	case PatternField_MACDTopDivergence:
+		return "MACDTopDivergence"
+	case PatternField_MACDBottomDivergence:
+		return "MACDBottomDivergence"
+	case PatternField_BOLLBreakUpper:
+		return "BOLLBreakUpper"
+	case PatternField_BOLLLower:
+		return "BOLLLower"
+	case PatternField_BOLLCrossMiddleUp:
+		return "BOLLCrossMiddleUp"
+	case PatternField_BOLLCrossMiddleDown:
+		return "BOLLCrossMiddleDown"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PatternField = map[string]PatternField{
+	"bollbreakupper":                   PatternField_BOLLBreakUpper,
+	"bollcrossmiddledown":              PatternField_BOLLCrossMiddleDown,
+	"bollcrossmiddleup":                PatternField_BOLLCrossMiddleUp,
+	"bolllower":                        PatternField_BOLLLower,
+	"emaalignmentlong":                 PatternField_EMAAlignmentLong,
+	"emaalignmentshort":                PatternField_EMAAlignmentShort,
+	"kdjbottomdivergence":              PatternField_KDJBottomDivergence,
+	"kdjdeathcrosshigh":                PatternField_KDJDeathCrossHigh,
+	"kdjgoldcrosslow":                  PatternField_KDJGoldCrossLow,
+	"kdjtopdivergence":                 PatternField_KDJTopDivergence,
+	"maalignmentlong":                  PatternField_MAAlignmentLong,
+	"maalignmentshort":                 PatternField_MAAlignmentShort,
+	"macdbottomdivergence":             PatternField_MACDBottomDivergence,
+	"macddeathcrosshigh":               PatternField_MACDDeathCrossHigh,
+	"macdgoldcrosslow":                 PatternField_MACDGoldCrossLow,
+	"macdtopdivergence":                PatternField_MACDTopDivergence,
+	"patternfieldbollbreakupper":       PatternField_BOLLBreakUpper,
+	"patternfieldbollcrossmiddledown":  PatternField_BOLLCrossMiddleDown,
+	"patternfieldbollcrossmiddleup":    PatternField_BOLLCrossMiddleUp,
+	"patternfieldbolllower":            PatternField_BOLLLower,
+	"patternfieldemaalignmentlong":     PatternField_EMAAlignmentLong,
+	"patternfieldemaalignmentshort":    PatternField_EMAAlignmentShort,
+	"patternfieldkdjbottomdivergence":  PatternField_KDJBottomDivergence,
+	"patternfieldkdjdeathcrosshigh":    PatternField_KDJDeathCrossHigh,
+	"patternfieldkdjgoldcrosslow":      PatternField_KDJGoldCrossLow,
+	"patternfieldkdjtopdivergence":     PatternField_KDJTopDivergence,
+	"patternfieldmaalignmentlong":      PatternField_MAAlignmentLong,
+	"patternfieldmaalignmentshort":     PatternField_MAAlignmentShort,
+	"patternfieldmacdbottomdivergence": PatternField_MACDBottomDivergence,
+	"patternfieldmacddeathcrosshigh":   PatternField_MACDDeathCrossHigh,
+	"patternfieldmacdgoldcrosslow":     PatternField_MACDGoldCrossLow,
+	"patternfieldmacdtopdivergence":    PatternField_MACDTopDivergence,
+	"patternfieldrsibottomdivergence":  PatternField_RSIBottomDivergence,
+	"patternfieldrsideathcrosshigh":    PatternField_RSIDeathCrossHigh,
+	"patternfieldrsigoldcrosslow":      PatternField_RSIGoldCrossLow,
+	"patternfieldrsitopdivergence":     PatternField_RSITopDivergence,
+	"patternfieldunknown":              PatternField_Unknown,
+	"rsibottomdivergence":              PatternField_RSIBottomDivergence,
+	"rsideathcrosshigh":                PatternField_RSIDeathCrossHigh,
+	"rsigoldcrosslow":                  PatternField_RSIGoldCrossLow,
+	"rsitopdivergence":                 PatternField_RSITopDivergence,
+	"unknown":                          PatternField_Unknown,
+}
+
+// ParsePatternField parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePatternField(s string) (PatternField, error) {
+	if v, ok := parseNames_PatternField[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PatternField_name[int32(n)]; ok {
+			return PatternField(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PatternField %q", ErrUnknownEnumName, s)
+}
+
+func (x PatternField) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PatternField) UnmarshalText(b []byte) error {
+	v, err := ParsePatternField(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PatternField) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PeriodType_ prefix.
+func (x PeriodType) Name() string {
+	switch x {
+	case PeriodType_Unknown:
+		return "Unknown"
+	case PeriodType_INTRADAY:
+		return "INTRADAY"
+	case PeriodType_DAY:
+		return "DAY"
+	case PeriodType_WEEK:
+		return "WEEK"
+	case PeriodType_MONTH:
+		return "MONTH"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PeriodType = map[string]PeriodType{
+	"day":                PeriodType_DAY,
+	"intraday":           PeriodType_INTRADAY,
+	"month":              PeriodType_MONTH,
+	"periodday":          PeriodType_DAY,
+	"periodintraday":     PeriodType_INTRADAY,
+	"periodmonth":        PeriodType_MONTH,
+	"periodtypeday":      PeriodType_DAY,
+	"periodtypeintraday": PeriodType_INTRADAY,
+	"periodtypemonth":    PeriodType_MONTH,
+	"periodtypeunknown":  PeriodType_Unknown,
+	"periodtypeweek":     PeriodType_WEEK,
+	"periodunknown":      PeriodType_Unknown,
+	"periodweek":         PeriodType_WEEK,
+	"unknown":            PeriodType_Unknown,
+	"week":               PeriodType_WEEK,
+}
+
+// ParsePeriodType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePeriodType(s string) (PeriodType, error) {
+	if v, ok := parseNames_PeriodType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PeriodType_name[int32(n)]; ok {
+			return PeriodType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PeriodType %q", ErrUnknownEnumName, s)
+}
+
+func (x PeriodType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PeriodType) UnmarshalText(b []byte) error {
+	v, err := ParsePeriodType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PeriodType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PlateSetType_ prefix.
+func (x PlateSetType) Name() string {
+	switch x {
+	case PlateSetType_All:
+		return "All"
+	case PlateSetType_Industry:
+		return "Industry"
+	case PlateSetType_Region:
+		return "Region"
+	case PlateSetType_Concept:
+		return "Concept"
+	case PlateSetType_Other:
+		return "Other"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PlateSetType = map[string]PlateSetType{
+	"all":                  PlateSetType_All,
+	"concept":              PlateSetType_Concept,
+	"industry":             PlateSetType_Industry,
+	"other":                PlateSetType_Other,
+	"platesetall":          PlateSetType_All,
+	"platesetconcept":      PlateSetType_Concept,
+	"platesetindustry":     PlateSetType_Industry,
+	"platesetother":        PlateSetType_Other,
+	"platesetregion":       PlateSetType_Region,
+	"platesettypeall":      PlateSetType_All,
+	"platesettypeconcept":  PlateSetType_Concept,
+	"platesettypeindustry": PlateSetType_Industry,
+	"platesettypeother":    PlateSetType_Other,
+	"platesettyperegion":   PlateSetType_Region,
+	"region":               PlateSetType_Region,
+}
+
+// ParsePlateSetType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePlateSetType(s string) (PlateSetType, error) {
+	if v, ok := parseNames_PlateSetType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PlateSetType_name[int32(n)]; ok {
+			return PlateSetType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PlateSetType %q", ErrUnknownEnumName, s)
+}
+
+func (x PlateSetType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PlateSetType) UnmarshalText(b []byte) error {
+	v, err := ParsePlateSetType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PlateSetType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PositionSide_ prefix.
+func (x PositionSide) Name() string {
+	switch x {
+	case PositionSide_Long:
+		return "Long"
+	case PositionSide_Unknown:
+		return "Unknown"
+	case PositionSide_Short:
+		return "Short"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PositionSide = map[string]PositionSide{
+	"long":                PositionSide_Long,
+	"positionsidelong":    PositionSide_Long,
+	"positionsideshort":   PositionSide_Short,
+	"positionsideunknown": PositionSide_Unknown,
+	"short":               PositionSide_Short,
+	"unknown":             PositionSide_Unknown,
+}
+
+// ParsePositionSide parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePositionSide(s string) (PositionSide, error) {
+	if v, ok := parseNames_PositionSide[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PositionSide_name[int32(n)]; ok {
+			return PositionSide(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PositionSide %q", ErrUnknownEnumName, s)
+}
+
+func (x PositionSide) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PositionSide) UnmarshalText(b []byte) error {
+	v, err := ParsePositionSide(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PositionSide) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PriceReminderFreq_ prefix.
+func (x PriceReminderFreq) Name() string {
+	switch x {
+	case PriceReminderFreq_Unknown:
+		return "Unknown"
+	case PriceReminderFreq_Always:
+		return "Always"
+	case PriceReminderFreq_OnceADay:
+		return "OnceADay"
+	case PriceReminderFreq_OnlyOnce:
+		return "OnlyOnce"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PriceReminderFreq = map[string]PriceReminderFreq{
+	"always":                    PriceReminderFreq_Always,
+	"onceaday":                  PriceReminderFreq_OnceADay,
+	"onlyonce":                  PriceReminderFreq_OnlyOnce,
+	"pricereminderfreqalways":   PriceReminderFreq_Always,
+	"pricereminderfreqonceaday": PriceReminderFreq_OnceADay,
+	"pricereminderfreqonlyonce": PriceReminderFreq_OnlyOnce,
+	"pricereminderfrequnknown":  PriceReminderFreq_Unknown,
+	"unknown":                   PriceReminderFreq_Unknown,
+}
+
+// ParsePriceReminderFreq parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePriceReminderFreq(s string) (PriceReminderFreq, error) {
+	if v, ok := parseNames_PriceReminderFreq[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PriceReminderFreq_name[int32(n)]; ok {
+			return PriceReminderFreq(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PriceReminderFreq %q", ErrUnknownEnumName, s)
+}
+
+func (x PriceReminderFreq) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PriceReminderFreq) UnmarshalText(b []byte) error {
+	v, err := ParsePriceReminderFreq(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PriceReminderFreq) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PriceReminderMarketStatus_ prefix.
+func (x PriceReminderMarketStatus) Name() string {
+	switch x {
+	case PriceReminderMarketStatus_Unknown:
+		return "Unknown"
+	case PriceReminderMarketStatus_Open:
+		return "Open"
+	case PriceReminderMarketStatus_USPre:
+		return "USPre"
+	case PriceReminderMarketStatus_USAfter:
+		return "USAfter"
+	case PriceReminderMarketStatus_USOverNight:
+		return "USOverNight"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PriceReminderMarketStatus = map[string]PriceReminderMarketStatus{
+	"open":                                 PriceReminderMarketStatus_Open,
+	"priceremindermarketstatusopen":        PriceReminderMarketStatus_Open,
+	"priceremindermarketstatusunknown":     PriceReminderMarketStatus_Unknown,
+	"priceremindermarketstatususafter":     PriceReminderMarketStatus_USAfter,
+	"priceremindermarketstatususovernight": PriceReminderMarketStatus_USOverNight,
+	"priceremindermarketstatususpre":       PriceReminderMarketStatus_USPre,
+	"unknown":                              PriceReminderMarketStatus_Unknown,
+	"usafter":                              PriceReminderMarketStatus_USAfter,
+	"usovernight":                          PriceReminderMarketStatus_USOverNight,
+	"uspre":                                PriceReminderMarketStatus_USPre,
+}
+
+// ParsePriceReminderMarketStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePriceReminderMarketStatus(s string) (PriceReminderMarketStatus, error) {
+	if v, ok := parseNames_PriceReminderMarketStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PriceReminderMarketStatus_name[int32(n)]; ok {
+			return PriceReminderMarketStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PriceReminderMarketStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x PriceReminderMarketStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PriceReminderMarketStatus) UnmarshalText(b []byte) error {
+	v, err := ParsePriceReminderMarketStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PriceReminderMarketStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PriceReminderType_ prefix.
+func (x PriceReminderType) Name() string {
+	switch x {
+	case PriceReminderType_Unknown:
+		return "Unknown"
+	case PriceReminderType_PriceUp:
+		return "PriceUp"
+	case PriceReminderType_PriceDown:
+		return "PriceDown"
+	case PriceReminderType_ChangeRateUp:
+		return "ChangeRateUp"
+	case PriceReminderType_ChangeRateDown:
+		return "ChangeRateDown"
+	case PriceReminderType_5MinChangeRateUp:
+		return "5MinChangeRateUp"
+	case PriceReminderType_5MinChangeRateDown:
+		return "5MinChangeRateDown"
+	case PriceReminderType_VolumeUp:
+		return "VolumeUp"
+	case PriceReminderType_TurnoverUp:
+		return "TurnoverUp"
+	case PriceReminderType_TurnoverRateUp:
+		return "TurnoverRateUp"
+	case PriceReminderType_BidPriceUp:
+		return "BidPriceUp"
+	case PriceReminderType_AskPriceDown:
+		return "AskPriceDown"
+	case PriceReminderType_BidVolUp:
+		return "BidVolUp"
+	case PriceReminderType_AskVolUp:
+		return "AskVolUp"
+	case PriceReminderType_3MinChangeRateUp:
+		return "3MinChangeRateUp"
+	case PriceReminderType_3MinChangeRateDown:
+		return "3MinChangeRateDown"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PriceReminderType = map[string]PriceReminderType{
+	"3minchangeratedown":                  PriceReminderType_3MinChangeRateDown,
+	"3minchangerateup":                    PriceReminderType_3MinChangeRateUp,
+	"5minchangeratedown":                  PriceReminderType_5MinChangeRateDown,
+	"5minchangerateup":                    PriceReminderType_5MinChangeRateUp,
+	"askpricedown":                        PriceReminderType_AskPriceDown,
+	"askvolup":                            PriceReminderType_AskVolUp,
+	"bidpriceup":                          PriceReminderType_BidPriceUp,
+	"bidvolup":                            PriceReminderType_BidVolUp,
+	"changeratedown":                      PriceReminderType_ChangeRateDown,
+	"changerateup":                        PriceReminderType_ChangeRateUp,
+	"pricedown":                           PriceReminderType_PriceDown,
+	"pricereminder3minchangeratedown":     PriceReminderType_3MinChangeRateDown,
+	"pricereminder3minchangerateup":       PriceReminderType_3MinChangeRateUp,
+	"pricereminder5minchangeratedown":     PriceReminderType_5MinChangeRateDown,
+	"pricereminder5minchangerateup":       PriceReminderType_5MinChangeRateUp,
+	"pricereminderaskpricedown":           PriceReminderType_AskPriceDown,
+	"pricereminderaskvolup":               PriceReminderType_AskVolUp,
+	"pricereminderbidpriceup":             PriceReminderType_BidPriceUp,
+	"pricereminderbidvolup":               PriceReminderType_BidVolUp,
+	"pricereminderchangeratedown":         PriceReminderType_ChangeRateDown,
+	"pricereminderchangerateup":           PriceReminderType_ChangeRateUp,
+	"pricereminderpricedown":              PriceReminderType_PriceDown,
+	"pricereminderpriceup":                PriceReminderType_PriceUp,
+	"pricereminderturnoverrateup":         PriceReminderType_TurnoverRateUp,
+	"pricereminderturnoverup":             PriceReminderType_TurnoverUp,
+	"priceremindertype3minchangeratedown": PriceReminderType_3MinChangeRateDown,
+	"priceremindertype3minchangerateup":   PriceReminderType_3MinChangeRateUp,
+	"priceremindertype5minchangeratedown": PriceReminderType_5MinChangeRateDown,
+	"priceremindertype5minchangerateup":   PriceReminderType_5MinChangeRateUp,
+	"priceremindertypeaskpricedown":       PriceReminderType_AskPriceDown,
+	"priceremindertypeaskvolup":           PriceReminderType_AskVolUp,
+	"priceremindertypebidpriceup":         PriceReminderType_BidPriceUp,
+	"priceremindertypebidvolup":           PriceReminderType_BidVolUp,
+	"priceremindertypechangeratedown":     PriceReminderType_ChangeRateDown,
+	"priceremindertypechangerateup":       PriceReminderType_ChangeRateUp,
+	"priceremindertypepricedown":          PriceReminderType_PriceDown,
+	"priceremindertypepriceup":            PriceReminderType_PriceUp,
+	"priceremindertypeturnoverrateup":     PriceReminderType_TurnoverRateUp,
+	"priceremindertypeturnoverup":         PriceReminderType_TurnoverUp,
+	"priceremindertypeunknown":            PriceReminderType_Unknown,
+	"priceremindertypevolumeup":           PriceReminderType_VolumeUp,
+	"pricereminderunknown":                PriceReminderType_Unknown,
+	"priceremindervolumeup":               PriceReminderType_VolumeUp,
+	"priceup":                             PriceReminderType_PriceUp,
+	"turnoverrateup":                      PriceReminderType_TurnoverRateUp,
+	"turnoverup":                          PriceReminderType_TurnoverUp,
+	"unknown":                             PriceReminderType_Unknown,
+	"volumeup":                            PriceReminderType_VolumeUp,
+}
+
+// ParsePriceReminderType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePriceReminderType(s string) (PriceReminderType, error) {
+	if v, ok := parseNames_PriceReminderType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PriceReminderType_name[int32(n)]; ok {
+			return PriceReminderType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PriceReminderType %q", ErrUnknownEnumName, s)
+}
+
+func (x PriceReminderType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PriceReminderType) UnmarshalText(b []byte) error {
+	v, err := ParsePriceReminderType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PriceReminderType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PriceType_ prefix.
+func (x PriceType) Name() string {
+	switch x {
+	case PriceType_Unknown:
+		return "Unknown"
+	case PriceType_Outside:
+		return "Outside"
+	case PriceType_WithIn:
+		return "WithIn"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PriceType = map[string]PriceType{
+	"outside":          PriceType_Outside,
+	"priceoutside":     PriceType_Outside,
+	"pricetypeoutside": PriceType_Outside,
+	"pricetypeunknown": PriceType_Unknown,
+	"pricetypewithin":  PriceType_WithIn,
+	"priceunknown":     PriceType_Unknown,
+	"pricewithin":      PriceType_WithIn,
+	"unknown":          PriceType_Unknown,
+	"within":           PriceType_WithIn,
+}
+
+// ParsePriceType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePriceType(s string) (PriceType, error) {
+	if v, ok := parseNames_PriceType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PriceType_name[int32(n)]; ok {
+			return PriceType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PriceType %q", ErrUnknownEnumName, s)
+}
+
+func (x PriceType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PriceType) UnmarshalText(b []byte) error {
+	v, err := ParsePriceType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PriceType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the ProgramStatusType_ prefix.
+func (x ProgramStatusType) Name() string {
+	switch x {
+	case ProgramStatusType_None:
+		return "None"
+	case ProgramStatusType_Loaded:
+		return "Loaded"
+	case ProgramStatusType_Loging:
+		return "Loging"
+	case ProgramStatusType_NeedPicVerifyCode:
+		return "NeedPicVerifyCode"
+	case ProgramStatusType_NeedPhoneVerifyCode:
+		return "NeedPhoneVerifyCode"
+	case ProgramStatusType_LoginFailed:
+		return "LoginFailed"
+	case ProgramStatusType_ForceUpdate:
+		return "ForceUpdate"
+	case ProgramStatusType_NessaryDataPreparing:
+		return "NessaryDataPreparing"
+	case ProgramStatusType_NessaryDataMissing:
+		return "NessaryDataMissing"
+	case ProgramStatusType_UnAgreeDisclaimer:
+		return "UnAgreeDisclaimer"
+	case ProgramStatusType_Ready:
+		return "Ready"
+	case ProgramStatusType_ForceLogout:
+		return "ForceLogout"
+	case ProgramStatusType_DisclaimerPullFailed:
+		return "DisclaimerPullFailed"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_ProgramStatusType = map[string]ProgramStatusType{
+	"disclaimerpullfailed":                  ProgramStatusType_DisclaimerPullFailed,
+	"forcelogout":                           ProgramStatusType_ForceLogout,
+	"forceupdate":                           ProgramStatusType_ForceUpdate,
+	"loaded":                                ProgramStatusType_Loaded,
+	"loginfailed":                           ProgramStatusType_LoginFailed,
+	"loging":                                ProgramStatusType_Loging,
+	"needphoneverifycode":                   ProgramStatusType_NeedPhoneVerifyCode,
+	"needpicverifycode":                     ProgramStatusType_NeedPicVerifyCode,
+	"nessarydatamissing":                    ProgramStatusType_NessaryDataMissing,
+	"nessarydatapreparing":                  ProgramStatusType_NessaryDataPreparing,
+	"none":                                  ProgramStatusType_None,
+	"programstatusdisclaimerpullfailed":     ProgramStatusType_DisclaimerPullFailed,
+	"programstatusforcelogout":              ProgramStatusType_ForceLogout,
+	"programstatusforceupdate":              ProgramStatusType_ForceUpdate,
+	"programstatusloaded":                   ProgramStatusType_Loaded,
+	"programstatusloginfailed":              ProgramStatusType_LoginFailed,
+	"programstatusloging":                   ProgramStatusType_Loging,
+	"programstatusneedphoneverifycode":      ProgramStatusType_NeedPhoneVerifyCode,
+	"programstatusneedpicverifycode":        ProgramStatusType_NeedPicVerifyCode,
+	"programstatusnessarydatamissing":       ProgramStatusType_NessaryDataMissing,
+	"programstatusnessarydatapreparing":     ProgramStatusType_NessaryDataPreparing,
+	"programstatusnone":                     ProgramStatusType_None,
+	"programstatusready":                    ProgramStatusType_Ready,
+	"programstatustypedisclaimerpullfailed": ProgramStatusType_DisclaimerPullFailed,
+	"programstatustypeforcelogout":          ProgramStatusType_ForceLogout,
+	"programstatustypeforceupdate":          ProgramStatusType_ForceUpdate,
+	"programstatustypeloaded":               ProgramStatusType_Loaded,
+	"programstatustypeloginfailed":          ProgramStatusType_LoginFailed,
+	"programstatustypeloging":               ProgramStatusType_Loging,
+	"programstatustypeneedphoneverifycode":  ProgramStatusType_NeedPhoneVerifyCode,
+	"programstatustypeneedpicverifycode":    ProgramStatusType_NeedPicVerifyCode,
+	"programstatustypenessarydatamissing":   ProgramStatusType_NessaryDataMissing,
+	"programstatustypenessarydatapreparing": ProgramStatusType_NessaryDataPreparing,
+	"programstatustypenone":                 ProgramStatusType_None,
+	"programstatustypeready":                ProgramStatusType_Ready,
+	"programstatustypeunagreedisclaimer":    ProgramStatusType_UnAgreeDisclaimer,
+	"programstatusunagreedisclaimer":        ProgramStatusType_UnAgreeDisclaimer,
+	"ready":                                 ProgramStatusType_Ready,
+	"unagreedisclaimer":                     ProgramStatusType_UnAgreeDisclaimer,
+}
+
+// ParseProgramStatusType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseProgramStatusType(s string) (ProgramStatusType, error) {
+	if v, ok := parseNames_ProgramStatusType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := ProgramStatusType_name[int32(n)]; ok {
+			return ProgramStatusType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: ProgramStatusType %q", ErrUnknownEnumName, s)
+}
+
+func (x ProgramStatusType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *ProgramStatusType) UnmarshalText(b []byte) error {
+	v, err := ParseProgramStatusType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *ProgramStatusType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the ProtoFmt_ prefix.
+func (x ProtoFmt) Name() string {
+	switch x {
+	case ProtoFmt_Protobuf:
+		return "Protobuf"
+	case ProtoFmt_Json:
+		return "Json"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_ProtoFmt = map[string]ProtoFmt{
+	"json":             ProtoFmt_Json,
+	"protobuf":         ProtoFmt_Protobuf,
+	"protofmtjson":     ProtoFmt_Json,
+	"protofmtprotobuf": ProtoFmt_Protobuf,
+}
+
+// ParseProtoFmt parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseProtoFmt(s string) (ProtoFmt, error) {
+	if v, ok := parseNames_ProtoFmt[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := ProtoFmt_name[int32(n)]; ok {
+			return ProtoFmt(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: ProtoFmt %q", ErrUnknownEnumName, s)
+}
+
+func (x ProtoFmt) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *ProtoFmt) UnmarshalText(b []byte) error {
+	v, err := ParseProtoFmt(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *ProtoFmt) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the PushDataType_ prefix.
+func (x PushDataType) Name() string {
+	switch x {
+	case PushDataType_Unknown:
+		return "Unknown"
+	case PushDataType_Realtime:
+		return "Realtime"
+	case PushDataType_ByDisConn:
+		return "ByDisConn"
+	case PushDataType_Cache:
+		return "Cache"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_PushDataType = map[string]PushDataType{
+	"bydisconn":             PushDataType_ByDisConn,
+	"cache":                 PushDataType_Cache,
+	"pushdatabydisconn":     PushDataType_ByDisConn,
+	"pushdatacache":         PushDataType_Cache,
+	"pushdatarealtime":      PushDataType_Realtime,
+	"pushdatatypebydisconn": PushDataType_ByDisConn,
+	"pushdatatypecache":     PushDataType_Cache,
+	"pushdatatyperealtime":  PushDataType_Realtime,
+	"pushdatatypeunknown":   PushDataType_Unknown,
+	"pushdataunknown":       PushDataType_Unknown,
+	"realtime":              PushDataType_Realtime,
+	"unknown":               PushDataType_Unknown,
+}
+
+// ParsePushDataType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParsePushDataType(s string) (PushDataType, error) {
+	if v, ok := parseNames_PushDataType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := PushDataType_name[int32(n)]; ok {
+			return PushDataType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: PushDataType %q", ErrUnknownEnumName, s)
+}
+
+func (x PushDataType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *PushDataType) UnmarshalText(b []byte) error {
+	v, err := ParsePushDataType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *PushDataType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the QotMarket_ prefix.
+func (x QotMarket) Name() string {
+	switch x {
+	case QotMarket_Unknown:
+		return "Unknown"
+	case QotMarket_HK_Security:
+		return "HK"
+	case QotMarket_HK_Future:
+		return "HK_Future"
+	case QotMarket_US_Security:
+		return "US"
+	case QotMarket_CNSH_Security:
+		return "CNSH"
+	case QotMarket_CNSZ_Security:
+		return "CNSZ"
+	case QotMarket_SG_Security:
+		return "SG"
+	case QotMarket_JP_Security:
+		return "JP"
+	case QotMarket_AU_Security:
+		return "AU"
+	case QotMarket_MY_Security:
+		return "MY"
+	case QotMarket_CA_Security:
+		return "CA"
+	case QotMarket_FX_Security:
+		return "FX"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_QotMarket = map[string]QotMarket{
+	"au":                    QotMarket_AU_Security,
+	"ausecurity":            QotMarket_AU_Security,
+	"ca":                    QotMarket_CA_Security,
+	"casecurity":            QotMarket_CA_Security,
+	"cnsh":                  QotMarket_CNSH_Security,
+	"cnshsecurity":          QotMarket_CNSH_Security,
+	"cnsz":                  QotMarket_CNSZ_Security,
+	"cnszsecurity":          QotMarket_CNSZ_Security,
+	"fx":                    QotMarket_FX_Security,
+	"fxsecurity":            QotMarket_FX_Security,
+	"hk":                    QotMarket_HK_Security,
+	"hkfuture":              QotMarket_HK_Future,
+	"hksecurity":            QotMarket_HK_Security,
+	"jp":                    QotMarket_JP_Security,
+	"jpsecurity":            QotMarket_JP_Security,
+	"my":                    QotMarket_MY_Security,
+	"mysecurity":            QotMarket_MY_Security,
+	"qotmarketausecurity":   QotMarket_AU_Security,
+	"qotmarketcasecurity":   QotMarket_CA_Security,
+	"qotmarketcnshsecurity": QotMarket_CNSH_Security,
+	"qotmarketcnszsecurity": QotMarket_CNSZ_Security,
+	"qotmarketfxsecurity":   QotMarket_FX_Security,
+	"qotmarkethkfuture":     QotMarket_HK_Future,
+	"qotmarkethksecurity":   QotMarket_HK_Security,
+	"qotmarketjpsecurity":   QotMarket_JP_Security,
+	"qotmarketmysecurity":   QotMarket_MY_Security,
+	"qotmarketsgsecurity":   QotMarket_SG_Security,
+	"qotmarketunknown":      QotMarket_Unknown,
+	"qotmarketussecurity":   QotMarket_US_Security,
+	"sg":                    QotMarket_SG_Security,
+	"sgsecurity":            QotMarket_SG_Security,
+	"unknown":               QotMarket_Unknown,
+	"us":                    QotMarket_US_Security,
+	"ussecurity":            QotMarket_US_Security,
+}
+
+// ParseQotMarket parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseQotMarket(s string) (QotMarket, error) {
+	if v, ok := parseNames_QotMarket[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := QotMarket_name[int32(n)]; ok {
+			return QotMarket(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: QotMarket %q", ErrUnknownEnumName, s)
+}
+
+func (x QotMarket) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *QotMarket) UnmarshalText(b []byte) error {
+	v, err := ParseQotMarket(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *QotMarket) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the QotMarketState_ prefix.
+func (x QotMarketState) Name() string {
+	switch x {
+	case QotMarketState_None:
+		return "None"
+	case QotMarketState_Auction:
+		return "Auction"
+	case QotMarketState_WaitingOpen:
+		return "WaitingOpen"
+	case QotMarketState_Morning:
+		return "Morning"
+	case QotMarketState_Rest:
+		return "Rest"
+	case QotMarketState_Afternoon:
+		return "Afternoon"
+	case QotMarketState_Closed:
+		return "Closed"
+	case QotMarketState_PreMarketBegin:
+		return "PreMarketBegin"
+	case QotMarketState_PreMarketEnd:
+		return "PreMarketEnd"
+	case QotMarketState_AfterHoursBegin:
+		return "AfterHoursBegin"
+	case QotMarketState_AfterHoursEnd:
+		return "AfterHoursEnd"
+	case QotMarketState_FUTU_SWITCH_DATE:
+		return "FUTU_SWITCH_DATE"
+	case QotMarketState_NightOpen:
+		return "NightOpen"
+	case QotMarketState_NightEnd:
+		return "NightEnd"
+	case QotMarketState_FutureDayOpen:
+		return "FutureDayOpen"
+	case QotMarketState_FutureDayBreak:
+		return "FutureDayBreak"
+	case QotMarketState_FutureDayClose:
+		return "FutureDayClose"
+	case QotMarketState_FutureDayWaitForOpen:
+		return "FutureDayWaitForOpen"
+	case QotMarketState_HkCas:
+		return "HkCas"
+	case QotMarketState_FutureNightWait:
+		return "FutureNightWait"
+	case QotMarketState_FutureAfternoon:
+		return "FutureAfternoon"
+	case QotMarketState_FutureSwitchDate:
+		return "FutureSwitchDate"
+	case QotMarketState_FutureOpen:
+		return "FutureOpen"
+	case QotMarketState_FutureBreak:
+		return "FutureBreak"
+	case QotMarketState_FutureBreakOver:
+		return "FutureBreakOver"
+	case QotMarketState_FutureClose:
+		return "FutureClose"
+	case QotMarketState_StibAfterHoursWait:
+		return "StibAfterHoursWait"
+	case QotMarketState_StibAfterHoursBegin:
+		return "StibAfterHoursBegin"
+	case QotMarketState_StibAfterHoursEnd:
+		return "StibAfterHoursEnd"
+	case QotMarketState_CLOSE_AUCTION:
+		return "CLOSE_AUCTION"
+	case QotMarketState_AFTERNOON_END:
+		return "AFTERNOON_END"
+	case QotMarketState_NIGHT:
+		return "NIGHT"
+	case QotMarketState_OVERNIGHT_BEGIN:
+		return "OVERNIGHT_BEGIN"
+	case QotMarketState_OVERNIGHT_END:
+		return "OVERNIGHT_END"
+	case QotMarketState_TRADE_AT_LAST:
+		return "TRADE_AT_LAST"
+	case QotMarketState_TRADE_AUCTION:
+		return "TRADE_AUCTION"
+	case QotMarketState_OVERNIGHT:
+		return "OVERNIGHT"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_QotMarketState = map[string]QotMarketState{
+	"afterhoursbegin":                    QotMarketState_AfterHoursBegin,
+	"afterhoursend":                      QotMarketState_AfterHoursEnd,
+	"afternoon":                          QotMarketState_Afternoon,
+	"afternoonend":                       QotMarketState_AFTERNOON_END,
+	"auction":                            QotMarketState_Auction,
+	"closeauction":                       QotMarketState_CLOSE_AUCTION,
+	"closed":                             QotMarketState_Closed,
+	"futureafternoon":                    QotMarketState_FutureAfternoon,
+	"futurebreak":                        QotMarketState_FutureBreak,
+	"futurebreakover":                    QotMarketState_FutureBreakOver,
+	"futureclose":                        QotMarketState_FutureClose,
+	"futuredaybreak":                     QotMarketState_FutureDayBreak,
+	"futuredayclose":                     QotMarketState_FutureDayClose,
+	"futuredayopen":                      QotMarketState_FutureDayOpen,
+	"futuredaywaitforopen":               QotMarketState_FutureDayWaitForOpen,
+	"futurenightwait":                    QotMarketState_FutureNightWait,
+	"futureopen":                         QotMarketState_FutureOpen,
+	"futureswitchdate":                   QotMarketState_FutureSwitchDate,
+	"futuswitchdate":                     QotMarketState_FUTU_SWITCH_DATE,
+	"hkcas":                              QotMarketState_HkCas,
+	"morning":                            QotMarketState_Morning,
+	"night":                              QotMarketState_NIGHT,
+	"nightend":                           QotMarketState_NightEnd,
+	"nightopen":                          QotMarketState_NightOpen,
+	"none":                               QotMarketState_None,
+	"overnight":                          QotMarketState_OVERNIGHT,
+	"overnightbegin":                     QotMarketState_OVERNIGHT_BEGIN,
+	"overnightend":                       QotMarketState_OVERNIGHT_END,
+	"premarketbegin":                     QotMarketState_PreMarketBegin,
+	"premarketend":                       QotMarketState_PreMarketEnd,
+	"qotmarketstateafterhoursbegin":      QotMarketState_AfterHoursBegin,
+	"qotmarketstateafterhoursend":        QotMarketState_AfterHoursEnd,
+	"qotmarketstateafternoon":            QotMarketState_Afternoon,
+	"qotmarketstateafternoonend":         QotMarketState_AFTERNOON_END,
+	"qotmarketstateauction":              QotMarketState_Auction,
+	"qotmarketstatecloseauction":         QotMarketState_CLOSE_AUCTION,
+	"qotmarketstateclosed":               QotMarketState_Closed,
+	"qotmarketstatefutureafternoon":      QotMarketState_FutureAfternoon,
+	"qotmarketstatefuturebreak":          QotMarketState_FutureBreak,
+	"qotmarketstatefuturebreakover":      QotMarketState_FutureBreakOver,
+	"qotmarketstatefutureclose":          QotMarketState_FutureClose,
+	"qotmarketstatefuturedaybreak":       QotMarketState_FutureDayBreak,
+	"qotmarketstatefuturedayclose":       QotMarketState_FutureDayClose,
+	"qotmarketstatefuturedayopen":        QotMarketState_FutureDayOpen,
+	"qotmarketstatefuturedaywaitforopen": QotMarketState_FutureDayWaitForOpen,
+	"qotmarketstatefuturenightwait":      QotMarketState_FutureNightWait,
+	"qotmarketstatefutureopen":           QotMarketState_FutureOpen,
+	"qotmarketstatefutureswitchdate":     QotMarketState_FutureSwitchDate,
+	"qotmarketstatefutuswitchdate":       QotMarketState_FUTU_SWITCH_DATE,
+	"qotmarketstatehkcas":                QotMarketState_HkCas,
+	"qotmarketstatemorning":              QotMarketState_Morning,
+	"qotmarketstatenight":                QotMarketState_NIGHT,
+	"qotmarketstatenightend":             QotMarketState_NightEnd,
+	"qotmarketstatenightopen":            QotMarketState_NightOpen,
+	"qotmarketstatenone":                 QotMarketState_None,
+	"qotmarketstateovernight":            QotMarketState_OVERNIGHT,
+	"qotmarketstateovernightbegin":       QotMarketState_OVERNIGHT_BEGIN,
+	"qotmarketstateovernightend":         QotMarketState_OVERNIGHT_END,
+	"qotmarketstatepremarketbegin":       QotMarketState_PreMarketBegin,
+	"qotmarketstatepremarketend":         QotMarketState_PreMarketEnd,
+	"qotmarketstaterest":                 QotMarketState_Rest,
+	"qotmarketstatestibafterhoursbegin":  QotMarketState_StibAfterHoursBegin,
+	"qotmarketstatestibafterhoursend":    QotMarketState_StibAfterHoursEnd,
+	"qotmarketstatestibafterhourswait":   QotMarketState_StibAfterHoursWait,
+	"qotmarketstatetradeatlast":          QotMarketState_TRADE_AT_LAST,
+	"qotmarketstatetradeauction":         QotMarketState_TRADE_AUCTION,
+	"qotmarketstatewaitingopen":          QotMarketState_WaitingOpen,
+	"rest":                               QotMarketState_Rest,
+	"stibafterhoursbegin":                QotMarketState_StibAfterHoursBegin,
+	"stibafterhoursend":                  QotMarketState_StibAfterHoursEnd,
+	"stibafterhourswait":                 QotMarketState_StibAfterHoursWait,
+	"tradeatlast":                        QotMarketState_TRADE_AT_LAST,
+	"tradeauction":                       QotMarketState_TRADE_AUCTION,
+	"waitingopen":                        QotMarketState_WaitingOpen,
+}
+
+// ParseQotMarketState parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseQotMarketState(s string) (QotMarketState, error) {
+	if v, ok := parseNames_QotMarketState[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := QotMarketState_name[int32(n)]; ok {
+			return QotMarketState(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: QotMarketState %q", ErrUnknownEnumName, s)
+}
+
+func (x QotMarketState) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *QotMarketState) UnmarshalText(b []byte) error {
+	v, err := ParseQotMarketState(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *QotMarketState) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the QotPushStage_ prefix.
+func (x QotPushStage) Name() string {
+	switch x {
+	case QotPushStage_Unknown:
+		return "Unknown"
+	case QotPushStage_SR2SS:
+		return "SR2SS"
+	case QotPushStage_SS2CR:
+		return "SS2CR"
+	case QotPushStage_CR2CS:
+		return "CR2CS"
+	case QotPushStage_SS2CS:
+		return "SS2CS"
+	case QotPushStage_SR2CS:
+		return "SR2CS"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_QotPushStage = map[string]QotPushStage{
+	"cr2cs":               QotPushStage_CR2CS,
+	"qotpushstagecr2cs":   QotPushStage_CR2CS,
+	"qotpushstagesr2cs":   QotPushStage_SR2CS,
+	"qotpushstagesr2ss":   QotPushStage_SR2SS,
+	"qotpushstagess2cr":   QotPushStage_SS2CR,
+	"qotpushstagess2cs":   QotPushStage_SS2CS,
+	"qotpushstageunknown": QotPushStage_Unknown,
+	"sr2cs":               QotPushStage_SR2CS,
+	"sr2ss":               QotPushStage_SR2SS,
+	"ss2cr":               QotPushStage_SS2CR,
+	"ss2cs":               QotPushStage_SS2CS,
+	"unknown":             QotPushStage_Unknown,
+}
+
+// ParseQotPushStage parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseQotPushStage(s string) (QotPushStage, error) {
+	if v, ok := parseNames_QotPushStage[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := QotPushStage_name[int32(n)]; ok {
+			return QotPushStage(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: QotPushStage %q", ErrUnknownEnumName, s)
+}
+
+func (x QotPushStage) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *QotPushStage) UnmarshalText(b []byte) error {
+	v, err := ParseQotPushStage(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *QotPushStage) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the QotPushType_ prefix.
+func (x QotPushType) Name() string {
+	switch x {
+	case QotPushType_Unknown:
+		return "Unknown"
+	case QotPushType_Price:
+		return "Price"
+	case QotPushType_Ticker:
+		return "Ticker"
+	case QotPushType_OrderBook:
+		return "OrderBook"
+	case QotPushType_Broker:
+		return "Broker"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_QotPushType = map[string]QotPushType{
+	"broker":               QotPushType_Broker,
+	"orderbook":            QotPushType_OrderBook,
+	"price":                QotPushType_Price,
+	"qotpushbroker":        QotPushType_Broker,
+	"qotpushorderbook":     QotPushType_OrderBook,
+	"qotpushprice":         QotPushType_Price,
+	"qotpushticker":        QotPushType_Ticker,
+	"qotpushtypebroker":    QotPushType_Broker,
+	"qotpushtypeorderbook": QotPushType_OrderBook,
+	"qotpushtypeprice":     QotPushType_Price,
+	"qotpushtypeticker":    QotPushType_Ticker,
+	"qotpushtypeunknown":   QotPushType_Unknown,
+	"qotpushunknown":       QotPushType_Unknown,
+	"ticker":               QotPushType_Ticker,
+	"unknown":              QotPushType_Unknown,
+}
+
+// ParseQotPushType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseQotPushType(s string) (QotPushType, error) {
+	if v, ok := parseNames_QotPushType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := QotPushType_name[int32(n)]; ok {
+			return QotPushType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: QotPushType %q", ErrUnknownEnumName, s)
+}
+
+func (x QotPushType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *QotPushType) UnmarshalText(b []byte) error {
+	v, err := ParseQotPushType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *QotPushType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the QotRight_ prefix.
+func (x QotRight) Name() string {
+	switch x {
+	case QotRight_Unknown:
+		return "Unknown"
+	case QotRight_Bmp:
+		return "Bmp"
+	case QotRight_Level1:
+		return "Level1"
+	case QotRight_Level2:
+		return "Level2"
+	case QotRight_SF:
+		return "SF"
+	case QotRight_No:
+		return "No"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_QotRight = map[string]QotRight{
+	"bmp":             QotRight_Bmp,
+	"level1":          QotRight_Level1,
+	"level2":          QotRight_Level2,
+	"no":              QotRight_No,
+	"qotrightbmp":     QotRight_Bmp,
+	"qotrightlevel1":  QotRight_Level1,
+	"qotrightlevel2":  QotRight_Level2,
+	"qotrightno":      QotRight_No,
+	"qotrightsf":      QotRight_SF,
+	"qotrightunknown": QotRight_Unknown,
+	"sf":              QotRight_SF,
+	"unknown":         QotRight_Unknown,
+}
+
+// ParseQotRight parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseQotRight(s string) (QotRight, error) {
+	if v, ok := parseNames_QotRight[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := QotRight_name[int32(n)]; ok {
+			return QotRight(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: QotRight %q", ErrUnknownEnumName, s)
+}
+
+func (x QotRight) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *QotRight) UnmarshalText(b []byte) error {
+	v, err := ParseQotRight(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *QotRight) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the ReferenceType_ prefix.
+func (x ReferenceType) Name() string {
+	switch x {
+	case ReferenceType_Unknown:
+		return "Unknown"
+	case ReferenceType_Warrant:
+		return "Warrant"
+	case ReferenceType_Future:
+		return "Future"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_ReferenceType = map[string]ReferenceType{
+	"future":               ReferenceType_Future,
+	"referencefuture":      ReferenceType_Future,
+	"referencetypefuture":  ReferenceType_Future,
+	"referencetypeunknown": ReferenceType_Unknown,
+	"referencetypewarrant": ReferenceType_Warrant,
+	"referenceunknown":     ReferenceType_Unknown,
+	"referencewarrant":     ReferenceType_Warrant,
+	"unknown":              ReferenceType_Unknown,
+	"warrant":              ReferenceType_Warrant,
+}
+
+// ParseReferenceType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseReferenceType(s string) (ReferenceType, error) {
+	if v, ok := parseNames_ReferenceType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := ReferenceType_name[int32(n)]; ok {
+			return ReferenceType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: ReferenceType %q", ErrUnknownEnumName, s)
+}
+
+func (x ReferenceType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *ReferenceType) UnmarshalText(b []byte) error {
+	v, err := ParseReferenceType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *ReferenceType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the RehabType_ prefix.
+func (x RehabType) Name() string {
+	switch x {
+	case RehabType_None:
+		return "None"
+	case RehabType_Forward:
+		return "Forward"
+	case RehabType_Backward:
+		return "Backward"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_RehabType = map[string]RehabType{
+	"backward":          RehabType_Backward,
+	"forward":           RehabType_Forward,
+	"none":              RehabType_None,
+	"rehabbackward":     RehabType_Backward,
+	"rehabforward":      RehabType_Forward,
+	"rehabnone":         RehabType_None,
+	"rehabtypebackward": RehabType_Backward,
+	"rehabtypeforward":  RehabType_Forward,
+	"rehabtypenone":     RehabType_None,
+}
+
+// ParseRehabType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseRehabType(s string) (RehabType, error) {
+	if v, ok := parseNames_RehabType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := RehabType_name[int32(n)]; ok {
+			return RehabType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: RehabType %q", ErrUnknownEnumName, s)
+}
+
+func (x RehabType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *RehabType) UnmarshalText(b []byte) error {
+	v, err := ParseRehabType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *RehabType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the RelativePosition_ prefix.
+func (x RelativePosition) Name() string {
+	switch x {
+	case RelativePosition_Unknown:
+		return "Unknown"
+	case RelativePosition_More:
+		return "More"
+	case RelativePosition_Less:
+		return "Less"
+	case RelativePosition_CrossUp:
+		return "CrossUp"
+	case RelativePosition_CrossDown:
+		return "CrossDown"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_RelativePosition = map[string]RelativePosition{
+	"crossdown":                 RelativePosition_CrossDown,
+	"crossup":                   RelativePosition_CrossUp,
+	"less":                      RelativePosition_Less,
+	"more":                      RelativePosition_More,
+	"relativepositioncrossdown": RelativePosition_CrossDown,
+	"relativepositioncrossup":   RelativePosition_CrossUp,
+	"relativepositionless":      RelativePosition_Less,
+	"relativepositionmore":      RelativePosition_More,
+	"relativepositionunknown":   RelativePosition_Unknown,
+	"unknown":                   RelativePosition_Unknown,
+}
+
+// ParseRelativePosition parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseRelativePosition(s string) (RelativePosition, error) {
+	if v, ok := parseNames_RelativePosition[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := RelativePosition_name[int32(n)]; ok {
+			return RelativePosition(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: RelativePosition %q", ErrUnknownEnumName, s)
+}
+
+func (x RelativePosition) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *RelativePosition) UnmarshalText(b []byte) error {
+	v, err := ParseRelativePosition(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *RelativePosition) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the RetType_ prefix.
+func (x RetType) Name() string {
+	switch x {
+	case RetType_Succeed:
+		return "Succeed"
+	case RetType_Failed:
+		return "Failed"
+	case RetType_TimeOut:
+		return "TimeOut"
+	case RetType_DisConnect:
+		return "DisConnect"
+	case RetType_Unknown:
+		return "Unknown"
+	case RetType_Invalid:
+		return "Invalid"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_RetType = map[string]RetType{
+	"disconnect":        RetType_DisConnect,
+	"failed":            RetType_Failed,
+	"invalid":           RetType_Invalid,
+	"retdisconnect":     RetType_DisConnect,
+	"retfailed":         RetType_Failed,
+	"retinvalid":        RetType_Invalid,
+	"retsucceed":        RetType_Succeed,
+	"rettimeout":        RetType_TimeOut,
+	"rettypedisconnect": RetType_DisConnect,
+	"rettypefailed":     RetType_Failed,
+	"rettypeinvalid":    RetType_Invalid,
+	"rettypesucceed":    RetType_Succeed,
+	"rettypetimeout":    RetType_TimeOut,
+	"rettypeunknown":    RetType_Unknown,
+	"retunknown":        RetType_Unknown,
+	"succeed":           RetType_Succeed,
+	"timeout":           RetType_TimeOut,
+	"unknown":           RetType_Unknown,
+}
+
+// ParseRetType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseRetType(s string) (RetType, error) {
+	if v, ok := parseNames_RetType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := RetType_name[int32(n)]; ok {
+			return RetType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: RetType %q", ErrUnknownEnumName, s)
+}
+
+func (x RetType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *RetType) UnmarshalText(b []byte) error {
+	v, err := ParseRetType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *RetType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the SecurityFirm_ prefix.
+func (x SecurityFirm) Name() string {
+	switch x {
+	case SecurityFirm_Unknown:
+		return "Unknown"
+	case SecurityFirm_FutuSecurities:
+		return "FutuSecurities"
+	case SecurityFirm_FutuInc:
+		return "FutuInc"
+	case SecurityFirm_FutuSG:
+		return "FutuSG"
+	case SecurityFirm_FutuAU:
+		return "FutuAU"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_SecurityFirm = map[string]SecurityFirm{
+	"futuau":                     SecurityFirm_FutuAU,
+	"futuinc":                    SecurityFirm_FutuInc,
+	"futusecurities":             SecurityFirm_FutuSecurities,
+	"futusg":                     SecurityFirm_FutuSG,
+	"securityfirmfutuau":         SecurityFirm_FutuAU,
+	"securityfirmfutuinc":        SecurityFirm_FutuInc,
+	"securityfirmfutusecurities": SecurityFirm_FutuSecurities,
+	"securityfirmfutusg":         SecurityFirm_FutuSG,
+	"securityfirmunknown":        SecurityFirm_Unknown,
+	"unknown":                    SecurityFirm_Unknown,
+}
+
+// ParseSecurityFirm parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseSecurityFirm(s string) (SecurityFirm, error) {
+	if v, ok := parseNames_SecurityFirm[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := SecurityFirm_name[int32(n)]; ok {
+			return SecurityFirm(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: SecurityFirm %q", ErrUnknownEnumName, s)
+}
+
+func (x SecurityFirm) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *SecurityFirm) UnmarshalText(b []byte) error {
+	v, err := ParseSecurityFirm(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *SecurityFirm) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the SecurityStatus_ prefix.
+func (x SecurityStatus) Name() string {
+	switch x {
+	case SecurityStatus_Unknown:
+		return "Unknown"
+	case SecurityStatus_Normal:
+		return "Normal"
+	case SecurityStatus_Listing:
+		return "Listing"
+	case SecurityStatus_Purchasing:
+		return "Purchasing"
+	case SecurityStatus_Subscribing:
+		return "Subscribing"
+	case SecurityStatus_BeforeDrakTradeOpening:
+		return "BeforeDrakTradeOpening"
+	case SecurityStatus_DrakTrading:
+		return "DrakTrading"
+	case SecurityStatus_DrakTradeEnd:
+		return "DrakTradeEnd"
+	case SecurityStatus_ToBeOpen:
+		return "ToBeOpen"
+	case SecurityStatus_Suspended:
+		return "Suspended"
+	case SecurityStatus_Called:
+		return "Called"
+	case SecurityStatus_ExpiredLastTradingDate:
+		return "ExpiredLastTradingDate"
+	case SecurityStatus_Expired:
+		return "Expired"
+	case SecurityStatus_Delisted:
+		return "Delisted"
+	case SecurityStatus_ChangeToTemporaryCode:
+		return "ChangeToTemporaryCode"
+	case SecurityStatus_TemporaryCodeTradeEnd:
+		return "TemporaryCodeTradeEnd"
+	case SecurityStatus_ChangedPlateTradeEnd:
+		return "ChangedPlateTradeEnd"
+	case SecurityStatus_ChangedCodeTradeEnd:
+		return "ChangedCodeTradeEnd"
+	case SecurityStatus_RecoverableCircuitBreaker:
+		return "RecoverableCircuitBreaker"
+	case SecurityStatus_UnRecoverableCircuitBreaker:
+		return "UnRecoverableCircuitBreaker"
+	case SecurityStatus_AfterCombination:
+		return "AfterCombination"
+	case SecurityStatus_AfterTransation:
+		return "AfterTransation"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_SecurityStatus = map[string]SecurityStatus{
+	"aftercombination":                          SecurityStatus_AfterCombination,
+	"aftertransation":                           SecurityStatus_AfterTransation,
+	"beforedraktradeopening":                    SecurityStatus_BeforeDrakTradeOpening,
+	"called":                                    SecurityStatus_Called,
+	"changedcodetradeend":                       SecurityStatus_ChangedCodeTradeEnd,
+	"changedplatetradeend":                      SecurityStatus_ChangedPlateTradeEnd,
+	"changetotemporarycode":                     SecurityStatus_ChangeToTemporaryCode,
+	"delisted":                                  SecurityStatus_Delisted,
+	"draktradeend":                              SecurityStatus_DrakTradeEnd,
+	"draktrading":                               SecurityStatus_DrakTrading,
+	"expired":                                   SecurityStatus_Expired,
+	"expiredlasttradingdate":                    SecurityStatus_ExpiredLastTradingDate,
+	"listing":                                   SecurityStatus_Listing,
+	"normal":                                    SecurityStatus_Normal,
+	"purchasing":                                SecurityStatus_Purchasing,
+	"recoverablecircuitbreaker":                 SecurityStatus_RecoverableCircuitBreaker,
+	"securitystatusaftercombination":            SecurityStatus_AfterCombination,
+	"securitystatusaftertransation":             SecurityStatus_AfterTransation,
+	"securitystatusbeforedraktradeopening":      SecurityStatus_BeforeDrakTradeOpening,
+	"securitystatuscalled":                      SecurityStatus_Called,
+	"securitystatuschangedcodetradeend":         SecurityStatus_ChangedCodeTradeEnd,
+	"securitystatuschangedplatetradeend":        SecurityStatus_ChangedPlateTradeEnd,
+	"securitystatuschangetotemporarycode":       SecurityStatus_ChangeToTemporaryCode,
+	"securitystatusdelisted":                    SecurityStatus_Delisted,
+	"securitystatusdraktradeend":                SecurityStatus_DrakTradeEnd,
+	"securitystatusdraktrading":                 SecurityStatus_DrakTrading,
+	"securitystatusexpired":                     SecurityStatus_Expired,
+	"securitystatusexpiredlasttradingdate":      SecurityStatus_ExpiredLastTradingDate,
+	"securitystatuslisting":                     SecurityStatus_Listing,
+	"securitystatusnormal":                      SecurityStatus_Normal,
+	"securitystatuspurchasing":                  SecurityStatus_Purchasing,
+	"securitystatusrecoverablecircuitbreaker":   SecurityStatus_RecoverableCircuitBreaker,
+	"securitystatussubscribing":                 SecurityStatus_Subscribing,
+	"securitystatussuspended":                   SecurityStatus_Suspended,
+	"securitystatustemporarycodetradeend":       SecurityStatus_TemporaryCodeTradeEnd,
+	"securitystatustobeopen":                    SecurityStatus_ToBeOpen,
+	"securitystatusunknown":                     SecurityStatus_Unknown,
+	"securitystatusunrecoverablecircuitbreaker": SecurityStatus_UnRecoverableCircuitBreaker,
+	"subscribing":                               SecurityStatus_Subscribing,
+	"suspended":                                 SecurityStatus_Suspended,
+	"temporarycodetradeend":                     SecurityStatus_TemporaryCodeTradeEnd,
+	"tobeopen":                                  SecurityStatus_ToBeOpen,
+	"unknown":                                   SecurityStatus_Unknown,
+	"unrecoverablecircuitbreaker":               SecurityStatus_UnRecoverableCircuitBreaker,
+}
+
+// ParseSecurityStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseSecurityStatus(s string) (SecurityStatus, error) {
+	if v, ok := parseNames_SecurityStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := SecurityStatus_name[int32(n)]; ok {
+			return SecurityStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: SecurityStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x SecurityStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *SecurityStatus) UnmarshalText(b []byte) error {
+	v, err := ParseSecurityStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *SecurityStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the SecurityType_ prefix.
+func (x SecurityType) Name() string {
+	switch x {
+	case SecurityType_Unknown:
+		return "Unknown"
+	case SecurityType_Bond:
+		return "Bond"
+	case SecurityType_Bwrt:
+		return "Bwrt"
+	case SecurityType_Eqty:
+		return "Eqty"
+	case SecurityType_Trust:
+		return "Trust"
+	case SecurityType_Warrant:
+		return "Warrant"
+	case SecurityType_Index:
+		return "Index"
+	case SecurityType_Plate:
+		return "Plate"
+	case SecurityType_Drvt:
+		return "Drvt"
+	case SecurityType_PlateSet:
+		return "PlateSet"
+	case SecurityType_Future:
+		return "Future"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_SecurityType = map[string]SecurityType{
+	"bond":                 SecurityType_Bond,
+	"bwrt":                 SecurityType_Bwrt,
+	"drvt":                 SecurityType_Drvt,
+	"eqty":                 SecurityType_Eqty,
+	"future":               SecurityType_Future,
+	"index":                SecurityType_Index,
+	"plate":                SecurityType_Plate,
+	"plateset":             SecurityType_PlateSet,
+	"securitybond":         SecurityType_Bond,
+	"securitybwrt":         SecurityType_Bwrt,
+	"securitydrvt":         SecurityType_Drvt,
+	"securityeqty":         SecurityType_Eqty,
+	"securityfuture":       SecurityType_Future,
+	"securityindex":        SecurityType_Index,
+	"securityplate":        SecurityType_Plate,
+	"securityplateset":     SecurityType_PlateSet,
+	"securitytrust":        SecurityType_Trust,
+	"securitytypebond":     SecurityType_Bond,
+	"securitytypebwrt":     SecurityType_Bwrt,
+	"securitytypedrvt":     SecurityType_Drvt,
+	"securitytypeeqty":     SecurityType_Eqty,
+	"securitytypefuture":   SecurityType_Future,
+	"securitytypeindex":    SecurityType_Index,
+	"securitytypeplate":    SecurityType_Plate,
+	"securitytypeplateset": SecurityType_PlateSet,
+	"securitytypetrust":    SecurityType_Trust,
+	"securitytypeunknown":  SecurityType_Unknown,
+	"securitytypewarrant":  SecurityType_Warrant,
+	"securityunknown":      SecurityType_Unknown,
+	"securitywarrant":      SecurityType_Warrant,
+	"trust":                SecurityType_Trust,
+	"unknown":              SecurityType_Unknown,
+	"warrant":              SecurityType_Warrant,
+}
+
+// ParseSecurityType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseSecurityType(s string) (SecurityType, error) {
+	if v, ok := parseNames_SecurityType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := SecurityType_name[int32(n)]; ok {
+			return SecurityType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: SecurityType %q", ErrUnknownEnumName, s)
+}
+
+func (x SecurityType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *SecurityType) UnmarshalText(b []byte) error {
+	v, err := ParseSecurityType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *SecurityType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the Session_ prefix.
+func (x Session) Name() string {
+	switch x {
+	case Session_NONE:
+		return "NONE"
+	case Session_RTH:
+		return "RTH"
+	case Session_ETH:
+		return "ETH"
+	case Session_ALL:
+		return "ALL"
+	case Session_OVERNIGHT:
+		return "OVERNIGHT"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_Session = map[string]Session{
+	"all":              Session_ALL,
+	"eth":              Session_ETH,
+	"none":             Session_NONE,
+	"overnight":        Session_OVERNIGHT,
+	"rth":              Session_RTH,
+	"sessionall":       Session_ALL,
+	"sessioneth":       Session_ETH,
+	"sessionnone":      Session_NONE,
+	"sessionovernight": Session_OVERNIGHT,
+	"sessionrth":       Session_RTH,
+}
+
+// ParseSession parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseSession(s string) (Session, error) {
+	if v, ok := parseNames_Session[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := Session_name[int32(n)]; ok {
+			return Session(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: Session %q", ErrUnknownEnumName, s)
+}
+
+func (x Session) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *Session) UnmarshalText(b []byte) error {
+	v, err := ParseSession(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *Session) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the SetPriceReminderOp_ prefix.
+func (x SetPriceReminderOp) Name() string {
+	switch x {
+	case SetPriceReminderOp_Unknown:
+		return "Unknown"
+	case SetPriceReminderOp_Add:
+		return "Add"
+	case SetPriceReminderOp_Del:
+		return "Del"
+	case SetPriceReminderOp_Enable:
+		return "Enable"
+	case SetPriceReminderOp_Disable:
+		return "Disable"
+	case SetPriceReminderOp_Modify:
+		return "Modify"
+	case SetPriceReminderOp_DelAll:
+		return "DelAll"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_SetPriceReminderOp = map[string]SetPriceReminderOp{
+	"add":                       SetPriceReminderOp_Add,
+	"del":                       SetPriceReminderOp_Del,
+	"delall":                    SetPriceReminderOp_DelAll,
+	"disable":                   SetPriceReminderOp_Disable,
+	"enable":                    SetPriceReminderOp_Enable,
+	"modify":                    SetPriceReminderOp_Modify,
+	"setpricereminderopadd":     SetPriceReminderOp_Add,
+	"setpricereminderopdel":     SetPriceReminderOp_Del,
+	"setpricereminderopdelall":  SetPriceReminderOp_DelAll,
+	"setpricereminderopdisable": SetPriceReminderOp_Disable,
+	"setpricereminderopenable":  SetPriceReminderOp_Enable,
+	"setpricereminderopmodify":  SetPriceReminderOp_Modify,
+	"setpricereminderopunknown": SetPriceReminderOp_Unknown,
+	"unknown":                   SetPriceReminderOp_Unknown,
+}
+
+// ParseSetPriceReminderOp parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseSetPriceReminderOp(s string) (SetPriceReminderOp, error) {
+	if v, ok := parseNames_SetPriceReminderOp[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := SetPriceReminderOp_name[int32(n)]; ok {
+			return SetPriceReminderOp(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: SetPriceReminderOp %q", ErrUnknownEnumName, s)
+}
+
+func (x SetPriceReminderOp) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *SetPriceReminderOp) UnmarshalText(b []byte) error {
+	v, err := ParseSetPriceReminderOp(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *SetPriceReminderOp) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the SimAccType_ prefix.
+func (x SimAccType) Name() string {
+	switch x {
+	case SimAccType_Unknown:
+		return "Unknown"
+	case SimAccType_Stock:
+		return "Stock"
+	case SimAccType_Option:
+		return "Option"
+	case SimAccType_Futures:
+		return "Futures"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_SimAccType = map[string]SimAccType{
+	"futures":           SimAccType_Futures,
+	"option":            SimAccType_Option,
+	"simaccfutures":     SimAccType_Futures,
+	"simaccoption":      SimAccType_Option,
+	"simaccstock":       SimAccType_Stock,
+	"simacctypefutures": SimAccType_Futures,
+	"simacctypeoption":  SimAccType_Option,
+	"simacctypestock":   SimAccType_Stock,
+	"simacctypeunknown": SimAccType_Unknown,
+	"simaccunknown":     SimAccType_Unknown,
+	"stock":             SimAccType_Stock,
+	"unknown":           SimAccType_Unknown,
+}
+
+// ParseSimAccType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseSimAccType(s string) (SimAccType, error) {
+	if v, ok := parseNames_SimAccType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := SimAccType_name[int32(n)]; ok {
+			return SimAccType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: SimAccType %q", ErrUnknownEnumName, s)
+}
+
+func (x SimAccType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *SimAccType) UnmarshalText(b []byte) error {
+	v, err := ParseSimAccType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *SimAccType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the SortDir_ prefix.
+func (x SortDir) Name() string {
+	switch x {
+	case SortDir_No:
+		return "No"
+	case SortDir_Ascend:
+		return "Ascend"
+	case SortDir_Descend:
+		return "Descend"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_SortDir = map[string]SortDir{
+	"ascend":         SortDir_Ascend,
+	"descend":        SortDir_Descend,
+	"no":             SortDir_No,
+	"sortdirascend":  SortDir_Ascend,
+	"sortdirdescend": SortDir_Descend,
+	"sortdirno":      SortDir_No,
+}
+
+// ParseSortDir parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseSortDir(s string) (SortDir, error) {
+	if v, ok := parseNames_SortDir[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := SortDir_name[int32(n)]; ok {
+			return SortDir(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: SortDir %q", ErrUnknownEnumName, s)
+}
+
+func (x SortDir) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *SortDir) UnmarshalText(b []byte) error {
+	v, err := ParseSortDir(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *SortDir) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the SortField_ prefix.
+func (x SortField) Name() string {
+	switch x {
+	case SortField_Unknown:
+		return "Unknown"
+	case SortField_Code:
+		return "Code"
+	case SortField_CurPrice:
+		return "CurPrice"
+	case SortField_PriceChangeVal:
+		return "PriceChangeVal"
+	case SortField_ChangeRate:
+		return "ChangeRate"
+	case SortField_Status:
+		return "Status"
+	case SortField_BidPrice:
+		return "BidPrice"
+	case SortField_AskPrice:
+		return "AskPrice"
+	case SortField_BidVol:
+		return "BidVol"
+	case SortField_AskVol:
+		return "AskVol"
+	case SortField_Volume:
+		return "Volume"
+	case SortField_Turnover:
+		return "Turnover"
+	case SortField_Amplitude:
+		return "Amplitude"
+	case SortField_Score:
+		return "Score"
+	case SortField_Premium:
+		return "Premium"
+	case SortField_EffectiveLeverage:
+		return "EffectiveLeverage"
+	case SortField_Delta:
+		return "Delta"
+	case SortField_ImpliedVolatility:
+		return "ImpliedVolatility"
+	case SortField_Type:
+		return "Type"
+	case SortField_StrikePrice:
+		return "StrikePrice"
+	case SortField_BreakEvenPoint:
+		return "BreakEvenPoint"
+	case SortField_MaturityTime:
+		return "MaturityTime"
+	case SortField_ListTime:
+		return "ListTime"
+	case SortField_LastTradeTime:
+		return "LastTradeTime"
+	case SortField_Leverage:
+		return "Leverage"
+	case SortField_InOutMoney:
+		return "InOutMoney"
+	case SortField_RecoveryPrice:
+		return "RecoveryPrice"
+	case SortField_ChangePrice:
+		return "ChangePrice"
+	case SortField_Change:
+		return "Change"
+	case SortField_StreetRate:
+		return "StreetRate"
+	case SortField_StreetVol:
+		return "StreetVol"
+	case SortField_WarrantName:
+		return "WarrantName"
+	case SortField_Issuer:
+		return "Issuer"
+	case SortField_LotSize:
+		return "LotSize"
+	case SortField_IssueSize:
+		return "IssueSize"
+	case SortField_UpperStrikePrice:
+		return "UpperStrikePrice"
+	case SortField_LowerStrikePrice:
+		return "LowerStrikePrice"
+	case SortField_InLinePriceStatus:
+		return "InLinePriceStatus"
+	case SortField_PreCurPrice:
+		return "PreCurPrice"
+	case SortField_AfterCurPrice:
+		return "AfterCurPrice"
+	case SortField_PrePriceChangeVal:
+		return "PrePriceChangeVal"
+	case SortField_AfterPriceChangeVal:
+		return "AfterPriceChangeVal"
+	case SortField_PreChangeRate:
+		return "PreChangeRate"
+	case SortField_AfterChangeRate:
+		return "AfterChangeRate"
+	case SortField_PreAmplitude:
+		return "PreAmplitude"
+	case SortField_AfterAmplitude:
+		return "AfterAmplitude"
+	case SortField_PreTurnover:
+		return "PreTurnover"
+	case SortField_AfterTurnover:
+		return "AfterTurnover"
+	case SortField_LastSettlePrice:
+		return "LastSettlePrice"
+	case SortField_Position:
+		return "Position"
+	case SortField_PositionChange:
+		return "PositionChange"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_SortField = map[string]SortField{
+	"afteramplitude":               SortField_AfterAmplitude,
+	"afterchangerate":              SortField_AfterChangeRate,
+	"aftercurprice":                SortField_AfterCurPrice,
+	"afterpricechangeval":          SortField_AfterPriceChangeVal,
+	"afterturnover":                SortField_AfterTurnover,
+	"amplitude":                    SortField_Amplitude,
+	"askprice":                     SortField_AskPrice,
+	"askvol":                       SortField_AskVol,
+	"bidprice":                     SortField_BidPrice,
+	"bidvol":                       SortField_BidVol,
+	"breakevenpoint":               SortField_BreakEvenPoint,
+	"change":                       SortField_Change,
+	"changeprice":                  SortField_ChangePrice,
+	"changerate":                   SortField_ChangeRate,
+	"code":                         SortField_Code,
+	"curprice":                     SortField_CurPrice,
+	"delta":                        SortField_Delta,
+	"effectiveleverage":            SortField_EffectiveLeverage,
+	"impliedvolatility":            SortField_ImpliedVolatility,
+	"inlinepricestatus":            SortField_InLinePriceStatus,
+	"inoutmoney":                   SortField_InOutMoney,
+	"issuer":                       SortField_Issuer,
+	"issuesize":                    SortField_IssueSize,
+	"lastsettleprice":              SortField_LastSettlePrice,
+	"lasttradetime":                SortField_LastTradeTime,
+	"leverage":                     SortField_Leverage,
+	"listtime":                     SortField_ListTime,
+	"lotsize":                      SortField_LotSize,
+	"lowerstrikeprice":             SortField_LowerStrikePrice,
+	"maturitytime":                 SortField_MaturityTime,
+	"position":                     SortField_Position,
+	"positionchange":               SortField_PositionChange,
+	"preamplitude":                 SortField_PreAmplitude,
+	"prechangerate":                SortField_PreChangeRate,
+	"precurprice":                  SortField_PreCurPrice,
+	"premium":                      SortField_Premium,
+	"prepricechangeval":            SortField_PrePriceChangeVal,
+	"preturnover":                  SortField_PreTurnover,
+	"pricechangeval":               SortField_PriceChangeVal,
+	"recoveryprice":                SortField_RecoveryPrice,
+	"score":                        SortField_Score,
+	"sortfieldafteramplitude":      SortField_AfterAmplitude,
+	"sortfieldafterchangerate":     SortField_AfterChangeRate,
+	"sortfieldaftercurprice":       SortField_AfterCurPrice,
+	"sortfieldafterpricechangeval": SortField_AfterPriceChangeVal,
+	"sortfieldafterturnover":       SortField_AfterTurnover,
+	"sortfieldamplitude":           SortField_Amplitude,
+	"sortfieldaskprice":            SortField_AskPrice,
+	"sortfieldaskvol":              SortField_AskVol,
+	"sortfieldbidprice":            SortField_BidPrice,
+	"sortfieldbidvol":              SortField_BidVol,
+	"sortfieldbreakevenpoint":      SortField_BreakEvenPoint,
+	"sortfieldchange":              SortField_Change,
+	"sortfieldchangeprice":         SortField_ChangePrice,
+	"sortfieldchangerate":          SortField_ChangeRate,
+	"sortfieldcode":                SortField_Code,
+	"sortfieldcurprice":            SortField_CurPrice,
+	"sortfielddelta":               SortField_Delta,
+	"sortfieldeffectiveleverage":   SortField_EffectiveLeverage,
+	"sortfieldimpliedvolatility":   SortField_ImpliedVolatility,
+	"sortfieldinlinepricestatus":   SortField_InLinePriceStatus,
+	"sortfieldinoutmoney":          SortField_InOutMoney,
+	"sortfieldissuer":              SortField_Issuer,
+	"sortfieldissuesize":           SortField_IssueSize,
+	"sortfieldlastsettleprice":     SortField_LastSettlePrice,
+	"sortfieldlasttradetime":       SortField_LastTradeTime,
+	"sortfieldleverage":            SortField_Leverage,
+	"sortfieldlisttime":            SortField_ListTime,
+	"sortfieldlotsize":             SortField_LotSize,
+	"sortfieldlowerstrikeprice":    SortField_LowerStrikePrice,
+	"sortfieldmaturitytime":        SortField_MaturityTime,
+	"sortfieldposition":            SortField_Position,
+	"sortfieldpositionchange":      SortField_PositionChange,
+	"sortfieldpreamplitude":        SortField_PreAmplitude,
+	"sortfieldprechangerate":       SortField_PreChangeRate,
+	"sortfieldprecurprice":         SortField_PreCurPrice,
+	"sortfieldpremium":             SortField_Premium,
+	"sortfieldprepricechangeval":   SortField_PrePriceChangeVal,
+	"sortfieldpreturnover":         SortField_PreTurnover,
+	"sortfieldpricechangeval":      SortField_PriceChangeVal,
+	"sortfieldrecoveryprice":       SortField_RecoveryPrice,
+	"sortfieldscore":               SortField_Score,
+	"sortfieldstatus":              SortField_Status,
+	"sortfieldstreetrate":          SortField_StreetRate,
+	"sortfieldstreetvol":           SortField_StreetVol,
+	"sortfieldstrikeprice":         SortField_StrikePrice,
+	"sortfieldturnover":            SortField_Turnover,
+	"sortfieldtype":                SortField_Type,
+	"sortfieldunknown":             SortField_Unknown,
+	"sortfieldupperstrikeprice":    SortField_UpperStrikePrice,
+	"sortfieldvolume":              SortField_Volume,
+	"sortfieldwarrantname":         SortField_WarrantName,
+	"status":                       SortField_Status,
+	"streetrate":                   SortField_StreetRate,
+	"streetvol":                    SortField_StreetVol,
+	"strikeprice":                  SortField_StrikePrice,
+	"turnover":                     SortField_Turnover,
+	"type":                         SortField_Type,
+	"unknown":                      SortField_Unknown,
+	"upperstrikeprice":             SortField_UpperStrikePrice,
+	"volume":                       SortField_Volume,
+	"warrantname":                  SortField_WarrantName,
+}
+
+// ParseSortField parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseSortField(s string) (SortField, error) {
+	if v, ok := parseNames_SortField[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := SortField_name[int32(n)]; ok {
+			return SortField(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: SortField %q", ErrUnknownEnumName, s)
+}
+
+func (x SortField) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *SortField) UnmarshalText(b []byte) error {
+	v, err := ParseSortField(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *SortField) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the StockField_ prefix.
+func (x StockField) Name() string {
+	switch x {
+	case StockField_Unknown:
+		return "Unknown"
+	case StockField_StockCode:
+		return "StockCode"
+	case StockField_StockName:
+		return "StockName"
+	case StockField_CurPrice:
+		return "CurPrice"
+	case StockField_CurPriceToHighest52WeeksRatio:
+		return "CurPriceToHighest52WeeksRatio"
+	case StockField_CurPriceToLowest52WeeksRatio:
+		return "CurPriceToLowest52WeeksRatio"
+	case StockField_HighPriceToHighest52WeeksRatio:
+		return "HighPriceToHighest52WeeksRatio"
+	case StockField_LowPriceToLowest52WeeksRatio:
+		return "LowPriceToLowest52WeeksRatio"
+	case StockField_VolumeRatio:
+		return "VolumeRatio"
+	case StockField_BidAskRatio:
+		return "BidAskRatio"
+	case StockField_LotPrice:
+		return "LotPrice"
+	case StockField_MarketVal:
+		return "MarketVal"
+	case StockField_PeAnnual:
+		return "PeAnnual"
+	case StockField_PeTTM:
+		return "PeTTM"
+	case StockField_PbRate:
+		return "PbRate"
+	case StockField_ChangeRate5min:
+		return "ChangeRate5min"
+	case StockField_ChangeRateBeginYear:
+		return "ChangeRateBeginYear"
+	case StockField_PSTTM:
+		return "PSTTM"
+	case StockField_PCFTTM:
+		return "PCFTTM"
+	case StockField_TotalShare:
+		return "TotalShare"
+	case StockField_FloatShare:
+		return "FloatShare"
+	case StockField_FloatMarketVal:
+		return "FloatMarketVal"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_StockField = map[string]StockField{
+	"bidaskratio":                    StockField_BidAskRatio,
+	"changerate5min":                 StockField_ChangeRate5min,
+	"changeratebeginyear":            StockField_ChangeRateBeginYear,
+	"curprice":                       StockField_CurPrice,
+	"curpricetohighest52weeksratio":  StockField_CurPriceToHighest52WeeksRatio,
+	"curpricetolowest52weeksratio":   StockField_CurPriceToLowest52WeeksRatio,
+	"floatmarketval":                 StockField_FloatMarketVal,
+	"floatshare":                     StockField_FloatShare,
+	"highpricetohighest52weeksratio": StockField_HighPriceToHighest52WeeksRatio,
+	"lotprice":                       StockField_LotPrice,
+	"lowpricetolowest52weeksratio":   StockField_LowPriceToLowest52WeeksRatio,
+	"marketval":                      StockField_MarketVal,
+	"pbrate":                         StockField_PbRate,
+	"pcfttm":                         StockField_PCFTTM,
+	"peannual":                       StockField_PeAnnual,
+	"pettm":                          StockField_PeTTM,
+	"psttm":                          StockField_PSTTM,
+	"stockcode":                      StockField_StockCode,
+	"stockfieldbidaskratio":          StockField_BidAskRatio,
+	"stockfieldchangerate5min":       StockField_ChangeRate5min,
+	"stockfieldchangeratebeginyear":  StockField_ChangeRateBeginYear,
+	"stockfieldcurprice":             StockField_CurPrice,
+	"stockfieldcurpricetohighest52weeksratio":  StockField_CurPriceToHighest52WeeksRatio,
+	"stockfieldcurpricetolowest52weeksratio":   StockField_CurPriceToLowest52WeeksRatio,
+	"stockfieldfloatmarketval":                 StockField_FloatMarketVal,
+	"stockfieldfloatshare":                     StockField_FloatShare,
+	"stockfieldhighpricetohighest52weeksratio": StockField_HighPriceToHighest52WeeksRatio,
+	"stockfieldlotprice":                       StockField_LotPrice,
+	"stockfieldlowpricetolowest52weeksratio":   StockField_LowPriceToLowest52WeeksRatio,
+	"stockfieldmarketval":                      StockField_MarketVal,
+	"stockfieldpbrate":                         StockField_PbRate,
+	"stockfieldpcfttm":                         StockField_PCFTTM,
+	"stockfieldpeannual":                       StockField_PeAnnual,
+	"stockfieldpettm":                          StockField_PeTTM,
+	"stockfieldpsttm":                          StockField_PSTTM,
+	"stockfieldstockcode":                      StockField_StockCode,
+	"stockfieldstockname":                      StockField_StockName,
+	"stockfieldtotalshare":                     StockField_TotalShare,
+	"stockfieldunknown":                        StockField_Unknown,
+	"stockfieldvolumeratio":                    StockField_VolumeRatio,
+	"stockname":                                StockField_StockName,
+	"totalshare":                               StockField_TotalShare,
+	"unknown":                                  StockField_Unknown,
+	"volumeratio":                              StockField_VolumeRatio,
+}
+
+// ParseStockField parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseStockField(s string) (StockField, error) {
+	if v, ok := parseNames_StockField[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := StockField_name[int32(n)]; ok {
+			return StockField(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: StockField %q", ErrUnknownEnumName, s)
+}
+
+func (x StockField) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *StockField) UnmarshalText(b []byte) error {
+	v, err := ParseStockField(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *StockField) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the SubType_ prefix.
+func (x SubType) Name() string {
+	switch x {
+	case SubType_None:
+		return "None"
+	case SubType_Basic:
+		return "Basic"
+	case SubType_OrderBook:
+		return "OrderBook"
+	case SubType_Ticker:
+		return "Ticker"
+	case SubType_RT:
+		return "RT"
+	case SubType_KL_Day:
+		return "KL_Day"
+	case SubType_KL_5Min:
+		return "KL_5Min"
+	case SubType_KL_15Min:
+		return "KL_15Min"
+	case SubType_KL_30Min:
+		return "KL_30Min"
+	case SubType_KL_60Min:
+		return "KL_60Min"
+	case SubType_KL_1Min:
+		return "KL_1Min"
+	case SubType_KL_Week:
+		return "KL_Week"
+	case SubType_KL_Month:
+		return "KL_Month"
+	case SubType_Broker:
+		return "Broker"
+	case SubType_KL_Qurater:
+		return "KL_Qurater"
+	case SubType_KL_Year:
+		return "KL_Year"
+	case SubType_KL_3Min:
+		return "KL_3Min"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_SubType = map[string]SubType{
+	"basic":            SubType_Basic,
+	"broker":           SubType_Broker,
+	"kl15min":          SubType_KL_15Min,
+	"kl1min":           SubType_KL_1Min,
+	"kl30min":          SubType_KL_30Min,
+	"kl3min":           SubType_KL_3Min,
+	"kl5min":           SubType_KL_5Min,
+	"kl60min":          SubType_KL_60Min,
+	"klday":            SubType_KL_Day,
+	"klmonth":          SubType_KL_Month,
+	"klqurater":        SubType_KL_Qurater,
+	"klweek":           SubType_KL_Week,
+	"klyear":           SubType_KL_Year,
+	"none":             SubType_None,
+	"orderbook":        SubType_OrderBook,
+	"rt":               SubType_RT,
+	"subbasic":         SubType_Basic,
+	"subbroker":        SubType_Broker,
+	"subkl15min":       SubType_KL_15Min,
+	"subkl1min":        SubType_KL_1Min,
+	"subkl30min":       SubType_KL_30Min,
+	"subkl3min":        SubType_KL_3Min,
+	"subkl5min":        SubType_KL_5Min,
+	"subkl60min":       SubType_KL_60Min,
+	"subklday":         SubType_KL_Day,
+	"subklmonth":       SubType_KL_Month,
+	"subklqurater":     SubType_KL_Qurater,
+	"subklweek":        SubType_KL_Week,
+	"subklyear":        SubType_KL_Year,
+	"subnone":          SubType_None,
+	"suborderbook":     SubType_OrderBook,
+	"subrt":            SubType_RT,
+	"subticker":        SubType_Ticker,
+	"subtypebasic":     SubType_Basic,
+	"subtypebroker":    SubType_Broker,
+	"subtypekl15min":   SubType_KL_15Min,
+	"subtypekl1min":    SubType_KL_1Min,
+	"subtypekl30min":   SubType_KL_30Min,
+	"subtypekl3min":    SubType_KL_3Min,
+	"subtypekl5min":    SubType_KL_5Min,
+	"subtypekl60min":   SubType_KL_60Min,
+	"subtypeklday":     SubType_KL_Day,
+	"subtypeklmonth":   SubType_KL_Month,
+	"subtypeklqurater": SubType_KL_Qurater,
+	"subtypeklweek":    SubType_KL_Week,
+	"subtypeklyear":    SubType_KL_Year,
+	"subtypenone":      SubType_None,
+	"subtypeorderbook": SubType_OrderBook,
+	"subtypert":        SubType_RT,
+	"subtypeticker":    SubType_Ticker,
+	"ticker":           SubType_Ticker,
+}
+
+// ParseSubType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseSubType(s string) (SubType, error) {
+	if v, ok := parseNames_SubType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := SubType_name[int32(n)]; ok {
+			return SubType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: SubType %q", ErrUnknownEnumName, s)
+}
+
+func (x SubType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *SubType) UnmarshalText(b []byte) error {
+	v, err := ParseSubType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *SubType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TickerDirection_ prefix.
+func (x TickerDirection) Name() string {
+	switch x {
+	case TickerDirection_Unknown:
+		return "Unknown"
+	case TickerDirection_Bid:
+		return "Bid"
+	case TickerDirection_Ask:
+		return "Ask"
+	case TickerDirection_Neutral:
+		return "Neutral"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TickerDirection = map[string]TickerDirection{
+	"ask":                    TickerDirection_Ask,
+	"bid":                    TickerDirection_Bid,
+	"neutral":                TickerDirection_Neutral,
+	"tickerdirectionask":     TickerDirection_Ask,
+	"tickerdirectionbid":     TickerDirection_Bid,
+	"tickerdirectionneutral": TickerDirection_Neutral,
+	"tickerdirectionunknown": TickerDirection_Unknown,
+	"unknown":                TickerDirection_Unknown,
+}
+
+// ParseTickerDirection parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTickerDirection(s string) (TickerDirection, error) {
+	if v, ok := parseNames_TickerDirection[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TickerDirection_name[int32(n)]; ok {
+			return TickerDirection(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TickerDirection %q", ErrUnknownEnumName, s)
+}
+
+func (x TickerDirection) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TickerDirection) UnmarshalText(b []byte) error {
+	v, err := ParseTickerDirection(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TickerDirection) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TickerType_ prefix.
+func (x TickerType) Name() string {
+	switch x {
+	case TickerType_Unknown:
+		return "Unknown"
+	case TickerType_Automatch:
+		return "Automatch"
+	case TickerType_Late:
+		return "Late"
+	case TickerType_NoneAutomatch:
+		return "NoneAutomatch"
+	case TickerType_InterAutomatch:
+		return "InterAutomatch"
+	case TickerType_InterNoneAutomatch:
+		return "InterNoneAutomatch"
+	case TickerType_OddLot:
+		return "OddLot"
+	case TickerType_Auction:
+		return "Auction"
+	case TickerType_Bulk:
+		return "Bulk"
+	case TickerType_Crash:
+		return "Crash"
+	case TickerType_CrossMarket:
+		return "CrossMarket"
+	case TickerType_BulkSold:
+		return "BulkSold"
+	case TickerType_FreeOnBoard:
+		return "FreeOnBoard"
+	case TickerType_Rule127Or155:
+		return "Rule127Or155"
+	case TickerType_Delay:
+		return "Delay"
+	case TickerType_MarketCenterClosePrice:
+		return "MarketCenterClosePrice"
+	case TickerType_NextDay:
+		return "NextDay"
+	case TickerType_MarketCenterOpening:
+		return "MarketCenterOpening"
+	case TickerType_PriorReferencePrice:
+		return "PriorReferencePrice"
+	case TickerType_MarketCenterOpenPrice:
+		return "MarketCenterOpenPrice"
+	case TickerType_Seller:
+		return "Seller"
+	case TickerType_T:
+		return "T"
+	case TickerType_ExtendedTradingHours:
+		return "ExtendedTradingHours"
+	case TickerType_Contingent:
+		return "Contingent"
+	case TickerType_AvgPrice:
+		return "AvgPrice"
+	case TickerType_OTCSold:
+		return "OTCSold"
+	case TickerType_OddLotCrossMarket:
+		return "OddLotCrossMarket"
+	case TickerType_DerivativelyPriced:
+		return "DerivativelyPriced"
+	case TickerType_ReOpeningPriced:
+		return "ReOpeningPriced"
+	case TickerType_ClosingPriced:
+		return "ClosingPriced"
+	case TickerType_ComprehensiveDelayPrice:
+		return "ComprehensiveDelayPrice"
+	case TickerType_Overseas:
+		return "Overseas"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TickerType = map[string]TickerType{
+	"auction":                           TickerType_Auction,
+	"automatch":                         TickerType_Automatch,
+	"avgprice":                          TickerType_AvgPrice,
+	"bulk":                              TickerType_Bulk,
+	"bulksold":                          TickerType_BulkSold,
+	"closingpriced":                     TickerType_ClosingPriced,
+	"comprehensivedelayprice":           TickerType_ComprehensiveDelayPrice,
+	"contingent":                        TickerType_Contingent,
+	"crash":                             TickerType_Crash,
+	"crossmarket":                       TickerType_CrossMarket,
+	"delay":                             TickerType_Delay,
+	"derivativelypriced":                TickerType_DerivativelyPriced,
+	"extendedtradinghours":              TickerType_ExtendedTradingHours,
+	"freeonboard":                       TickerType_FreeOnBoard,
+	"interautomatch":                    TickerType_InterAutomatch,
+	"internoneautomatch":                TickerType_InterNoneAutomatch,
+	"late":                              TickerType_Late,
+	"marketcentercloseprice":            TickerType_MarketCenterClosePrice,
+	"marketcenteropening":               TickerType_MarketCenterOpening,
+	"marketcenteropenprice":             TickerType_MarketCenterOpenPrice,
+	"nextday":                           TickerType_NextDay,
+	"noneautomatch":                     TickerType_NoneAutomatch,
+	"oddlot":                            TickerType_OddLot,
+	"oddlotcrossmarket":                 TickerType_OddLotCrossMarket,
+	"otcsold":                           TickerType_OTCSold,
+	"overseas":                          TickerType_Overseas,
+	"priorreferenceprice":               TickerType_PriorReferencePrice,
+	"reopeningpriced":                   TickerType_ReOpeningPriced,
+	"rule127or155":                      TickerType_Rule127Or155,
+	"seller":                            TickerType_Seller,
+	"t":                                 TickerType_T,
+	"tickerauction":                     TickerType_Auction,
+	"tickerautomatch":                   TickerType_Automatch,
+	"tickeravgprice":                    TickerType_AvgPrice,
+	"tickerbulk":                        TickerType_Bulk,
+	"tickerbulksold":                    TickerType_BulkSold,
+	"tickerclosingpriced":               TickerType_ClosingPriced,
+	"tickercomprehensivedelayprice":     TickerType_ComprehensiveDelayPrice,
+	"tickercontingent":                  TickerType_Contingent,
+	"tickercrash":                       TickerType_Crash,
+	"tickercrossmarket":                 TickerType_CrossMarket,
+	"tickerdelay":                       TickerType_Delay,
+	"tickerderivativelypriced":          TickerType_DerivativelyPriced,
+	"tickerextendedtradinghours":        TickerType_ExtendedTradingHours,
+	"tickerfreeonboard":                 TickerType_FreeOnBoard,
+	"tickerinterautomatch":              TickerType_InterAutomatch,
+	"tickerinternoneautomatch":          TickerType_InterNoneAutomatch,
+	"tickerlate":                        TickerType_Late,
+	"tickermarketcentercloseprice":      TickerType_MarketCenterClosePrice,
+	"tickermarketcenteropening":         TickerType_MarketCenterOpening,
+	"tickermarketcenteropenprice":       TickerType_MarketCenterOpenPrice,
+	"tickernextday":                     TickerType_NextDay,
+	"tickernoneautomatch":               TickerType_NoneAutomatch,
+	"tickeroddlot":                      TickerType_OddLot,
+	"tickeroddlotcrossmarket":           TickerType_OddLotCrossMarket,
+	"tickerotcsold":                     TickerType_OTCSold,
+	"tickeroverseas":                    TickerType_Overseas,
+	"tickerpriorreferenceprice":         TickerType_PriorReferencePrice,
+	"tickerreopeningpriced":             TickerType_ReOpeningPriced,
+	"tickerrule127or155":                TickerType_Rule127Or155,
+	"tickerseller":                      TickerType_Seller,
+	"tickert":                           TickerType_T,
+	"tickertypeauction":                 TickerType_Auction,
+	"tickertypeautomatch":               TickerType_Automatch,
+	"tickertypeavgprice":                TickerType_AvgPrice,
+	"tickertypebulk":                    TickerType_Bulk,
+	"tickertypebulksold":                TickerType_BulkSold,
+	"tickertypeclosingpriced":           TickerType_ClosingPriced,
+	"tickertypecomprehensivedelayprice": TickerType_ComprehensiveDelayPrice,
+	"tickertypecontingent":              TickerType_Contingent,
+	"tickertypecrash":                   TickerType_Crash,
+	"tickertypecrossmarket":             TickerType_CrossMarket,
+	"tickertypedelay":                   TickerType_Delay,
+	"tickertypederivativelypriced":      TickerType_DerivativelyPriced,
+	"tickertypeextendedtradinghours":    TickerType_ExtendedTradingHours,
+	"tickertypefreeonboard":             TickerType_FreeOnBoard,
+	"tickertypeinterautomatch":          TickerType_InterAutomatch,
+	"tickertypeinternoneautomatch":      TickerType_InterNoneAutomatch,
+	"tickertypelate":                    TickerType_Late,
+	"tickertypemarketcentercloseprice":  TickerType_MarketCenterClosePrice,
+	"tickertypemarketcenteropening":     TickerType_MarketCenterOpening,
+	"tickertypemarketcenteropenprice":   TickerType_MarketCenterOpenPrice,
+	"tickertypenextday":                 TickerType_NextDay,
+	"tickertypenoneautomatch":           TickerType_NoneAutomatch,
+	"tickertypeoddlot":                  TickerType_OddLot,
+	"tickertypeoddlotcrossmarket":       TickerType_OddLotCrossMarket,
+	"tickertypeotcsold":                 TickerType_OTCSold,
+	"tickertypeoverseas":                TickerType_Overseas,
+	"tickertypepriorreferenceprice":     TickerType_PriorReferencePrice,
+	"tickertypereopeningpriced":         TickerType_ReOpeningPriced,
+	"tickertyperule127or155":            TickerType_Rule127Or155,
+	"tickertypeseller":                  TickerType_Seller,
+	"tickertypet":                       TickerType_T,
+	"tickertypeunknown":                 TickerType_Unknown,
+	"tickerunknown":                     TickerType_Unknown,
+	"unknown":                           TickerType_Unknown,
+}
+
+// ParseTickerType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTickerType(s string) (TickerType, error) {
+	if v, ok := parseNames_TickerType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TickerType_name[int32(n)]; ok {
+			return TickerType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TickerType %q", ErrUnknownEnumName, s)
+}
+
+func (x TickerType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TickerType) UnmarshalText(b []byte) error {
+	v, err := ParseTickerType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TickerType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TimeFilterType_ prefix.
+func (x TimeFilterType) Name() string {
+	switch x {
+	case TimeFilterType_Unknown:
+		return "Unknown"
+	case TimeFilterType_Public:
+		return "Public"
+	case TimeFilterType_Effective:
+		return "Effective"
+	case TimeFilterType_End:
+		return "End"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TimeFilterType = map[string]TimeFilterType{
+	"effective":               TimeFilterType_Effective,
+	"end":                     TimeFilterType_End,
+	"public":                  TimeFilterType_Public,
+	"timefiltereffective":     TimeFilterType_Effective,
+	"timefilterend":           TimeFilterType_End,
+	"timefilterpublic":        TimeFilterType_Public,
+	"timefiltertypeeffective": TimeFilterType_Effective,
+	"timefiltertypeend":       TimeFilterType_End,
+	"timefiltertypepublic":    TimeFilterType_Public,
+	"timefiltertypeunknown":   TimeFilterType_Unknown,
+	"timefilterunknown":       TimeFilterType_Unknown,
+	"unknown":                 TimeFilterType_Unknown,
+}
+
+// ParseTimeFilterType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTimeFilterType(s string) (TimeFilterType, error) {
+	if v, ok := parseNames_TimeFilterType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TimeFilterType_name[int32(n)]; ok {
+			return TimeFilterType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TimeFilterType %q", ErrUnknownEnumName, s)
+}
+
+func (x TimeFilterType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TimeFilterType) UnmarshalText(b []byte) error {
+	v, err := ParseTimeFilterType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TimeFilterType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TimeInForce_ prefix.
+func (x TimeInForce) Name() string {
+	switch x {
+	case TimeInForce_DAY:
+		return "DAY"
+	case TimeInForce_GTC:
+		return "GTC"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TimeInForce = map[string]TimeInForce{
+	"day":            TimeInForce_DAY,
+	"gtc":            TimeInForce_GTC,
+	"timeinforceday": TimeInForce_DAY,
+	"timeinforcegtc": TimeInForce_GTC,
+}
+
+// ParseTimeInForce parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTimeInForce(s string) (TimeInForce, error) {
+	if v, ok := parseNames_TimeInForce[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TimeInForce_name[int32(n)]; ok {
+			return TimeInForce(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TimeInForce %q", ErrUnknownEnumName, s)
+}
+
+func (x TimeInForce) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TimeInForce) UnmarshalText(b []byte) error {
+	v, err := ParseTimeInForce(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TimeInForce) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TradeDateMarket_ prefix.
+func (x TradeDateMarket) Name() string {
+	switch x {
+	case TradeDateMarket_Unknown:
+		return "Unknown"
+	case TradeDateMarket_HK:
+		return "HK"
+	case TradeDateMarket_US:
+		return "US"
+	case TradeDateMarket_CN:
+		return "CN"
+	case TradeDateMarket_NT:
+		return "NT"
+	case TradeDateMarket_ST:
+		return "ST"
+	case TradeDateMarket_JP_Future:
+		return "JP_Future"
+	case TradeDateMarket_SG_Future:
+		return "SG_Future"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TradeDateMarket = map[string]TradeDateMarket{
+	"cn":                      TradeDateMarket_CN,
+	"hk":                      TradeDateMarket_HK,
+	"jpfuture":                TradeDateMarket_JP_Future,
+	"nt":                      TradeDateMarket_NT,
+	"sgfuture":                TradeDateMarket_SG_Future,
+	"st":                      TradeDateMarket_ST,
+	"tradedatemarketcn":       TradeDateMarket_CN,
+	"tradedatemarkethk":       TradeDateMarket_HK,
+	"tradedatemarketjpfuture": TradeDateMarket_JP_Future,
+	"tradedatemarketnt":       TradeDateMarket_NT,
+	"tradedatemarketsgfuture": TradeDateMarket_SG_Future,
+	"tradedatemarketst":       TradeDateMarket_ST,
+	"tradedatemarketunknown":  TradeDateMarket_Unknown,
+	"tradedatemarketus":       TradeDateMarket_US,
+	"unknown":                 TradeDateMarket_Unknown,
+	"us":                      TradeDateMarket_US,
+}
+
+// ParseTradeDateMarket parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTradeDateMarket(s string) (TradeDateMarket, error) {
+	if v, ok := parseNames_TradeDateMarket[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TradeDateMarket_name[int32(n)]; ok {
+			return TradeDateMarket(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TradeDateMarket %q", ErrUnknownEnumName, s)
+}
+
+func (x TradeDateMarket) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TradeDateMarket) UnmarshalText(b []byte) error {
+	v, err := ParseTradeDateMarket(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TradeDateMarket) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TradeDateType_ prefix.
+func (x TradeDateType) Name() string {
+	switch x {
+	case TradeDateType_Whole:
+		return "Whole"
+	case TradeDateType_Morning:
+		return "Morning"
+	case TradeDateType_Afternoon:
+		return "Afternoon"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TradeDateType = map[string]TradeDateType{
+	"afternoon":              TradeDateType_Afternoon,
+	"morning":                TradeDateType_Morning,
+	"tradedateafternoon":     TradeDateType_Afternoon,
+	"tradedatemorning":       TradeDateType_Morning,
+	"tradedatetypeafternoon": TradeDateType_Afternoon,
+	"tradedatetypemorning":   TradeDateType_Morning,
+	"tradedatetypewhole":     TradeDateType_Whole,
+	"tradedatewhole":         TradeDateType_Whole,
+	"whole":                  TradeDateType_Whole,
+}
+
+// ParseTradeDateType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTradeDateType(s string) (TradeDateType, error) {
+	if v, ok := parseNames_TradeDateType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TradeDateType_name[int32(n)]; ok {
+			return TradeDateType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TradeDateType %q", ErrUnknownEnumName, s)
+}
+
+func (x TradeDateType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TradeDateType) UnmarshalText(b []byte) error {
+	v, err := ParseTradeDateType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TradeDateType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrailType_ prefix.
+func (x TrailType) Name() string {
+	switch x {
+	case TrailType_Unknown:
+		return "Unknown"
+	case TrailType_Ratio:
+		return "Ratio"
+	case TrailType_Amount:
+		return "Amount"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrailType = map[string]TrailType{
+	"amount":           TrailType_Amount,
+	"ratio":            TrailType_Ratio,
+	"trailamount":      TrailType_Amount,
+	"trailratio":       TrailType_Ratio,
+	"trailtypeamount":  TrailType_Amount,
+	"trailtyperatio":   TrailType_Ratio,
+	"trailtypeunknown": TrailType_Unknown,
+	"trailunknown":     TrailType_Unknown,
+	"unknown":          TrailType_Unknown,
+}
+
+// ParseTrailType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrailType(s string) (TrailType, error) {
+	if v, ok := parseNames_TrailType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrailType_name[int32(n)]; ok {
+			return TrailType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrailType %q", ErrUnknownEnumName, s)
+}
+
+func (x TrailType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrailType) UnmarshalText(b []byte) error {
+	v, err := ParseTrailType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrailType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrdAccRole_ prefix.
+func (x TrdAccRole) Name() string {
+	switch x {
+	case TrdAccRole_Unknown:
+		return "Unknown"
+	case TrdAccRole_Normal:
+		return "Normal"
+	case TrdAccRole_Master:
+		return "Master"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrdAccRole = map[string]TrdAccRole{
+	"master":            TrdAccRole_Master,
+	"normal":            TrdAccRole_Normal,
+	"trdaccrolemaster":  TrdAccRole_Master,
+	"trdaccrolenormal":  TrdAccRole_Normal,
+	"trdaccroleunknown": TrdAccRole_Unknown,
+	"unknown":           TrdAccRole_Unknown,
+}
+
+// ParseTrdAccRole parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrdAccRole(s string) (TrdAccRole, error) {
+	if v, ok := parseNames_TrdAccRole[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrdAccRole_name[int32(n)]; ok {
+			return TrdAccRole(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrdAccRole %q", ErrUnknownEnumName, s)
+}
+
+func (x TrdAccRole) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrdAccRole) UnmarshalText(b []byte) error {
+	v, err := ParseTrdAccRole(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrdAccRole) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrdAccStatus_ prefix.
+func (x TrdAccStatus) Name() string {
+	switch x {
+	case TrdAccStatus_Active:
+		return "Active"
+	case TrdAccStatus_Disabled:
+		return "Disabled"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrdAccStatus = map[string]TrdAccStatus{
+	"active":               TrdAccStatus_Active,
+	"disabled":             TrdAccStatus_Disabled,
+	"trdaccstatusactive":   TrdAccStatus_Active,
+	"trdaccstatusdisabled": TrdAccStatus_Disabled,
+}
+
+// ParseTrdAccStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrdAccStatus(s string) (TrdAccStatus, error) {
+	if v, ok := parseNames_TrdAccStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrdAccStatus_name[int32(n)]; ok {
+			return TrdAccStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrdAccStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x TrdAccStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrdAccStatus) UnmarshalText(b []byte) error {
+	v, err := ParseTrdAccStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrdAccStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrdAccType_ prefix.
+func (x TrdAccType) Name() string {
+	switch x {
+	case TrdAccType_Unknown:
+		return "Unknown"
+	case TrdAccType_Cash:
+		return "Cash"
+	case TrdAccType_Margin:
+		return "Margin"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrdAccType = map[string]TrdAccType{
+	"cash":              TrdAccType_Cash,
+	"margin":            TrdAccType_Margin,
+	"trdacccash":        TrdAccType_Cash,
+	"trdaccmargin":      TrdAccType_Margin,
+	"trdacctypecash":    TrdAccType_Cash,
+	"trdacctypemargin":  TrdAccType_Margin,
+	"trdacctypeunknown": TrdAccType_Unknown,
+	"trdaccunknown":     TrdAccType_Unknown,
+	"unknown":           TrdAccType_Unknown,
+}
+
+// ParseTrdAccType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrdAccType(s string) (TrdAccType, error) {
+	if v, ok := parseNames_TrdAccType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrdAccType_name[int32(n)]; ok {
+			return TrdAccType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrdAccType %q", ErrUnknownEnumName, s)
+}
+
+func (x TrdAccType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrdAccType) UnmarshalText(b []byte) error {
+	v, err := ParseTrdAccType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrdAccType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrdCashFlowDirection_ prefix.
+func (x TrdCashFlowDirection) Name() string {
+	switch x {
+	case TrdCashFlowDirection_Unknown:
+		return "Unknown"
+	case TrdCashFlowDirection_In:
+		return "In"
+	case TrdCashFlowDirection_Out:
+		return "Out"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrdCashFlowDirection = map[string]TrdCashFlowDirection{
+	"in":                          TrdCashFlowDirection_In,
+	"out":                         TrdCashFlowDirection_Out,
+	"trdcashflowdirectionin":      TrdCashFlowDirection_In,
+	"trdcashflowdirectionout":     TrdCashFlowDirection_Out,
+	"trdcashflowdirectionunknown": TrdCashFlowDirection_Unknown,
+	"unknown":                     TrdCashFlowDirection_Unknown,
+}
+
+// ParseTrdCashFlowDirection parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrdCashFlowDirection(s string) (TrdCashFlowDirection, error) {
+	if v, ok := parseNames_TrdCashFlowDirection[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrdCashFlowDirection_name[int32(n)]; ok {
+			return TrdCashFlowDirection(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrdCashFlowDirection %q", ErrUnknownEnumName, s)
+}
+
+func (x TrdCashFlowDirection) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrdCashFlowDirection) UnmarshalText(b []byte) error {
+	v, err := ParseTrdCashFlowDirection(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrdCashFlowDirection) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrdCategory_ prefix.
+func (x TrdCategory) Name() string {
+	switch x {
+	case TrdCategory_Unknown:
+		return "Unknown"
+	case TrdCategory_Security:
+		return "Security"
+	case TrdCategory_Future:
+		return "Future"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrdCategory = map[string]TrdCategory{
+	"future":              TrdCategory_Future,
+	"security":            TrdCategory_Security,
+	"trdcategoryfuture":   TrdCategory_Future,
+	"trdcategorysecurity": TrdCategory_Security,
+	"trdcategoryunknown":  TrdCategory_Unknown,
+	"unknown":             TrdCategory_Unknown,
+}
+
+// ParseTrdCategory parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrdCategory(s string) (TrdCategory, error) {
+	if v, ok := parseNames_TrdCategory[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrdCategory_name[int32(n)]; ok {
+			return TrdCategory(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrdCategory %q", ErrUnknownEnumName, s)
+}
+
+func (x TrdCategory) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrdCategory) UnmarshalText(b []byte) error {
+	v, err := ParseTrdCategory(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrdCategory) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrdEnv_ prefix.
+func (x TrdEnv) Name() string {
+	switch x {
+	case TrdEnv_Simulate:
+		return "Simulate"
+	case TrdEnv_Real:
+		return "Real"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrdEnv = map[string]TrdEnv{
+	"real":           TrdEnv_Real,
+	"simulate":       TrdEnv_Simulate,
+	"trdenvreal":     TrdEnv_Real,
+	"trdenvsimulate": TrdEnv_Simulate,
+}
+
+// ParseTrdEnv parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrdEnv(s string) (TrdEnv, error) {
+	if v, ok := parseNames_TrdEnv[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrdEnv_name[int32(n)]; ok {
+			return TrdEnv(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrdEnv %q", ErrUnknownEnumName, s)
+}
+
+func (x TrdEnv) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrdEnv) UnmarshalText(b []byte) error {
+	v, err := ParseTrdEnv(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrdEnv) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrdMarket_ prefix.
+func (x TrdMarket) Name() string {
+	switch x {
+	case TrdMarket_Unknown:
+		return "Unknown"
+	case TrdMarket_HK:
+		return "HK"
+	case TrdMarket_US:
+		return "US"
+	case TrdMarket_CN:
+		return "CN"
+	case TrdMarket_HKCC:
+		return "HKCC"
+	case TrdMarket_Futures:
+		return "Futures"
+	case TrdMarket_SG:
+		return "SG"
+	case TrdMarket_AU:
+		return "AU"
+	case TrdMarket_Futures_Simulate_HK:
+		return "Futures_Simulate_HK"
+	case TrdMarket_Futures_Simulate_US:
+		return "Futures_Simulate_US"
+	case TrdMarket_Futures_Simulate_SG:
+		return "Futures_Simulate_SG"
+	case TrdMarket_Futures_Simulate_JP:
+		return "Futures_Simulate_JP"
+	case TrdMarket_JP:
+		return "JP"
+	case TrdMarket_MY:
+		return "MY"
+	case TrdMarket_CA:
+		return "CA"
+	case TrdMarket_HK_Fund:
+		return "HK_Fund"
+	case TrdMarket_US_Fund:
+		return "US_Fund"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrdMarket = map[string]TrdMarket{
+	"au":                         TrdMarket_AU,
+	"ca":                         TrdMarket_CA,
+	"cn":                         TrdMarket_CN,
+	"futures":                    TrdMarket_Futures,
+	"futuressimulatehk":          TrdMarket_Futures_Simulate_HK,
+	"futuressimulatejp":          TrdMarket_Futures_Simulate_JP,
+	"futuressimulatesg":          TrdMarket_Futures_Simulate_SG,
+	"futuressimulateus":          TrdMarket_Futures_Simulate_US,
+	"hk":                         TrdMarket_HK,
+	"hkcc":                       TrdMarket_HKCC,
+	"hkfund":                     TrdMarket_HK_Fund,
+	"jp":                         TrdMarket_JP,
+	"my":                         TrdMarket_MY,
+	"sg":                         TrdMarket_SG,
+	"trdmarketau":                TrdMarket_AU,
+	"trdmarketca":                TrdMarket_CA,
+	"trdmarketcn":                TrdMarket_CN,
+	"trdmarketfutures":           TrdMarket_Futures,
+	"trdmarketfuturessimulatehk": TrdMarket_Futures_Simulate_HK,
+	"trdmarketfuturessimulatejp": TrdMarket_Futures_Simulate_JP,
+	"trdmarketfuturessimulatesg": TrdMarket_Futures_Simulate_SG,
+	"trdmarketfuturessimulateus": TrdMarket_Futures_Simulate_US,
+	"trdmarkethk":                TrdMarket_HK,
+	"trdmarkethkcc":              TrdMarket_HKCC,
+	"trdmarkethkfund":            TrdMarket_HK_Fund,
+	"trdmarketjp":                TrdMarket_JP,
+	"trdmarketmy":                TrdMarket_MY,
+	"trdmarketsg":                TrdMarket_SG,
+	"trdmarketunknown":           TrdMarket_Unknown,
+	"trdmarketus":                TrdMarket_US,
+	"trdmarketusfund":            TrdMarket_US_Fund,
+	"unknown":                    TrdMarket_Unknown,
+	"us":                         TrdMarket_US,
+	"usfund":                     TrdMarket_US_Fund,
+}
+
+// ParseTrdMarket parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrdMarket(s string) (TrdMarket, error) {
+	if v, ok := parseNames_TrdMarket[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrdMarket_name[int32(n)]; ok {
+			return TrdMarket(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrdMarket %q", ErrUnknownEnumName, s)
+}
+
+func (x TrdMarket) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrdMarket) UnmarshalText(b []byte) error {
+	v, err := ParseTrdMarket(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrdMarket) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrdSecMarket_ prefix.
+func (x TrdSecMarket) Name() string {
+	switch x {
+	case TrdSecMarket_Unknown:
+		return "Unknown"
+	case TrdSecMarket_HK:
+		return "HK"
+	case TrdSecMarket_US:
+		return "US"
+	case TrdSecMarket_CN_SH:
+		return "CN_SH"
+	case TrdSecMarket_CN_SZ:
+		return "CN_SZ"
+	case TrdSecMarket_SG:
+		return "SG"
+	case TrdSecMarket_JP:
+		return "JP"
+	case TrdSecMarket_AU:
+		return "AU"
+	case TrdSecMarket_MY:
+		return "MY"
+	case TrdSecMarket_CA:
+		return "CA"
+	case TrdSecMarket_FX:
+		return "FX"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrdSecMarket = map[string]TrdSecMarket{
+	"au":                  TrdSecMarket_AU,
+	"ca":                  TrdSecMarket_CA,
+	"cnsh":                TrdSecMarket_CN_SH,
+	"cnsz":                TrdSecMarket_CN_SZ,
+	"fx":                  TrdSecMarket_FX,
+	"hk":                  TrdSecMarket_HK,
+	"jp":                  TrdSecMarket_JP,
+	"my":                  TrdSecMarket_MY,
+	"sg":                  TrdSecMarket_SG,
+	"trdsecmarketau":      TrdSecMarket_AU,
+	"trdsecmarketca":      TrdSecMarket_CA,
+	"trdsecmarketcnsh":    TrdSecMarket_CN_SH,
+	"trdsecmarketcnsz":    TrdSecMarket_CN_SZ,
+	"trdsecmarketfx":      TrdSecMarket_FX,
+	"trdsecmarkethk":      TrdSecMarket_HK,
+	"trdsecmarketjp":      TrdSecMarket_JP,
+	"trdsecmarketmy":      TrdSecMarket_MY,
+	"trdsecmarketsg":      TrdSecMarket_SG,
+	"trdsecmarketunknown": TrdSecMarket_Unknown,
+	"trdsecmarketus":      TrdSecMarket_US,
+	"unknown":             TrdSecMarket_Unknown,
+	"us":                  TrdSecMarket_US,
+}
+
+// ParseTrdSecMarket parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrdSecMarket(s string) (TrdSecMarket, error) {
+	if v, ok := parseNames_TrdSecMarket[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrdSecMarket_name[int32(n)]; ok {
+			return TrdSecMarket(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrdSecMarket %q", ErrUnknownEnumName, s)
+}
+
+func (x TrdSecMarket) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrdSecMarket) UnmarshalText(b []byte) error {
+	v, err := ParseTrdSecMarket(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrdSecMarket) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the TrdSide_ prefix.
+func (x TrdSide) Name() string {
+	switch x {
+	case TrdSide_Unknown:
+		return "Unknown"
+	case TrdSide_Buy:
+		return "Buy"
+	case TrdSide_Sell:
+		return "Sell"
+	case TrdSide_SellShort:
+		return "SellShort"
+	case TrdSide_BuyBack:
+		return "BuyBack"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_TrdSide = map[string]TrdSide{
+	"buy":              TrdSide_Buy,
+	"buyback":          TrdSide_BuyBack,
+	"sell":             TrdSide_Sell,
+	"sellshort":        TrdSide_SellShort,
+	"trdsidebuy":       TrdSide_Buy,
+	"trdsidebuyback":   TrdSide_BuyBack,
+	"trdsidesell":      TrdSide_Sell,
+	"trdsidesellshort": TrdSide_SellShort,
+	"trdsideunknown":   TrdSide_Unknown,
+	"unknown":          TrdSide_Unknown,
+}
+
+// ParseTrdSide parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseTrdSide(s string) (TrdSide, error) {
+	if v, ok := parseNames_TrdSide[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := TrdSide_name[int32(n)]; ok {
+			return TrdSide(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: TrdSide %q", ErrUnknownEnumName, s)
+}
+
+func (x TrdSide) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *TrdSide) UnmarshalText(b []byte) error {
+	v, err := ParseTrdSide(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *TrdSide) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the UpdateType_ prefix.
+func (x UpdateType) Name() string {
+	switch x {
+	case UpdateType_None:
+		return "None"
+	case UpdateType_Advice:
+		return "Advice"
+	case UpdateType_Force:
+		return "Force"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_UpdateType = map[string]UpdateType{
+	"advice":           UpdateType_Advice,
+	"force":            UpdateType_Force,
+	"none":             UpdateType_None,
+	"updateadvice":     UpdateType_Advice,
+	"updateforce":      UpdateType_Force,
+	"updatenone":       UpdateType_None,
+	"updatetypeadvice": UpdateType_Advice,
+	"updatetypeforce":  UpdateType_Force,
+	"updatetypenone":   UpdateType_None,
+}
+
+// ParseUpdateType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseUpdateType(s string) (UpdateType, error) {
+	if v, ok := parseNames_UpdateType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := UpdateType_name[int32(n)]; ok {
+			return UpdateType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: UpdateType %q", ErrUnknownEnumName, s)
+}
+
+func (x UpdateType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *UpdateType) UnmarshalText(b []byte) error {
+	v, err := ParseUpdateType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *UpdateType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the UserAttribution_ prefix.
+func (x UserAttribution) Name() string {
+	switch x {
+	case UserAttribution_Unknown:
+		return "Unknown"
+	case UserAttribution_NN:
+		return "NN"
+	case UserAttribution_MM:
+		return "MM"
+	case UserAttribution_SG:
+		return "SG"
+	case UserAttribution_AU:
+		return "AU"
+	case UserAttribution_JP:
+		return "JP"
+	case UserAttribution_HK:
+		return "HK"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_UserAttribution = map[string]UserAttribution{
+	"au":                     UserAttribution_AU,
+	"hk":                     UserAttribution_HK,
+	"jp":                     UserAttribution_JP,
+	"mm":                     UserAttribution_MM,
+	"nn":                     UserAttribution_NN,
+	"sg":                     UserAttribution_SG,
+	"unknown":                UserAttribution_Unknown,
+	"userattributionau":      UserAttribution_AU,
+	"userattributionhk":      UserAttribution_HK,
+	"userattributionjp":      UserAttribution_JP,
+	"userattributionmm":      UserAttribution_MM,
+	"userattributionnn":      UserAttribution_NN,
+	"userattributionsg":      UserAttribution_SG,
+	"userattributionunknown": UserAttribution_Unknown,
+}
+
+// ParseUserAttribution parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseUserAttribution(s string) (UserAttribution, error) {
+	if v, ok := parseNames_UserAttribution[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := UserAttribution_name[int32(n)]; ok {
+			return UserAttribution(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: UserAttribution %q", ErrUnknownEnumName, s)
+}
+
+func (x UserAttribution) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *UserAttribution) UnmarshalText(b []byte) error {
+	v, err := ParseUserAttribution(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *UserAttribution) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the UserInfoField_ prefix.
+func (x UserInfoField) Name() string {
+	switch x {
+	case UserInfoField_Basic:
+		return "Basic"
+	case UserInfoField_API:
+		return "API"
+	case UserInfoField_QotRight:
+		return "QotRight"
+	case UserInfoField_Disclaimer:
+		return "Disclaimer"
+	case UserInfoField_Update:
+		return "Update"
+	case UserInfoField_WebKey:
+		return "WebKey"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_UserInfoField = map[string]UserInfoField{
+	"api":                     UserInfoField_API,
+	"basic":                   UserInfoField_Basic,
+	"disclaimer":              UserInfoField_Disclaimer,
+	"qotright":                UserInfoField_QotRight,
+	"update":                  UserInfoField_Update,
+	"userinfofieldapi":        UserInfoField_API,
+	"userinfofieldbasic":      UserInfoField_Basic,
+	"userinfofielddisclaimer": UserInfoField_Disclaimer,
+	"userinfofieldqotright":   UserInfoField_QotRight,
+	"userinfofieldupdate":     UserInfoField_Update,
+	"userinfofieldwebkey":     UserInfoField_WebKey,
+	"webkey":                  UserInfoField_WebKey,
+}
+
+// ParseUserInfoField parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseUserInfoField(s string) (UserInfoField, error) {
+	if v, ok := parseNames_UserInfoField[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := UserInfoField_name[int32(n)]; ok {
+			return UserInfoField(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: UserInfoField %q", ErrUnknownEnumName, s)
+}
+
+func (x UserInfoField) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *UserInfoField) UnmarshalText(b []byte) error {
+	v, err := ParseUserInfoField(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *UserInfoField) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the VerificationOp_ prefix.
+func (x VerificationOp) Name() string {
+	switch x {
+	case VerificationOp_Unknown:
+		return "Unknown"
+	case VerificationOp_Request:
+		return "Request"
+	case VerificationOp_InputAndLogin:
+		return "InputAndLogin"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_VerificationOp = map[string]VerificationOp{
+	"inputandlogin":               VerificationOp_InputAndLogin,
+	"request":                     VerificationOp_Request,
+	"unknown":                     VerificationOp_Unknown,
+	"verificationopinputandlogin": VerificationOp_InputAndLogin,
+	"verificationoprequest":       VerificationOp_Request,
+	"verificationopunknown":       VerificationOp_Unknown,
+}
+
+// ParseVerificationOp parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseVerificationOp(s string) (VerificationOp, error) {
+	if v, ok := parseNames_VerificationOp[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := VerificationOp_name[int32(n)]; ok {
+			return VerificationOp(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: VerificationOp %q", ErrUnknownEnumName, s)
+}
+
+func (x VerificationOp) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *VerificationOp) UnmarshalText(b []byte) error {
+	v, err := ParseVerificationOp(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *VerificationOp) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the VerificationType_ prefix.
+func (x VerificationType) Name() string {
+	switch x {
+	case VerificationType_Unknown:
+		return "Unknown"
+	case VerificationType_Picture:
+		return "Picture"
+	case VerificationType_Phone:
+		return "Phone"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_VerificationType = map[string]VerificationType{
+	"phone":                   VerificationType_Phone,
+	"picture":                 VerificationType_Picture,
+	"unknown":                 VerificationType_Unknown,
+	"verificationphone":       VerificationType_Phone,
+	"verificationpicture":     VerificationType_Picture,
+	"verificationtypephone":   VerificationType_Phone,
+	"verificationtypepicture": VerificationType_Picture,
+	"verificationtypeunknown": VerificationType_Unknown,
+	"verificationunknown":     VerificationType_Unknown,
+}
+
+// ParseVerificationType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseVerificationType(s string) (VerificationType, error) {
+	if v, ok := parseNames_VerificationType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := VerificationType_name[int32(n)]; ok {
+			return VerificationType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: VerificationType %q", ErrUnknownEnumName, s)
+}
+
+func (x VerificationType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *VerificationType) UnmarshalText(b []byte) error {
+	v, err := ParseVerificationType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *VerificationType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the WarrantStatus_ prefix.
+func (x WarrantStatus) Name() string {
+	switch x {
+	case WarrantStatus_Unknown:
+		return "Unknown"
+	case WarrantStatus_Normal:
+		return "Normal"
+	case WarrantStatus_Suspend:
+		return "Suspend"
+	case WarrantStatus_StopTrade:
+		return "StopTrade"
+	case WarrantStatus_PendingListing:
+		return "PendingListing"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_WarrantStatus = map[string]WarrantStatus{
+	"normal":                      WarrantStatus_Normal,
+	"pendinglisting":              WarrantStatus_PendingListing,
+	"stoptrade":                   WarrantStatus_StopTrade,
+	"suspend":                     WarrantStatus_Suspend,
+	"unknown":                     WarrantStatus_Unknown,
+	"warrantstatusnormal":         WarrantStatus_Normal,
+	"warrantstatuspendinglisting": WarrantStatus_PendingListing,
+	"warrantstatusstoptrade":      WarrantStatus_StopTrade,
+	"warrantstatussuspend":        WarrantStatus_Suspend,
+	"warrantstatusunknown":        WarrantStatus_Unknown,
+}
+
+// ParseWarrantStatus parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseWarrantStatus(s string) (WarrantStatus, error) {
+	if v, ok := parseNames_WarrantStatus[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := WarrantStatus_name[int32(n)]; ok {
+			return WarrantStatus(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: WarrantStatus %q", ErrUnknownEnumName, s)
+}
+
+func (x WarrantStatus) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *WarrantStatus) UnmarshalText(b []byte) error {
+	v, err := ParseWarrantStatus(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *WarrantStatus) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
+
+// Name returns the value name without the WarrantType_ prefix.
+func (x WarrantType) Name() string {
+	switch x {
+	case WarrantType_Unknown:
+		return "Unknown"
+	case WarrantType_Buy:
+		return "Buy"
+	case WarrantType_Sell:
+		return "Sell"
+	case WarrantType_Bull:
+		return "Bull"
+	case WarrantType_Bear:
+		return "Bear"
+	case WarrantType_InLine:
+		return "InLine"
+	}
+	return strconv.Itoa(int(x))
+}
+
+var parseNames_WarrantType = map[string]WarrantType{
+	"bear":               WarrantType_Bear,
+	"bull":               WarrantType_Bull,
+	"buy":                WarrantType_Buy,
+	"inline":             WarrantType_InLine,
+	"sell":               WarrantType_Sell,
+	"unknown":            WarrantType_Unknown,
+	"warrantbear":        WarrantType_Bear,
+	"warrantbull":        WarrantType_Bull,
+	"warrantbuy":         WarrantType_Buy,
+	"warrantinline":      WarrantType_InLine,
+	"warrantsell":        WarrantType_Sell,
+	"warranttypebear":    WarrantType_Bear,
+	"warranttypebull":    WarrantType_Bull,
+	"warranttypebuy":     WarrantType_Buy,
+	"warranttypeinline":  WarrantType_InLine,
+	"warranttypesell":    WarrantType_Sell,
+	"warranttypeunknown": WarrantType_Unknown,
+	"warrantunknown":     WarrantType_Unknown,
+}
+
+// ParseWarrantType parses short or full names, case and underscore insensitive,
+// or numbers of defined values.
+func ParseWarrantType(s string) (WarrantType, error) {
+	if v, ok := parseNames_WarrantType[normEnumName(s)]; ok {
+		return v, nil
+	}
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		if _, ok := WarrantType_name[int32(n)]; ok {
+			return WarrantType(n), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: WarrantType %q", ErrUnknownEnumName, s)
+}
+
+func (x WarrantType) MarshalText() ([]byte, error) {
+	return []byte(x.Name()), nil
+}
+
+func (x *WarrantType) UnmarshalText(b []byte) error {
+	v, err := ParseWarrantType(string(b))
+	if err != nil {
+		return err
+	}
+	*x = v
+	return nil
+}
+
+// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+func (x *WarrantType) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	s, err := enumJSONText(b)
+	if err != nil {
+		return err
+	}
+	return x.UnmarshalText([]byte(s))
+}
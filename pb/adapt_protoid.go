@@ -14,6 +14,7 @@ const (
 	ProtoId_GetDelayStatistics         ProtoId = 1007
 	ProtoId_TestCmd                    ProtoId = 1008
 	ProtoId_InitQuantMode              ProtoId = 1009
+	ProtoId_UsedQuota                  ProtoId = 1010
 	ProtoId_TrdGetAccList              ProtoId = 2001
 	ProtoId_TrdUnlockTrade             ProtoId = 2005
 	ProtoId_TrdSubAccPush              ProtoId = 2008
@@ -24,6 +25,7 @@ const (
 	ProtoId_TrdPlaceOrder              ProtoId = 2202
 	ProtoId_TrdModifyOrder             ProtoId = 2205
 	ProtoId_TrdUpdateOrder             ProtoId = 2208
+	ProtoId_TrdReconfirmOrder          ProtoId = 2209
 	ProtoId_TrdGetOrderFillList        ProtoId = 2211
 	ProtoId_TrdUpdateOrderFill         ProtoId = 2218
 	ProtoId_TrdGetHistoryOrderList     ProtoId = 2221
@@ -97,6 +99,8 @@ func (id ProtoId) String() string {
 		return "ProtoId_TestCmd"
 	case ProtoId_InitQuantMode:
 		return "ProtoId_InitQuantMode"
+	case ProtoId_UsedQuota:
+		return "ProtoId_UsedQuota"
 	case ProtoId_TrdGetAccList:
 		return "ProtoId_TrdGetAccList"
 	case ProtoId_TrdUnlockTrade:
@@ -117,6 +121,8 @@ func (id ProtoId) String() string {
 		return "ProtoId_TrdModifyOrder"
 	case ProtoId_TrdUpdateOrder:
 		return "ProtoId_TrdUpdateOrder"
+	case ProtoId_TrdReconfirmOrder:
+		return "ProtoId_TrdReconfirmOrder"
 	case ProtoId_TrdGetOrderFillList:
 		return "ProtoId_TrdGetOrderFillList"
 	case ProtoId_TrdUpdateOrderFill:
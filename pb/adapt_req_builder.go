@@ -14,6 +14,10 @@ type HeaderSetter interface {
 	SetHeader(*TrdHeader)
 }
 
+type HeaderGetter interface {
+	GetHeader() *TrdHeader
+}
+
 // AccumulateFilter
 // 累积属性筛选
 
@@ -77,6 +81,14 @@ func (s *AccumulateFilter) SetSortDir(o SortDir) {
 	s.SortDir = o.Enum()
 }
 
+// NewAccumulateFilter creates a AccumulateFilter with required fields.
+func NewAccumulateFilter(fieldName AccumulateField, days int32) *AccumulateFilter {
+	return &AccumulateFilter{
+		FieldName: fieldName.Enum(),
+		Days:      &days,
+	}
+}
+
 // BaseFilter
 // 简单属性筛选
 
@@ -130,6 +142,13 @@ func (s *BaseFilter) SetSortDir(o SortDir) {
 	s.SortDir = o.Enum()
 }
 
+// NewBaseFilter creates a BaseFilter with required fields.
+func NewBaseFilter(fieldName StockField) *BaseFilter {
+	return &BaseFilter{
+		FieldName: fieldName.Enum(),
+	}
+}
+
 // CustomIndicatorFilter
 // 自定义技术指标属性筛选
 
@@ -173,6 +192,11 @@ func (s *CustomIndicatorFilter) SetFirstFieldParaList(o ...int32) {
 	s.FirstFieldParaList = o
 }
 
+func (s *CustomIndicatorFilter) AddFirstFieldPara(o ...int32) *CustomIndicatorFilter {
+	s.FirstFieldParaList = append(s.FirstFieldParaList, o...)
+	return s
+}
+
 // 该字段是否不需要筛选，True代表不筛选，False代表筛选。不传默认为不筛选
 func (s *CustomIndicatorFilter) WithIsNoFilter(o bool) *CustomIndicatorFilter {
 	s.IsNoFilter = &o
@@ -223,6 +247,21 @@ func (s *CustomIndicatorFilter) SetSecondFieldParaList(o ...int32) {
 	s.SecondFieldParaList = o
 }
 
+func (s *CustomIndicatorFilter) AddSecondFieldPara(o ...int32) *CustomIndicatorFilter {
+	s.SecondFieldParaList = append(s.SecondFieldParaList, o...)
+	return s
+}
+
+// NewCustomIndicatorFilter creates a CustomIndicatorFilter with required fields.
+func NewCustomIndicatorFilter(firstFieldName CustomIndicatorField, secondFieldName CustomIndicatorField, relativePosition RelativePosition, klType KLType) *CustomIndicatorFilter {
+	return &CustomIndicatorFilter{
+		FirstFieldName:   firstFieldName.Enum(),
+		SecondFieldName:  secondFieldName.Enum(),
+		RelativePosition: relativePosition.Enum(),
+		KlType:           klType.Enum(),
+	}
+}
+
 // DataFilter
 //以下为数据字段筛选，可选字段，不填表示不过滤
 
@@ -469,6 +508,14 @@ func (s *FinancialFilter) SetSortDir(o SortDir) {
 	s.SortDir = o.Enum()
 }
 
+// NewFinancialFilter creates a FinancialFilter with required fields.
+func NewFinancialFilter(fieldName FinancialField, quarter FinancialQuarter) *FinancialFilter {
+	return &FinancialFilter{
+		FieldName: fieldName.Enum(),
+		Quarter:   quarter.Enum(),
+	}
+}
+
 // GetDelayStatisticsRequest
 
 // 行情推送统计的区间，行情推送统计时有效，QotPushStage
@@ -491,6 +538,11 @@ func (s *GetDelayStatisticsRequest) SetSegmentList(o ...int32) {
 	s.SegmentList = o
 }
 
+func (s *GetDelayStatisticsRequest) AddSegment(o ...int32) *GetDelayStatisticsRequest {
+	s.SegmentList = append(s.SegmentList, o...)
+	return s
+}
+
 // 统计数据类型，DelayStatisticsType
 func (s *GetDelayStatisticsRequest) WithTypeList(o ...DelayStatisticsType) *GetDelayStatisticsRequest {
 	s.TypeList = o
@@ -501,6 +553,11 @@ func (s *GetDelayStatisticsRequest) SetTypeList(o ...DelayStatisticsType) {
 	s.TypeList = o
 }
 
+func (s *GetDelayStatisticsRequest) AddType(o ...DelayStatisticsType) *GetDelayStatisticsRequest {
+	s.TypeList = append(s.TypeList, o...)
+	return s
+}
+
 // GetGlobalStateRequest
 
 // 历史原因，目前已废弃，填0即可
@@ -622,6 +679,14 @@ func (s *PacketID) SetSerialNo(o uint32) {
 	s.SerialNo = &o
 }
 
+// NewPacketID creates a PacketID with required fields.
+func NewPacketID(connID uint64, serialNo uint32) *PacketID {
+	return &PacketID{
+		ConnID:   &connID,
+		SerialNo: &serialNo,
+	}
+}
+
 // PatternFilter
 // 形态技术指标属性筛选
 
@@ -665,6 +730,14 @@ func (s *PatternFilter) SetKlType(o KLType) {
 	s.KlType = o.Enum()
 }
 
+// NewPatternFilter creates a PatternFilter with required fields.
+func NewPatternFilter(fieldName PatternField, klType KLType) *PatternFilter {
+	return &PatternFilter{
+		FieldName: fieldName.Enum(),
+		KlType:    klType.Enum(),
+	}
+}
+
 // QotGetBasicQotRequest
 
 // 股票
@@ -677,6 +750,11 @@ func (s *QotGetBasicQotRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetBasicQotRequest) AddSecurity(o ...*Security) *QotGetBasicQotRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // QotGetBrokerRequest
 
 // 股票
@@ -765,6 +843,11 @@ func (s *QotGetCodeChangeRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetCodeChangeRequest) AddSecurity(o ...*Security) *QotGetCodeChangeRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // 根据时间筛选
 func (s *QotGetCodeChangeRequest) WithTimeFilterList(o ...*TimeFilter) *QotGetCodeChangeRequest {
 	s.TimeFilterList = o
@@ -775,6 +858,11 @@ func (s *QotGetCodeChangeRequest) SetTimeFilterList(o ...*TimeFilter) {
 	s.TimeFilterList = o
 }
 
+func (s *QotGetCodeChangeRequest) AddTimeFilter(o ...*TimeFilter) *QotGetCodeChangeRequest {
+	s.TimeFilterList = append(s.TimeFilterList, o...)
+	return s
+}
+
 // CodeChangeType，根据类型筛选
 func (s *QotGetCodeChangeRequest) WithTypeList(o ...CodeChangeType) *QotGetCodeChangeRequest {
 	s.TypeList = o
@@ -785,6 +873,11 @@ func (s *QotGetCodeChangeRequest) SetTypeList(o ...CodeChangeType) {
 	s.TypeList = o
 }
 
+func (s *QotGetCodeChangeRequest) AddType(o ...CodeChangeType) *QotGetCodeChangeRequest {
+	s.TypeList = append(s.TypeList, o...)
+	return s
+}
+
 // QotGetFutureInfoRequest
 
 // 股票列表
@@ -797,6 +890,11 @@ func (s *QotGetFutureInfoRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetFutureInfoRequest) AddSecurity(o ...*Security) *QotGetFutureInfoRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // QotGetHistoryKLPointsRequest
 
 // Qot_Common.KLType,K线类型
@@ -859,6 +957,11 @@ func (s *QotGetHistoryKLPointsRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetHistoryKLPointsRequest) AddSecurity(o ...*Security) *QotGetHistoryKLPointsRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // 时间字符串
 func (s *QotGetHistoryKLPointsRequest) WithTimeList(o ...string) *QotGetHistoryKLPointsRequest {
 	s.TimeList = o
@@ -869,6 +972,11 @@ func (s *QotGetHistoryKLPointsRequest) SetTimeList(o ...string) {
 	s.TimeList = o
 }
 
+func (s *QotGetHistoryKLPointsRequest) AddTime(o ...string) *QotGetHistoryKLPointsRequest {
+	s.TimeList = append(s.TimeList, o...)
+	return s
+}
+
 // QotGetHistoryKLRequest
 
 // 开始时间字符串
@@ -1049,6 +1157,11 @@ func (s *QotGetMarketStateRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetMarketStateRequest) AddSecurity(o ...*Security) *QotGetMarketStateRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // QotGetOptionChainRequest
 
 // 期权到期日开始时间
@@ -1177,6 +1290,11 @@ func (s *QotGetOwnerPlateRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetOwnerPlateRequest) AddSecurity(o ...*Security) *QotGetOwnerPlateRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // QotGetPlateSecurityRequest
 
 // 升序ture, 降序false, 不填默认升序
@@ -1299,6 +1417,11 @@ func (s *QotGetRehabRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetRehabRequest) AddSecurity(o ...*Security) *QotGetRehabRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // QotGetSecuritySnapshotRequest
 
 // 股票
@@ -1311,6 +1434,11 @@ func (s *QotGetSecuritySnapshotRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetSecuritySnapshotRequest) AddSecurity(o ...*Security) *QotGetSecuritySnapshotRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // QotGetStaticInfoRequest
 
 // Qot_Common.QotMarket,股票市场
@@ -1343,6 +1471,11 @@ func (s *QotGetStaticInfoRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetStaticInfoRequest) AddSecurity(o ...*Security) *QotGetStaticInfoRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // QotGetSubInfoRequest
 
 // 是否返回所有连接的订阅状态,不传或者传false只返回当前连接数据
@@ -1387,6 +1520,11 @@ func (s *QotGetSuspendRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotGetSuspendRequest) AddSecurity(o ...*Security) *QotGetSuspendRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // QotGetTickerRequest
 
 // 最多返回的逐笔个数,实际返回数量不一定会返回这么多,最多返回1000个
@@ -1555,6 +1693,11 @@ func (s *QotGetWarrantRequest) SetIssuerList(o ...Issuer) {
 	s.IssuerList = o
 }
 
+func (s *QotGetWarrantRequest) AddIssuer(o ...Issuer) *QotGetWarrantRequest {
+	s.IssuerList = append(s.IssuerList, o...)
+	return s
+}
+
 // 杠杆比率的过滤上限（闭区间），不传代表上限为 +∞（精确到小数点后 3 位，超出部分会被舍弃）
 func (s *QotGetWarrantRequest) WithLeverageRatioMax(o float64) *QotGetWarrantRequest {
 	s.LeverageRatioMax = &o
@@ -1755,6 +1898,11 @@ func (s *QotGetWarrantRequest) SetTypeList(o ...WarrantType) {
 	s.TypeList = o
 }
 
+func (s *QotGetWarrantRequest) AddType(o ...WarrantType) *QotGetWarrantRequest {
+	s.TypeList = append(s.TypeList, o...)
+	return s
+}
+
 // 成交量的过滤上限（闭区间），不传代表上限为 +∞
 func (s *QotGetWarrantRequest) WithVolMax(o uint64) *QotGetWarrantRequest {
 	s.VolMax = &o
@@ -1807,6 +1955,11 @@ func (s *QotModifyUserSecurityRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotModifyUserSecurityRequest) AddSecurity(o ...*Security) *QotModifyUserSecurityRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // QotRegQotPushRequest
 
 // 注册后如果本地已有数据是否首推一次已存在数据,该参数不指定则默认true
@@ -1839,6 +1992,11 @@ func (s *QotRegQotPushRequest) SetRehabTypeList(o ...RehabType) {
 	s.RehabTypeList = o
 }
 
+func (s *QotRegQotPushRequest) AddRehabType(o ...RehabType) *QotRegQotPushRequest {
+	s.RehabTypeList = append(s.RehabTypeList, o...)
+	return s
+}
+
 // 股票
 func (s *QotRegQotPushRequest) WithSecurityList(o ...*Security) *QotRegQotPushRequest {
 	s.SecurityList = o
@@ -1849,6 +2007,11 @@ func (s *QotRegQotPushRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotRegQotPushRequest) AddSecurity(o ...*Security) *QotRegQotPushRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // Qot_Common.SubType,要注册到该连接的订阅类型
 func (s *QotRegQotPushRequest) WithSubTypeList(o ...SubType) *QotRegQotPushRequest {
 	s.SubTypeList = o
@@ -1859,6 +2022,11 @@ func (s *QotRegQotPushRequest) SetSubTypeList(o ...SubType) {
 	s.SubTypeList = o
 }
 
+func (s *QotRegQotPushRequest) AddSubType(o ...SubType) *QotRegQotPushRequest {
+	s.SubTypeList = append(s.SubTypeList, o...)
+	return s
+}
+
 // QotRequestHistoryKLQuotaRequest
 
 // 是否返回详细拉取过的历史纪录
@@ -2079,6 +2247,11 @@ func (s *QotSetPriceReminderRequest) SetReminderSessionList(o ...PriceReminderMa
 	s.ReminderSessionList = o
 }
 
+func (s *QotSetPriceReminderRequest) AddReminderSession(o ...PriceReminderMarketStatus) *QotSetPriceReminderRequest {
+	s.ReminderSessionList = append(s.ReminderSessionList, o...)
+	return s
+}
+
 // 股票
 func (s *QotSetPriceReminderRequest) WithSecurity(o *Security) *QotSetPriceReminderRequest {
 	s.Security = o
@@ -2121,6 +2294,11 @@ func (s *QotStockFilterRequest) SetAccumulateFilterList(o ...*AccumulateFilter)
 	s.AccumulateFilterList = o
 }
 
+func (s *QotStockFilterRequest) AddAccumulateFilter(o ...*AccumulateFilter) *QotStockFilterRequest {
+	s.AccumulateFilterList = append(s.AccumulateFilterList, o...)
+	return s
+}
+
 // 简单指标过滤器
 func (s *QotStockFilterRequest) WithBaseFilterList(o ...*BaseFilter) *QotStockFilterRequest {
 	s.BaseFilterList = o
@@ -2131,6 +2309,11 @@ func (s *QotStockFilterRequest) SetBaseFilterList(o ...*BaseFilter) {
 	s.BaseFilterList = o
 }
 
+func (s *QotStockFilterRequest) AddBaseFilter(o ...*BaseFilter) *QotStockFilterRequest {
+	s.BaseFilterList = append(s.BaseFilterList, o...)
+	return s
+}
+
 // 数据起始点
 func (s *QotStockFilterRequest) WithBegin(o int32) *QotStockFilterRequest {
 	s.Begin = &o
@@ -2151,6 +2334,11 @@ func (s *QotStockFilterRequest) SetCustomIndicatorFilterList(o ...*CustomIndicat
 	s.CustomIndicatorFilterList = o
 }
 
+func (s *QotStockFilterRequest) AddCustomIndicatorFilter(o ...*CustomIndicatorFilter) *QotStockFilterRequest {
+	s.CustomIndicatorFilterList = append(s.CustomIndicatorFilterList, o...)
+	return s
+}
+
 // 财务指标过滤器
 func (s *QotStockFilterRequest) WithFinancialFilterList(o ...*FinancialFilter) *QotStockFilterRequest {
 	s.FinancialFilterList = o
@@ -2161,6 +2349,11 @@ func (s *QotStockFilterRequest) SetFinancialFilterList(o ...*FinancialFilter) {
 	s.FinancialFilterList = o
 }
 
+func (s *QotStockFilterRequest) AddFinancialFilter(o ...*FinancialFilter) *QotStockFilterRequest {
+	s.FinancialFilterList = append(s.FinancialFilterList, o...)
+	return s
+}
+
 // Qot_Common::QotMarket股票市场，支持沪股和深股，且沪股和深股不做区分都代表A股市场。
 func (s *QotStockFilterRequest) WithMarket(o QotMarket) *QotStockFilterRequest {
 	s.Market = o.Enum()
@@ -2191,6 +2384,11 @@ func (s *QotStockFilterRequest) SetPatternFilterList(o ...*PatternFilter) {
 	s.PatternFilterList = o
 }
 
+func (s *QotStockFilterRequest) AddPatternFilter(o ...*PatternFilter) *QotStockFilterRequest {
+	s.PatternFilterList = append(s.PatternFilterList, o...)
+	return s
+}
+
 // 板块
 func (s *QotStockFilterRequest) WithPlate(o *Security) *QotStockFilterRequest {
 	s.Plate = o
@@ -2273,6 +2471,11 @@ func (s *QotSubRequest) SetRegPushRehabTypeList(o ...RehabType) {
 	s.RegPushRehabTypeList = o
 }
 
+func (s *QotSubRequest) AddRegPushRehabType(o ...RehabType) *QotSubRequest {
+	s.RegPushRehabTypeList = append(s.RegPushRehabTypeList, o...)
+	return s
+}
+
 // 股票
 func (s *QotSubRequest) WithSecurityList(o ...*Security) *QotSubRequest {
 	s.SecurityList = o
@@ -2283,6 +2486,11 @@ func (s *QotSubRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *QotSubRequest) AddSecurity(o ...*Security) *QotSubRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // 时段 Session
 func (s *QotSubRequest) WithSession(o Session) *QotSubRequest {
 	s.Session = o.Enum()
@@ -2303,6 +2511,11 @@ func (s *QotSubRequest) SetSubTypeList(o ...SubType) {
 	s.SubTypeList = o
 }
 
+func (s *QotSubRequest) AddSubType(o ...SubType) *QotSubRequest {
+	s.SubTypeList = append(s.SubTypeList, o...)
+	return s
+}
+
 // Security
 //两个字段确定一支股票
 
@@ -2326,6 +2539,14 @@ func (s *Security) SetMarket(o QotMarket) {
 	s.Market = o.Enum()
 }
 
+// NewSecurity creates a Security with required fields.
+func NewSecurity(market QotMarket, code string) *Security {
+	return &Security{
+		Market: market.Enum(),
+		Code:   &code,
+	}
+}
+
 // TestCmdRequest
 //内部使用
 
@@ -2379,6 +2600,13 @@ func (s *TimeFilter) SetType(o TimeFilterType) {
 	s.Type = o.Enum()
 }
 
+// NewTimeFilter creates a TimeFilter with required fields.
+func NewTimeFilter(typ TimeFilterType) *TimeFilter {
+	return &TimeFilter{
+		Type: typ.Enum(),
+	}
+}
+
 // TrdFilterConditions
 //过滤条件，条件组合是"与"不是"或"，用于获取订单、成交、持仓等时二次过滤
 
@@ -2402,6 +2630,11 @@ func (s *TrdFilterConditions) SetCodeList(o ...string) {
 	s.CodeList = o
 }
 
+func (s *TrdFilterConditions) AddCode(o ...string) *TrdFilterConditions {
+	s.CodeList = append(s.CodeList, o...)
+	return s
+}
+
 // 结束时间，严格按YYYY-MM-DD HH:MM:SS或YYYY-MM-DD HH:MM:SS.MS格式传，对持仓无效，拉历史数据必须填
 func (s *TrdFilterConditions) WithEndTime(o string) *TrdFilterConditions {
 	s.EndTime = &o
@@ -2432,6 +2665,11 @@ func (s *TrdFilterConditions) SetIdList(o ...uint64) {
 	s.IdList = o
 }
 
+func (s *TrdFilterConditions) AddId(o ...uint64) *TrdFilterConditions {
+	s.IdList = append(s.IdList, o...)
+	return s
+}
+
 // 服务器订单ID，可以用来替代orderID，二选一
 func (s *TrdFilterConditions) WithOrderIDExList(o ...string) *TrdFilterConditions {
 	s.OrderIDExList = o
@@ -2442,6 +2680,11 @@ func (s *TrdFilterConditions) SetOrderIDExList(o ...string) {
 	s.OrderIDExList = o
 }
 
+func (s *TrdFilterConditions) AddOrderIDEx(o ...string) *TrdFilterConditions {
+	s.OrderIDExList = append(s.OrderIDExList, o...)
+	return s
+}
+
 // TrdFlowSummaryRequest
 
 // 现金流方向 TrdCashFlowDirection
@@ -2582,6 +2825,11 @@ func (s *TrdGetHistoryOrderListRequest) SetFilterStatusList(o ...OrderStatus) {
 	s.FilterStatusList = o
 }
 
+func (s *TrdGetHistoryOrderListRequest) AddFilterStatus(o ...OrderStatus) *TrdGetHistoryOrderListRequest {
+	s.FilterStatusList = append(s.FilterStatusList, o...)
+	return s
+}
+
 // 交易公共参数头
 func (s *TrdGetHistoryOrderListRequest) WithHeader(o *TrdHeader) *TrdGetHistoryOrderListRequest {
 	s.Header = o
@@ -2614,6 +2862,11 @@ func (s *TrdGetMarginRatioRequest) SetSecurityList(o ...*Security) {
 	s.SecurityList = o
 }
 
+func (s *TrdGetMarginRatioRequest) AddSecurity(o ...*Security) *TrdGetMarginRatioRequest {
+	s.SecurityList = append(s.SecurityList, o...)
+	return s
+}
+
 // TrdGetMaxTrdQtysRequest
 
 // 是否调整价格，如果价格不合法，是否调整到合法价位，true调整，false不调整
@@ -2738,6 +2991,11 @@ func (s *TrdGetOrderFeeRequest) SetOrderIdExList(o ...string) {
 	s.OrderIdExList = o
 }
 
+func (s *TrdGetOrderFeeRequest) AddOrderIdEx(o ...string) *TrdGetOrderFeeRequest {
+	s.OrderIdExList = append(s.OrderIdExList, o...)
+	return s
+}
+
 // TrdGetOrderFillListRequest
 
 // 过滤条件
@@ -2792,6 +3050,11 @@ func (s *TrdGetOrderListRequest) SetFilterStatusList(o ...OrderStatus) {
 	s.FilterStatusList = o
 }
 
+func (s *TrdGetOrderListRequest) AddFilterStatus(o ...OrderStatus) *TrdGetOrderListRequest {
+	s.FilterStatusList = append(s.FilterStatusList, o...)
+	return s
+}
+
 // 交易公共参数头
 func (s *TrdGetOrderListRequest) WithHeader(o *TrdHeader) *TrdGetOrderListRequest {
 	s.Header = o
@@ -2897,6 +3160,15 @@ func (s *TrdHeader) SetMarket(o TrdMarket) {
 	s.TrdMarket = o.Enum()
 }
 
+// NewTrdHeader creates a TrdHeader with required fields.
+func NewTrdHeader(trdEnv TrdEnv, accID uint64, trdMarket TrdMarket) *TrdHeader {
+	return &TrdHeader{
+		TrdEnv:    trdEnv.Enum(),
+		AccID:     &accID,
+		TrdMarket: trdMarket.Enum(),
+	}
+}
+
 // TrdModifyOrderRequest
 
 // 是否调整价格，如果价格不合法，是否调整到合法价位，true调整，false不调整
@@ -3285,6 +3557,11 @@ func (s *TrdSubAccPushRequest) SetAccIDList(o ...uint64) {
 	s.AccIDList = o
 }
 
+func (s *TrdSubAccPushRequest) AddAccID(o ...uint64) *TrdSubAccPushRequest {
+	s.AccIDList = append(s.AccIDList, o...)
+	return s
+}
+
 // TrdUnlockTradeRequest
 
 // 交易密码的MD5转16进制(全小写)，解锁交易必须要填密码，锁定交易不需要验证密码，可不填
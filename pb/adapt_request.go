@@ -168,7 +168,7 @@ func (m *QotGetFutureInfoRequest_Internal) GetRequestPayload() proto.Message {
 	return m.GetPayload()
 }
 
-/* ProtoId Not Exist: QotGetHistoryKL
+/* ProtoId Removed from OpenD: QotGetHistoryKL
 
 func (m *QotGetHistoryKLRequest) Dispatch(ctx context.Context, rh RequestHandler) (*QotGetHistoryKLResponse, error) {
 	req := &QotGetHistoryKLRequest_Internal{ Payload: m }
@@ -186,7 +186,7 @@ func (m *QotGetHistoryKLRequest_Internal) GetRequestPayload() proto.Message {
 }
 
 */
-/* ProtoId Not Exist: QotGetHistoryKLPoints
+/* ProtoId Removed from OpenD: QotGetHistoryKLPoints
 
 func (m *QotGetHistoryKLPointsRequest) Dispatch(ctx context.Context, rh RequestHandler) (*QotGetHistoryKLPointsResponse, error) {
 	req := &QotGetHistoryKLPointsRequest_Internal{ Payload: m }
@@ -373,7 +373,7 @@ func (m *QotGetReferenceRequest_Internal) GetRequestPayload() proto.Message {
 	return m.GetPayload()
 }
 
-/* ProtoId Not Exist: QotGetRehab
+/* ProtoId Removed from OpenD: QotGetRehab
 
 func (m *QotGetRehabRequest) Dispatch(ctx context.Context, rh RequestHandler) (*QotGetRehabResponse, error) {
 	req := &QotGetRehabRequest_Internal{ Payload: m }
@@ -840,10 +840,8 @@ func (m *TrdPlaceOrderRequest_Internal) GetRequestPayload() proto.Message {
 	return m.GetPayload()
 }
 
-/* ProtoId Not Exist: TrdReconfirmOrder
-
 func (m *TrdReconfirmOrderRequest) Dispatch(ctx context.Context, rh RequestHandler) (*TrdReconfirmOrderResponse, error) {
-	req := &TrdReconfirmOrderRequest_Internal{ Payload: m }
+	req := &TrdReconfirmOrderRequest_Internal{Payload: m}
 	resp_internal := &TrdReconfirmOrderResponse_Internal{}
 	if resp, err := rh.Request(ctx, ProtoId_TrdReconfirmOrder, req, resp_internal); err != nil {
 		return nil, err
@@ -852,13 +850,10 @@ func (m *TrdReconfirmOrderRequest) Dispatch(ctx context.Context, rh RequestHandl
 	}
 }
 
-
 func (m *TrdReconfirmOrderRequest_Internal) GetRequestPayload() proto.Message {
 	return m.GetPayload()
 }
 
-*/
-
 func (m *TrdSubAccPushRequest) Dispatch(ctx context.Context, rh RequestHandler) (*TrdSubAccPushResponse, error) {
 	req := &TrdSubAccPushRequest_Internal{Payload: m}
 	resp_internal := &TrdSubAccPushResponse_Internal{}
@@ -887,10 +882,8 @@ func (m *TrdUnlockTradeRequest_Internal) GetRequestPayload() proto.Message {
 	return m.GetPayload()
 }
 
-/* ProtoId Not Exist: UsedQuota
-
 func (m *UsedQuotaRequest) Dispatch(ctx context.Context, rh RequestHandler) (*UsedQuotaResponse, error) {
-	req := &UsedQuotaRequest_Internal{ Payload: m }
+	req := &UsedQuotaRequest_Internal{Payload: m}
 	resp_internal := &UsedQuotaResponse_Internal{}
 	if resp, err := rh.Request(ctx, ProtoId_UsedQuota, req, resp_internal); err != nil {
 		return nil, err
@@ -899,13 +892,10 @@ func (m *UsedQuotaRequest) Dispatch(ctx context.Context, rh RequestHandler) (*Us
 	}
 }
 
-
 func (m *UsedQuotaRequest_Internal) GetRequestPayload() proto.Message {
 	return m.GetPayload()
 }
 
-*/
-
 func (m *VerificationRequest) Dispatch(ctx context.Context, rh RequestHandler) (*VerificationResponse, error) {
 	req := &VerificationRequest_Internal{Payload: m}
 	resp_internal := &VerificationResponse_Internal{}
@@ -920,7 +910,7 @@ func (m *VerificationRequest_Internal) GetRequestPayload() proto.Message {
 	return m.GetPayload()
 }
 
-/* protoid: used/total (68/69)
+/* protoid: used/total (70/71)
 unused:
 InitQuantMode
 */
@@ -0,0 +1,1832 @@
+// Code generated by protoc-gen-go-futu. DO NOT EDIT.
+
+package pb
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError reports an invalid request field by its path,
+// eg: TrdPlaceOrderRequest.header.accID
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Field + ": " + e.Reason
+}
+
+func validateEnum(path string, e protoreflect.Enum, noUnknown bool) error {
+	v := e.Descriptor().Values().ByNumber(e.Number())
+	if v == nil {
+		return &ValidationError{Field: path, Reason: fmt.Sprintf("invalid %s value %d", e.Descriptor().Name(), e.Number())}
+	}
+	if noUnknown && strings.HasSuffix(string(v.Name()), "_Unknown") {
+		return &ValidationError{Field: path, Reason: "must not be " + string(v.Name())}
+	}
+	return nil
+}
+
+func validateMaxBytes(path string, s string, n int) error {
+	if len(s) > n {
+		return &ValidationError{Field: path, Reason: fmt.Sprintf("exceeds %d bytes", n)}
+	}
+	return nil
+}
+
+// validateCode checks a security code without market,
+// A-share codes must be 6 digits.
+func validateCode(path string, code string, isCN bool) error {
+	if code == "" {
+		return &ValidationError{Field: path, Reason: "empty code"}
+	}
+
+	for _, prefix := range []string{"HK.", "US.", "SH.", "SZ."} {
+		if strings.HasPrefix(code, prefix) {
+			return &ValidationError{Field: path, Reason: "code must not have market prefix: " + code}
+		}
+	}
+
+	if isCN {
+		if len(code) != 6 || strings.Trim(code, "0123456789") != "" {
+			return &ValidationError{Field: path, Reason: "A-share code must be 6 digits: " + code}
+		}
+	}
+	return nil
+}
+
+// isCNPlateCode reports plate codes of A-share markets, eg: BK0001 and LIST3000000.
+func isCNPlateCode(code string) bool {
+	return strings.HasPrefix(code, "BK") || strings.HasPrefix(code, "LIST")
+}
+
+func isCNTrdSecMarket(secMarket TrdSecMarket, trdMarket TrdMarket) bool {
+	switch secMarket {
+	case TrdSecMarket_CN_SH, TrdSecMarket_CN_SZ:
+		return true
+	case TrdSecMarket_Unknown:
+		return trdMarket == TrdMarket_CN
+	}
+	return false
+}
+
+func (m *AccumulateFilter) validate(path string) error {
+	if m.FieldName == nil {
+		return &ValidationError{Field: path + ".fieldName", Reason: "required"}
+	}
+	if m.FieldName != nil {
+		if err := validateEnum(path+".fieldName", *m.FieldName, true); err != nil {
+			return err
+		}
+	}
+	if m.SortDir != nil {
+		if err := validateEnum(path+".sortDir", *m.SortDir, false); err != nil {
+			return err
+		}
+	}
+	if m.Days == nil {
+		return &ValidationError{Field: path + ".days", Reason: "required"}
+	}
+	return nil
+}
+
+func (m *BaseFilter) validate(path string) error {
+	if m.FieldName == nil {
+		return &ValidationError{Field: path + ".fieldName", Reason: "required"}
+	}
+	if m.FieldName != nil {
+		if err := validateEnum(path+".fieldName", *m.FieldName, true); err != nil {
+			return err
+		}
+	}
+	if m.SortDir != nil {
+		if err := validateEnum(path+".sortDir", *m.SortDir, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *CustomIndicatorFilter) validate(path string) error {
+	if m.FirstFieldName == nil {
+		return &ValidationError{Field: path + ".firstFieldName", Reason: "required"}
+	}
+	if m.FirstFieldName != nil {
+		if err := validateEnum(path+".firstFieldName", *m.FirstFieldName, true); err != nil {
+			return err
+		}
+	}
+	if m.SecondFieldName == nil {
+		return &ValidationError{Field: path + ".secondFieldName", Reason: "required"}
+	}
+	if m.SecondFieldName != nil {
+		if err := validateEnum(path+".secondFieldName", *m.SecondFieldName, true); err != nil {
+			return err
+		}
+	}
+	if m.RelativePosition == nil {
+		return &ValidationError{Field: path + ".relativePosition", Reason: "required"}
+	}
+	if m.RelativePosition != nil {
+		if err := validateEnum(path+".relativePosition", *m.RelativePosition, true); err != nil {
+			return err
+		}
+	}
+	if m.KlType == nil {
+		return &ValidationError{Field: path + ".klType", Reason: "required"}
+	}
+	if m.KlType != nil {
+		if err := validateEnum(path+".klType", *m.KlType, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *DataFilter) validate(path string) error {
+	return nil
+}
+
+func (m *FinancialFilter) validate(path string) error {
+	if m.FieldName == nil {
+		return &ValidationError{Field: path + ".fieldName", Reason: "required"}
+	}
+	if m.FieldName != nil {
+		if err := validateEnum(path+".fieldName", *m.FieldName, true); err != nil {
+			return err
+		}
+	}
+	if m.SortDir != nil {
+		if err := validateEnum(path+".sortDir", *m.SortDir, false); err != nil {
+			return err
+		}
+	}
+	if m.Quarter == nil {
+		return &ValidationError{Field: path + ".quarter", Reason: "required"}
+	}
+	if m.Quarter != nil {
+		if err := validateEnum(path+".quarter", *m.Quarter, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *GetDelayStatisticsRequest) Validate() error {
+	return m.validate("GetDelayStatisticsRequest")
+}
+
+func (m *GetDelayStatisticsRequest) validate(path string) error {
+	for i, v := range m.TypeList {
+		if err := validateEnum(fmt.Sprintf("%s.typeList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	if m.QotPushStage != nil {
+		if err := validateEnum(path+".qotPushStage", *m.QotPushStage, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *GetGlobalStateRequest) Validate() error {
+	return m.validate("GetGlobalStateRequest")
+}
+
+func (m *GetGlobalStateRequest) validate(path string) error {
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *GetUserInfoRequest) Validate() error {
+	return m.validate("GetUserInfoRequest")
+}
+
+func (m *GetUserInfoRequest) validate(path string) error {
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *InitConnectRequest) Validate() error {
+	return m.validate("InitConnectRequest")
+}
+
+func (m *InitConnectRequest) validate(path string) error {
+	if m.ClientVer == nil {
+		return &ValidationError{Field: path + ".clientVer", Reason: "required"}
+	}
+	if m.ClientID == nil {
+		return &ValidationError{Field: path + ".clientID", Reason: "required"}
+	}
+	if m.PacketEncAlgo != nil {
+		if err := validateEnum(path+".packetEncAlgo", *m.PacketEncAlgo, false); err != nil {
+			return err
+		}
+	}
+	if m.PushProtoFmt != nil {
+		if err := validateEnum(path+".pushProtoFmt", *m.PushProtoFmt, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *KeepAliveRequest) Validate() error {
+	return m.validate("KeepAliveRequest")
+}
+
+func (m *KeepAliveRequest) validate(path string) error {
+	if m.Time == nil {
+		return &ValidationError{Field: path + ".time", Reason: "required"}
+	}
+	return nil
+}
+
+func (m *PacketID) validate(path string) error {
+	if m.ConnID == nil {
+		return &ValidationError{Field: path + ".connID", Reason: "required"}
+	}
+	if m.SerialNo == nil {
+		return &ValidationError{Field: path + ".serialNo", Reason: "required"}
+	}
+	return nil
+}
+
+func (m *PatternFilter) validate(path string) error {
+	if m.FieldName == nil {
+		return &ValidationError{Field: path + ".fieldName", Reason: "required"}
+	}
+	if m.FieldName != nil {
+		if err := validateEnum(path+".fieldName", *m.FieldName, true); err != nil {
+			return err
+		}
+	}
+	if m.KlType == nil {
+		return &ValidationError{Field: path + ".klType", Reason: "required"}
+	}
+	if m.KlType != nil {
+		if err := validateEnum(path+".klType", *m.KlType, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetBasicQotRequest) Validate() error {
+	return m.validate("QotGetBasicQotRequest")
+}
+
+func (m *QotGetBasicQotRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetBrokerRequest) Validate() error {
+	return m.validate("QotGetBrokerRequest")
+}
+
+func (m *QotGetBrokerRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetCapitalDistributionRequest) Validate() error {
+	return m.validate("QotGetCapitalDistributionRequest")
+}
+
+func (m *QotGetCapitalDistributionRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetCapitalFlowRequest) Validate() error {
+	return m.validate("QotGetCapitalFlowRequest")
+}
+
+func (m *QotGetCapitalFlowRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.PeriodType != nil {
+		if err := validateEnum(path+".periodType", *m.PeriodType, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetCodeChangeRequest) Validate() error {
+	return m.validate("QotGetCodeChangeRequest")
+}
+
+func (m *QotGetCodeChangeRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.TimeFilterList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.timeFilterList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.timeFilterList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.TypeList {
+		if err := validateEnum(fmt.Sprintf("%s.typeList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetFutureInfoRequest) Validate() error {
+	return m.validate("QotGetFutureInfoRequest")
+}
+
+func (m *QotGetFutureInfoRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetHistoryKLPointsRequest) Validate() error {
+	return m.validate("QotGetHistoryKLPointsRequest")
+}
+
+func (m *QotGetHistoryKLPointsRequest) validate(path string) error {
+	if m.RehabType == nil {
+		return &ValidationError{Field: path + ".rehabType", Reason: "required"}
+	}
+	if m.RehabType != nil {
+		if err := validateEnum(path+".rehabType", *m.RehabType, true); err != nil {
+			return err
+		}
+	}
+	if m.KlType == nil {
+		return &ValidationError{Field: path + ".klType", Reason: "required"}
+	}
+	if m.KlType != nil {
+		if err := validateEnum(path+".klType", *m.KlType, true); err != nil {
+			return err
+		}
+	}
+	if m.NoDataMode == nil {
+		return &ValidationError{Field: path + ".noDataMode", Reason: "required"}
+	}
+	if m.NoDataMode != nil {
+		if err := validateEnum(path+".noDataMode", *m.NoDataMode, true); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetHistoryKLRequest) Validate() error {
+	return m.validate("QotGetHistoryKLRequest")
+}
+
+func (m *QotGetHistoryKLRequest) validate(path string) error {
+	if m.RehabType == nil {
+		return &ValidationError{Field: path + ".rehabType", Reason: "required"}
+	}
+	if m.RehabType != nil {
+		if err := validateEnum(path+".rehabType", *m.RehabType, true); err != nil {
+			return err
+		}
+	}
+	if m.KlType == nil {
+		return &ValidationError{Field: path + ".klType", Reason: "required"}
+	}
+	if m.KlType != nil {
+		if err := validateEnum(path+".klType", *m.KlType, true); err != nil {
+			return err
+		}
+	}
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.BeginTime == nil {
+		return &ValidationError{Field: path + ".beginTime", Reason: "required"}
+	}
+	if m.EndTime == nil {
+		return &ValidationError{Field: path + ".endTime", Reason: "required"}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetHoldingChangeListRequest) Validate() error {
+	return m.validate("QotGetHoldingChangeListRequest")
+}
+
+func (m *QotGetHoldingChangeListRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.HolderCategory == nil {
+		return &ValidationError{Field: path + ".holderCategory", Reason: "required"}
+	}
+	if m.HolderCategory != nil {
+		if err := validateEnum(path+".holderCategory", *m.HolderCategory, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetIpoListRequest) Validate() error {
+	return m.validate("QotGetIpoListRequest")
+}
+
+func (m *QotGetIpoListRequest) validate(path string) error {
+	if m.Market == nil {
+		return &ValidationError{Field: path + ".market", Reason: "required"}
+	}
+	if m.Market != nil {
+		if err := validateEnum(path+".market", *m.Market, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetKLRequest) Validate() error {
+	return m.validate("QotGetKLRequest")
+}
+
+func (m *QotGetKLRequest) validate(path string) error {
+	if m.RehabType == nil {
+		return &ValidationError{Field: path + ".rehabType", Reason: "required"}
+	}
+	if m.RehabType != nil {
+		if err := validateEnum(path+".rehabType", *m.RehabType, true); err != nil {
+			return err
+		}
+	}
+	if m.KlType == nil {
+		return &ValidationError{Field: path + ".klType", Reason: "required"}
+	}
+	if m.KlType != nil {
+		if err := validateEnum(path+".klType", *m.KlType, true); err != nil {
+			return err
+		}
+	}
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.ReqNum == nil {
+		return &ValidationError{Field: path + ".reqNum", Reason: "required"}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetMarketStateRequest) Validate() error {
+	return m.validate("QotGetMarketStateRequest")
+}
+
+func (m *QotGetMarketStateRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetOptionChainRequest) Validate() error {
+	return m.validate("QotGetOptionChainRequest")
+}
+
+func (m *QotGetOptionChainRequest) validate(path string) error {
+	if m.Owner == nil {
+		return &ValidationError{Field: path + ".owner", Reason: "required"}
+	}
+	if m.Owner != nil {
+		if err := m.Owner.validate(path + ".owner"); err != nil {
+			return err
+		}
+	}
+	if m.IndexOptionType != nil {
+		if err := validateEnum(path+".indexOptionType", *m.IndexOptionType, false); err != nil {
+			return err
+		}
+	}
+	if m.Type != nil {
+		if err := validateEnum(path+".type", *m.Type, false); err != nil {
+			return err
+		}
+	}
+	if m.Condition != nil {
+		if err := validateEnum(path+".condition", *m.Condition, false); err != nil {
+			return err
+		}
+	}
+	if m.BeginTime == nil {
+		return &ValidationError{Field: path + ".beginTime", Reason: "required"}
+	}
+	if m.EndTime == nil {
+		return &ValidationError{Field: path + ".endTime", Reason: "required"}
+	}
+	if m.DataFilter != nil {
+		if err := m.DataFilter.validate(path + ".dataFilter"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetOptionExpirationDateRequest) Validate() error {
+	return m.validate("QotGetOptionExpirationDateRequest")
+}
+
+func (m *QotGetOptionExpirationDateRequest) validate(path string) error {
+	if m.Owner == nil {
+		return &ValidationError{Field: path + ".owner", Reason: "required"}
+	}
+	if m.Owner != nil {
+		if err := m.Owner.validate(path + ".owner"); err != nil {
+			return err
+		}
+	}
+	if m.IndexOptionType != nil {
+		if err := validateEnum(path+".indexOptionType", *m.IndexOptionType, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetOrderBookRequest) Validate() error {
+	return m.validate("QotGetOrderBookRequest")
+}
+
+func (m *QotGetOrderBookRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.Num == nil {
+		return &ValidationError{Field: path + ".num", Reason: "required"}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetOwnerPlateRequest) Validate() error {
+	return m.validate("QotGetOwnerPlateRequest")
+}
+
+func (m *QotGetOwnerPlateRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetPlateSecurityRequest) Validate() error {
+	return m.validate("QotGetPlateSecurityRequest")
+}
+
+func (m *QotGetPlateSecurityRequest) validate(path string) error {
+	if m.Plate == nil {
+		return &ValidationError{Field: path + ".plate", Reason: "required"}
+	}
+	if m.Plate != nil {
+		if err := m.Plate.validate(path + ".plate"); err != nil {
+			return err
+		}
+	}
+	if m.SortField != nil {
+		if err := validateEnum(path+".sortField", *m.SortField, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetPlateSetRequest) Validate() error {
+	return m.validate("QotGetPlateSetRequest")
+}
+
+func (m *QotGetPlateSetRequest) validate(path string) error {
+	if m.Market == nil {
+		return &ValidationError{Field: path + ".market", Reason: "required"}
+	}
+	if m.Market != nil {
+		if err := validateEnum(path+".market", *m.Market, true); err != nil {
+			return err
+		}
+	}
+	if m.PlateSetType == nil {
+		return &ValidationError{Field: path + ".plateSetType", Reason: "required"}
+	}
+	if m.PlateSetType != nil {
+		if err := validateEnum(path+".plateSetType", *m.PlateSetType, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetPriceReminderRequest) Validate() error {
+	return m.validate("QotGetPriceReminderRequest")
+}
+
+func (m *QotGetPriceReminderRequest) validate(path string) error {
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.Market != nil {
+		if err := validateEnum(path+".market", *m.Market, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetRTRequest) Validate() error {
+	return m.validate("QotGetRTRequest")
+}
+
+func (m *QotGetRTRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetReferenceRequest) Validate() error {
+	return m.validate("QotGetReferenceRequest")
+}
+
+func (m *QotGetReferenceRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.ReferenceType == nil {
+		return &ValidationError{Field: path + ".referenceType", Reason: "required"}
+	}
+	if m.ReferenceType != nil {
+		if err := validateEnum(path+".referenceType", *m.ReferenceType, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetRehabRequest) Validate() error {
+	return m.validate("QotGetRehabRequest")
+}
+
+func (m *QotGetRehabRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetSecuritySnapshotRequest) Validate() error {
+	return m.validate("QotGetSecuritySnapshotRequest")
+}
+
+func (m *QotGetSecuritySnapshotRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetStaticInfoRequest) Validate() error {
+	return m.validate("QotGetStaticInfoRequest")
+}
+
+func (m *QotGetStaticInfoRequest) validate(path string) error {
+	if m.Market != nil {
+		if err := validateEnum(path+".market", *m.Market, false); err != nil {
+			return err
+		}
+	}
+	if m.SecType != nil {
+		if err := validateEnum(path+".secType", *m.SecType, false); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetSubInfoRequest) Validate() error {
+	return m.validate("QotGetSubInfoRequest")
+}
+
+func (m *QotGetSubInfoRequest) validate(path string) error {
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetSuspendRequest) Validate() error {
+	return m.validate("QotGetSuspendRequest")
+}
+
+func (m *QotGetSuspendRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	if m.BeginTime == nil {
+		return &ValidationError{Field: path + ".beginTime", Reason: "required"}
+	}
+	if m.EndTime == nil {
+		return &ValidationError{Field: path + ".endTime", Reason: "required"}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetTickerRequest) Validate() error {
+	return m.validate("QotGetTickerRequest")
+}
+
+func (m *QotGetTickerRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.MaxRetNum == nil {
+		return &ValidationError{Field: path + ".maxRetNum", Reason: "required"}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetUserSecurityGroupRequest) Validate() error {
+	return m.validate("QotGetUserSecurityGroupRequest")
+}
+
+func (m *QotGetUserSecurityGroupRequest) validate(path string) error {
+	if m.GroupType == nil {
+		return &ValidationError{Field: path + ".groupType", Reason: "required"}
+	}
+	if m.GroupType != nil {
+		if err := validateEnum(path+".groupType", *m.GroupType, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetUserSecurityRequest) Validate() error {
+	return m.validate("QotGetUserSecurityRequest")
+}
+
+func (m *QotGetUserSecurityRequest) validate(path string) error {
+	if m.GroupName == nil {
+		return &ValidationError{Field: path + ".groupName", Reason: "required"}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotGetWarrantRequest) Validate() error {
+	return m.validate("QotGetWarrantRequest")
+}
+
+func (m *QotGetWarrantRequest) validate(path string) error {
+	if m.Begin == nil {
+		return &ValidationError{Field: path + ".begin", Reason: "required"}
+	}
+	if m.Num == nil {
+		return &ValidationError{Field: path + ".num", Reason: "required"}
+	}
+	if m.SortField == nil {
+		return &ValidationError{Field: path + ".sortField", Reason: "required"}
+	}
+	if m.SortField != nil {
+		if err := validateEnum(path+".sortField", *m.SortField, true); err != nil {
+			return err
+		}
+	}
+	if m.Ascend == nil {
+		return &ValidationError{Field: path + ".ascend", Reason: "required"}
+	}
+	if m.Owner != nil {
+		if err := m.Owner.validate(path + ".owner"); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.TypeList {
+		if err := validateEnum(fmt.Sprintf("%s.typeList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.IssuerList {
+		if err := validateEnum(fmt.Sprintf("%s.issuerList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	if m.IpoPeriod != nil {
+		if err := validateEnum(path+".ipoPeriod", *m.IpoPeriod, false); err != nil {
+			return err
+		}
+	}
+	if m.PriceType != nil {
+		if err := validateEnum(path+".priceType", *m.PriceType, false); err != nil {
+			return err
+		}
+	}
+	if m.Status != nil {
+		if err := validateEnum(path+".status", *m.Status, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotModifyUserSecurityRequest) Validate() error {
+	return m.validate("QotModifyUserSecurityRequest")
+}
+
+func (m *QotModifyUserSecurityRequest) validate(path string) error {
+	if m.GroupName == nil {
+		return &ValidationError{Field: path + ".groupName", Reason: "required"}
+	}
+	if m.Op == nil {
+		return &ValidationError{Field: path + ".op", Reason: "required"}
+	}
+	if m.Op != nil {
+		if err := validateEnum(path+".op", *m.Op, true); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotRegQotPushRequest) Validate() error {
+	return m.validate("QotRegQotPushRequest")
+}
+
+func (m *QotRegQotPushRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.SubTypeList {
+		if err := validateEnum(fmt.Sprintf("%s.subTypeList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.RehabTypeList {
+		if err := validateEnum(fmt.Sprintf("%s.rehabTypeList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	if m.IsRegOrUnReg == nil {
+		return &ValidationError{Field: path + ".isRegOrUnReg", Reason: "required"}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotRequestHistoryKLQuotaRequest) Validate() error {
+	return m.validate("QotRequestHistoryKLQuotaRequest")
+}
+
+func (m *QotRequestHistoryKLQuotaRequest) validate(path string) error {
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotRequestHistoryKLRequest) Validate() error {
+	return m.validate("QotRequestHistoryKLRequest")
+}
+
+func (m *QotRequestHistoryKLRequest) validate(path string) error {
+	if m.RehabType == nil {
+		return &ValidationError{Field: path + ".rehabType", Reason: "required"}
+	}
+	if m.RehabType != nil {
+		if err := validateEnum(path+".rehabType", *m.RehabType, true); err != nil {
+			return err
+		}
+	}
+	if m.KlType == nil {
+		return &ValidationError{Field: path + ".klType", Reason: "required"}
+	}
+	if m.KlType != nil {
+		if err := validateEnum(path+".klType", *m.KlType, true); err != nil {
+			return err
+		}
+	}
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.BeginTime == nil {
+		return &ValidationError{Field: path + ".beginTime", Reason: "required"}
+	}
+	if m.EndTime == nil {
+		return &ValidationError{Field: path + ".endTime", Reason: "required"}
+	}
+	if m.Session != nil {
+		if err := validateEnum(path+".session", *m.Session, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotRequestRehabRequest) Validate() error {
+	return m.validate("QotRequestRehabRequest")
+}
+
+func (m *QotRequestRehabRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotRequestTradeDateRequest) Validate() error {
+	return m.validate("QotRequestTradeDateRequest")
+}
+
+func (m *QotRequestTradeDateRequest) validate(path string) error {
+	if m.Market == nil {
+		return &ValidationError{Field: path + ".market", Reason: "required"}
+	}
+	if m.Market != nil {
+		if err := validateEnum(path+".market", *m.Market, true); err != nil {
+			return err
+		}
+	}
+	if m.BeginTime == nil {
+		return &ValidationError{Field: path + ".beginTime", Reason: "required"}
+	}
+	if m.EndTime == nil {
+		return &ValidationError{Field: path + ".endTime", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotSetPriceReminderRequest) Validate() error {
+	return m.validate("QotSetPriceReminderRequest")
+}
+
+func (m *QotSetPriceReminderRequest) validate(path string) error {
+	if m.Security == nil {
+		return &ValidationError{Field: path + ".security", Reason: "required"}
+	}
+	if m.Security != nil {
+		if err := m.Security.validate(path + ".security"); err != nil {
+			return err
+		}
+	}
+	if m.Op == nil {
+		return &ValidationError{Field: path + ".op", Reason: "required"}
+	}
+	if m.Op != nil {
+		if err := validateEnum(path+".op", *m.Op, true); err != nil {
+			return err
+		}
+	}
+	if m.Type != nil {
+		if err := validateEnum(path+".type", *m.Type, false); err != nil {
+			return err
+		}
+	}
+	if m.Freq != nil {
+		if err := validateEnum(path+".freq", *m.Freq, false); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.ReminderSessionList {
+		if err := validateEnum(fmt.Sprintf("%s.reminderSessionList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotStockFilterRequest) Validate() error {
+	return m.validate("QotStockFilterRequest")
+}
+
+func (m *QotStockFilterRequest) validate(path string) error {
+	if m.Begin == nil {
+		return &ValidationError{Field: path + ".begin", Reason: "required"}
+	}
+	if m.Num == nil {
+		return &ValidationError{Field: path + ".num", Reason: "required"}
+	}
+	if m.Market == nil {
+		return &ValidationError{Field: path + ".market", Reason: "required"}
+	}
+	if m.Market != nil {
+		if err := validateEnum(path+".market", *m.Market, true); err != nil {
+			return err
+		}
+	}
+	if m.Plate != nil {
+		if err := m.Plate.validate(path + ".plate"); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.BaseFilterList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.baseFilterList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.baseFilterList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.AccumulateFilterList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.accumulateFilterList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.accumulateFilterList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.FinancialFilterList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.financialFilterList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.financialFilterList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.PatternFilterList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.patternFilterList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.patternFilterList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.CustomIndicatorFilterList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.customIndicatorFilterList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.customIndicatorFilterList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *QotSubRequest) Validate() error {
+	return m.validate("QotSubRequest")
+}
+
+func (m *QotSubRequest) validate(path string) error {
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.SubTypeList {
+		if err := validateEnum(fmt.Sprintf("%s.subTypeList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	if m.IsSubOrUnSub == nil {
+		return &ValidationError{Field: path + ".isSubOrUnSub", Reason: "required"}
+	}
+	for i, v := range m.RegPushRehabTypeList {
+		if err := validateEnum(fmt.Sprintf("%s.regPushRehabTypeList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	if m.Session != nil {
+		if err := validateEnum(path+".session", *m.Session, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Security) validate(path string) error {
+	if m.Market == nil {
+		return &ValidationError{Field: path + ".market", Reason: "required"}
+	}
+	if m.Market != nil {
+		if err := validateEnum(path+".market", *m.Market, true); err != nil {
+			return err
+		}
+	}
+	if m.Code == nil {
+		return &ValidationError{Field: path + ".code", Reason: "required"}
+	}
+	if m.Code != nil {
+		if err := validateCode(path+".code", m.GetCode(),
+			(m.GetMarket() == QotMarket_CNSH_Security || m.GetMarket() == QotMarket_CNSZ_Security) &&
+				!isCNPlateCode(m.GetCode())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TestCmdRequest) Validate() error {
+	return m.validate("TestCmdRequest")
+}
+
+func (m *TestCmdRequest) validate(path string) error {
+	if m.Cmd == nil {
+		return &ValidationError{Field: path + ".cmd", Reason: "required"}
+	}
+	return nil
+}
+
+func (m *TimeFilter) validate(path string) error {
+	if m.Type == nil {
+		return &ValidationError{Field: path + ".type", Reason: "required"}
+	}
+	if m.Type != nil {
+		if err := validateEnum(path+".type", *m.Type, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *TrdFilterConditions) validate(path string) error {
+	if m.FilterMarket != nil {
+		if err := validateEnum(path+".filterMarket", *m.FilterMarket, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdFlowSummaryRequest) Validate() error {
+	return m.validate("TrdFlowSummaryRequest")
+}
+
+func (m *TrdFlowSummaryRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.ClearingDate == nil {
+		return &ValidationError{Field: path + ".clearingDate", Reason: "required"}
+	}
+	if m.CashFlowDirection != nil {
+		if err := validateEnum(path+".cashFlowDirection", *m.CashFlowDirection, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetAccListRequest) Validate() error {
+	return m.validate("TrdGetAccListRequest")
+}
+
+func (m *TrdGetAccListRequest) validate(path string) error {
+	if m.TrdCategory != nil {
+		if err := validateEnum(path+".trdCategory", *m.TrdCategory, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetFundsRequest) Validate() error {
+	return m.validate("TrdGetFundsRequest")
+}
+
+func (m *TrdGetFundsRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.Currency != nil {
+		if err := validateEnum(path+".currency", *m.Currency, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetHistoryOrderFillListRequest) Validate() error {
+	return m.validate("TrdGetHistoryOrderFillListRequest")
+}
+
+func (m *TrdGetHistoryOrderFillListRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.FilterConditions == nil {
+		return &ValidationError{Field: path + ".filterConditions", Reason: "required"}
+	}
+	if m.FilterConditions != nil {
+		if err := m.FilterConditions.validate(path + ".filterConditions"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetHistoryOrderListRequest) Validate() error {
+	return m.validate("TrdGetHistoryOrderListRequest")
+}
+
+func (m *TrdGetHistoryOrderListRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.FilterConditions == nil {
+		return &ValidationError{Field: path + ".filterConditions", Reason: "required"}
+	}
+	if m.FilterConditions != nil {
+		if err := m.FilterConditions.validate(path + ".filterConditions"); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.FilterStatusList {
+		if err := validateEnum(fmt.Sprintf("%s.filterStatusList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetMarginRatioRequest) Validate() error {
+	return m.validate("TrdGetMarginRatioRequest")
+}
+
+func (m *TrdGetMarginRatioRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.SecurityList {
+		if v == nil {
+			return &ValidationError{Field: fmt.Sprintf("%s.securityList[%d]", path, i), Reason: "nil element"}
+		}
+		if err := v.validate(fmt.Sprintf("%s.securityList[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetMaxTrdQtysRequest) Validate() error {
+	return m.validate("TrdGetMaxTrdQtysRequest")
+}
+
+func (m *TrdGetMaxTrdQtysRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.OrderType == nil {
+		return &ValidationError{Field: path + ".orderType", Reason: "required"}
+	}
+	if m.OrderType != nil {
+		if err := validateEnum(path+".orderType", *m.OrderType, true); err != nil {
+			return err
+		}
+	}
+	if m.Code == nil {
+		return &ValidationError{Field: path + ".code", Reason: "required"}
+	}
+	if m.Code != nil {
+		if err := validateCode(path+".code", m.GetCode(),
+			isCNTrdSecMarket(m.GetSecMarket(), m.GetHeader().GetTrdMarket())); err != nil {
+			return err
+		}
+	}
+	if m.Price == nil {
+		return &ValidationError{Field: path + ".price", Reason: "required"}
+	}
+	if m.SecMarket != nil {
+		if err := validateEnum(path+".secMarket", *m.SecMarket, false); err != nil {
+			return err
+		}
+	}
+	if m.Session != nil {
+		if err := validateEnum(path+".session", *m.Session, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetOrderFeeRequest) Validate() error {
+	return m.validate("TrdGetOrderFeeRequest")
+}
+
+func (m *TrdGetOrderFeeRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetOrderFillListRequest) Validate() error {
+	return m.validate("TrdGetOrderFillListRequest")
+}
+
+func (m *TrdGetOrderFillListRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.FilterConditions != nil {
+		if err := m.FilterConditions.validate(path + ".filterConditions"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetOrderListRequest) Validate() error {
+	return m.validate("TrdGetOrderListRequest")
+}
+
+func (m *TrdGetOrderListRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.FilterConditions != nil {
+		if err := m.FilterConditions.validate(path + ".filterConditions"); err != nil {
+			return err
+		}
+	}
+	for i, v := range m.FilterStatusList {
+		if err := validateEnum(fmt.Sprintf("%s.filterStatusList[%d]", path, i), v, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdGetPositionListRequest) Validate() error {
+	return m.validate("TrdGetPositionListRequest")
+}
+
+func (m *TrdGetPositionListRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.FilterConditions != nil {
+		if err := m.FilterConditions.validate(path + ".filterConditions"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *TrdHeader) validate(path string) error {
+	if m.TrdEnv == nil {
+		return &ValidationError{Field: path + ".trdEnv", Reason: "required"}
+	}
+	if m.TrdEnv != nil {
+		if err := validateEnum(path+".trdEnv", *m.TrdEnv, true); err != nil {
+			return err
+		}
+	}
+	if m.AccID == nil {
+		return &ValidationError{Field: path + ".accID", Reason: "required"}
+	}
+	if m.TrdMarket == nil {
+		return &ValidationError{Field: path + ".trdMarket", Reason: "required"}
+	}
+	if m.TrdMarket != nil {
+		if err := validateEnum(path+".trdMarket", *m.TrdMarket, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdModifyOrderRequest) Validate() error {
+	return m.validate("TrdModifyOrderRequest")
+}
+
+func (m *TrdModifyOrderRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.OrderID == nil {
+		return &ValidationError{Field: path + ".orderID", Reason: "required"}
+	}
+	if m.ModifyOrderOp == nil {
+		return &ValidationError{Field: path + ".modifyOrderOp", Reason: "required"}
+	}
+	if m.ModifyOrderOp != nil {
+		if err := validateEnum(path+".modifyOrderOp", *m.ModifyOrderOp, true); err != nil {
+			return err
+		}
+	}
+	if m.TrdMarket != nil {
+		if err := validateEnum(path+".trdMarket", *m.TrdMarket, false); err != nil {
+			return err
+		}
+	}
+	if m.TrailType != nil {
+		if err := validateEnum(path+".trailType", *m.TrailType, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdPlaceOrderRequest) Validate() error {
+	return m.validate("TrdPlaceOrderRequest")
+}
+
+func (m *TrdPlaceOrderRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.TrdSide == nil {
+		return &ValidationError{Field: path + ".trdSide", Reason: "required"}
+	}
+	if m.TrdSide != nil {
+		if err := validateEnum(path+".trdSide", *m.TrdSide, true); err != nil {
+			return err
+		}
+	}
+	if m.OrderType == nil {
+		return &ValidationError{Field: path + ".orderType", Reason: "required"}
+	}
+	if m.OrderType != nil {
+		if err := validateEnum(path+".orderType", *m.OrderType, true); err != nil {
+			return err
+		}
+	}
+	if m.Code == nil {
+		return &ValidationError{Field: path + ".code", Reason: "required"}
+	}
+	if m.Code != nil {
+		if err := validateCode(path+".code", m.GetCode(),
+			isCNTrdSecMarket(m.GetSecMarket(), m.GetHeader().GetTrdMarket())); err != nil {
+			return err
+		}
+	}
+	if m.Qty == nil {
+		return &ValidationError{Field: path + ".qty", Reason: "required"}
+	}
+	if m.SecMarket != nil {
+		if err := validateEnum(path+".secMarket", *m.SecMarket, false); err != nil {
+			return err
+		}
+	}
+	if m.Remark != nil {
+		if err := validateMaxBytes(path+".remark", m.GetRemark(), 64); err != nil {
+			return err
+		}
+	}
+	if m.TimeInForce != nil {
+		if err := validateEnum(path+".timeInForce", *m.TimeInForce, false); err != nil {
+			return err
+		}
+	}
+	if m.TrailType != nil {
+		if err := validateEnum(path+".trailType", *m.TrailType, false); err != nil {
+			return err
+		}
+	}
+	if m.Session != nil {
+		if err := validateEnum(path+".session", *m.Session, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdReconfirmOrderRequest) Validate() error {
+	return m.validate("TrdReconfirmOrderRequest")
+}
+
+func (m *TrdReconfirmOrderRequest) validate(path string) error {
+	if m.Header == nil {
+		return &ValidationError{Field: path + ".header", Reason: "required"}
+	}
+	if m.Header != nil {
+		if err := m.Header.validate(path + ".header"); err != nil {
+			return err
+		}
+	}
+	if m.OrderID == nil {
+		return &ValidationError{Field: path + ".orderID", Reason: "required"}
+	}
+	if m.ReconfirmReason == nil {
+		return &ValidationError{Field: path + ".reconfirmReason", Reason: "required"}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdSubAccPushRequest) Validate() error {
+	return m.validate("TrdSubAccPushRequest")
+}
+
+func (m *TrdSubAccPushRequest) validate(path string) error {
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *TrdUnlockTradeRequest) Validate() error {
+	return m.validate("TrdUnlockTradeRequest")
+}
+
+func (m *TrdUnlockTradeRequest) validate(path string) error {
+	if m.Unlock == nil {
+		return &ValidationError{Field: path + ".unlock", Reason: "required"}
+	}
+	if m.SecurityFirm != nil {
+		if err := validateEnum(path+".securityFirm", *m.SecurityFirm, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *UsedQuotaRequest) Validate() error {
+	return m.validate("UsedQuotaRequest")
+}
+
+func (m *UsedQuotaRequest) validate(path string) error {
+	return nil
+}
+
+// Validate checks required fields, enum values and documented constraints.
+func (m *VerificationRequest) Validate() error {
+	return m.validate("VerificationRequest")
+}
+
+func (m *VerificationRequest) validate(path string) error {
+	if m.Type == nil {
+		return &ValidationError{Field: path + ".type", Reason: "required"}
+	}
+	if m.Type != nil {
+		if err := validateEnum(path+".type", *m.Type, true); err != nil {
+			return err
+		}
+	}
+	if m.Op == nil {
+		return &ValidationError{Field: path + ".op", Reason: "required"}
+	}
+	if m.Op != nil {
+		if err := validateEnum(path+".op", *m.Op, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
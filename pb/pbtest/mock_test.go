@@ -0,0 +1,69 @@
+package pbtest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMock(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	m := pbtest.NewMock()
+
+	m.OnQotGetKL(func(req *pb.QotGetKLRequest) (*pb.QotGetKLResponse, error) {
+		return &pb.QotGetKLResponse{
+			Security: req.GetSecurity(),
+			KlList: []*pb.KLine{
+				{Time: proto.String("2024-01-02 00:00:00"), ClosePrice: proto.Float64(300)},
+			},
+		}, nil
+	})
+
+	req := &pb.QotGetKLRequest{
+		Security:  futu.NewSecurity("HK.00700"),
+		KlType:    pb.KLType_Day.Enum(),
+		RehabType: pb.RehabType_Forward.Enum(),
+		ReqNum:    proto.Int32(1),
+	}
+	resp, err := req.Dispatch(ctx, m)
+	should.NoError(err)
+	should.Len(resp.GetKlList(), 1)
+	should.Equal(300.0, resp.GetKlList()[0].GetClosePrice())
+
+	calls := m.QotGetKLCalls()
+	should.Len(calls, 1)
+	should.Equal("00700", calls[0].GetSecurity().GetCode())
+
+	// failure in RetType is converted as Client does
+	m.On(pb.ProtoId_QotStockFilter, func(pb.Request) (pb.Response, error) {
+		return pbtest.NewQotStockFilterFailure(pb.RetType_Failed,
+			"条件选股频率太高，请求失败，每30秒最多10次。"), nil
+	})
+	_, err = (&pb.QotStockFilterRequest{}).Dispatch(ctx, m)
+	should.True(errors.Is(err, futu.ErrRateLimited))
+
+	// stub errors are returned as is
+	errStub := errors.New("stub error")
+	m.OnQotGetKL(func(*pb.QotGetKLRequest) (*pb.QotGetKLResponse, error) {
+		return nil, errStub
+	})
+	_, err = req.Dispatch(ctx, m)
+	should.ErrorIs(err, errStub)
+
+	_, err = (&pb.GetGlobalStateRequest{}).Dispatch(ctx, m)
+	should.ErrorIs(err, pbtest.ErrNoStub)
+
+	should.Len(m.Calls(), 4)
+	should.Len(m.CallsOf(pb.ProtoId_QotGetKL), 2)
+
+	m.Reset()
+	should.Empty(m.Calls())
+}
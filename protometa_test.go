@@ -0,0 +1,57 @@
+package futu_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtoMeta(t *testing.T) {
+	should := require.New(t)
+
+	m, ok := pb.ProtoMeta(pb.ProtoId_TrdPlaceOrder)
+	should.True(ok)
+	should.Equal(pb.ProtoCategory_Trade, m.Category)
+	should.True(m.Write)
+	should.True(m.RequireUnlock)
+	should.Equal(15, m.RateLimit)
+	should.Equal(30*time.Second, m.RateWindow)
+
+	m, ok = pb.ProtoMeta(pb.ProtoId_QotUpdateKL)
+	should.True(ok)
+	should.Equal(pb.ProtoCategory_Quote, m.Category)
+	should.True(m.Push)
+	should.True(m.RequireSubscription)
+	should.False(m.Write)
+
+	m, ok = pb.ProtoMeta(pb.ProtoId_QotSub)
+	should.True(ok)
+	should.True(m.SubscriptionQuota)
+	should.False(m.RequireSubscription)
+
+	m, ok = pb.ProtoMeta(pb.ProtoId_UsedQuota)
+	should.True(ok)
+	should.Equal(pb.ProtoCategory_Quote, m.Category)
+
+	m, ok = pb.ProtoMeta(pb.ProtoId_QotRequestHistoryKL)
+	should.True(ok)
+	should.True(m.HistoryKLQuota)
+	should.Equal(1000, m.PageSize)
+
+	m, ok = pb.ProtoMeta(pb.ProtoId_GetGlobalState)
+	should.True(ok)
+	should.Equal(pb.ProtoCategory_System, m.Category)
+	should.Zero(m.RateLimit)
+
+	// push flags agree with IsPushProtoId
+	for id := pb.ProtoId(1000); id < 4000; id++ {
+		if m, ok := pb.ProtoMeta(id); ok {
+			should.Equal(pb.IsPushProtoId(id), m.Push, id.String())
+		}
+	}
+
+	_, ok = pb.ProtoMeta(pb.ProtoId_Unknown)
+	should.False(ok)
+}
@@ -0,0 +1,117 @@
+package futu
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/santsai/futu-go/pb"
+)
+
+// statusChanged returns a channel closed on next ConnectStatus/ProgramStatus push.
+func (client *Client) statusChanged() <-chan struct{} {
+	client.stateMutex.Lock()
+	defer client.stateMutex.Unlock()
+
+	if client.statusChan == nil {
+		client.statusChan = make(chan struct{})
+	}
+	return client.statusChan
+}
+
+func (client *Client) notifyStatusChanged() {
+	client.stateMutex.Lock()
+	if client.statusChan != nil {
+		close(client.statusChan)
+		client.statusChan = nil
+	}
+	client.stateMutex.Unlock()
+}
+
+// checkReady returns true if OpenD is logged in as required.
+func checkReady(s *pb.GetGlobalStateResponse, needQuote, needTrade bool) (bool, error) {
+
+	if st := s.GetProgramStatus(); st != nil {
+		switch st.GetType() {
+		case pb.ProgramStatusType_Ready:
+		case pb.ProgramStatusType_ForceUpdate,
+			pb.ProgramStatusType_UnAgreeDisclaimer:
+			// needs manual action on OpenD
+			return false, fmt.Errorf("%w: %s %s", ErrNotReady, st.GetType(), st.GetStrExtDesc())
+		default:
+			return false, nil
+		}
+	}
+
+	if needQuote && !s.GetQotLogined() {
+		return false, nil
+	}
+
+	if needTrade && !s.GetTrdLogined() {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// WaitReady blocks until OpenD is ready and logged in to the quote and/or
+// trade servers, so services can start while OpenD is still booting.
+// It polls GetGlobalState and re-checks immediately on status pushes.
+func (client *Client) WaitReady(ctx context.Context, needQuote, needTrade bool) error {
+	return waitReady(ctx, client, client, client.readyPollInterval, needQuote, needTrade)
+}
+
+// WaitReady is Client.WaitReady of rh, polling every interval without
+// status pushes, eg: for a pbtest.Mock.
+func WaitReady(ctx context.Context, rh pb.RequestHandler, interval time.Duration, needQuote, needTrade bool) error {
+	return waitReady(ctx, rh, nil, interval, needQuote, needTrade)
+}
+
+// waitReady polls rh, and watches pushes and state of client if not nil.
+func waitReady(ctx context.Context, rh pb.RequestHandler, client *Client, interval time.Duration, needQuote, needTrade bool) error {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// nil channels never fire
+	var changed, closed <-chan struct{}
+	if client != nil {
+		closed = client.closed
+	}
+
+	for {
+		if client != nil {
+			if err := client.Err(); err != nil {
+				return err
+			}
+
+			// get the channel before checking, not to miss a push in between
+			changed = client.statusChanged()
+		}
+
+		req := &pb.GetGlobalStateRequest{}
+		if s, err := req.Dispatch(ctx, rh); err != nil {
+			log.Warn().Err(err).Msg("WaitReady: GetGlobalState error")
+		} else if ok, err := checkReady(s, needQuote, needTrade); err != nil {
+			return err
+		} else if ok {
+			return nil
+		} else {
+			log.Info().
+				Stringer("program_status", s.GetProgramStatus().GetType()).
+				Bool("qot_logined", s.GetQotLogined()).
+				Bool("trd_logined", s.GetTrdLogined()).
+				Msg("WaitReady: OpenD not ready")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-closed:
+			return ErrInterrupted
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
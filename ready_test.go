@@ -0,0 +1,53 @@
+package futu_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func globalState(st pb.ProgramStatusType, qot, trd bool) *pb.GetGlobalStateResponse {
+	return &pb.GetGlobalStateResponse{
+		QotLogined:    proto.Bool(qot),
+		TrdLogined:    proto.Bool(trd),
+		ProgramStatus: &pb.ProgramStatus{Type: st.Enum()},
+	}
+}
+
+func TestWaitReady(t *testing.T) {
+	should := require.New(t)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+
+	// booting, logged in to quote only, then ready
+	states := []*pb.GetGlobalStateResponse{
+		globalState(pb.ProgramStatusType_Loaded, false, false),
+		globalState(pb.ProgramStatusType_Ready, true, false),
+		globalState(pb.ProgramStatusType_Ready, true, true),
+	}
+
+	m := pbtest.NewMock()
+	m.OnGetGlobalState(func(*pb.GetGlobalStateRequest) (*pb.GetGlobalStateResponse, error) {
+		s := states[0]
+		if len(states) > 1 {
+			states = states[1:]
+		}
+		return s, nil
+	})
+
+	should.NoError(futu.WaitReady(ctx, m, time.Millisecond, true, true))
+	should.Len(m.GetGlobalStateCalls(), 3)
+
+	// needs manual action on OpenD
+	m.OnGetGlobalState(func(*pb.GetGlobalStateRequest) (*pb.GetGlobalStateResponse, error) {
+		return globalState(pb.ProgramStatusType_ForceUpdate, false, false), nil
+	})
+	should.ErrorIs(futu.WaitReady(ctx, m, time.Millisecond, true, false), futu.ErrNotReady)
+}
@@ -0,0 +1,71 @@
+package futu
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReconfirmFunc decides whether an updated order needs reconfirmation,
+// and returns the reason to reconfirm with, which is
+// Trd_Common.ReconfirmOrderReason, not published in proto.
+type ReconfirmFunc func(order *pb.Order) (reason int32, ok bool)
+
+// NewReconfirmHandler returns a TrdUpdateOrder push handler, which
+// reconfirms orders selected by needConfirm, eg: HK orders held by the
+// server for a second confirmation. timeout bounds each TrdReconfirmOrder.
+//
+// OpenD pushes an order on every status change, so each order is
+// reconfirmed at most once, failed or not, to avoid repeated trade writes.
+//
+//	client.RegisterHandler(pb.ProtoId_TrdUpdateOrder,
+//		futu.NewReconfirmHandler(client, needConfirm, 10*time.Second))
+func NewReconfirmHandler(rh pb.RequestHandler, needConfirm ReconfirmFunc, timeout time.Duration) Handler {
+	var (
+		mutex sync.Mutex
+		seen  = map[uint64]bool{} // by orderID, in flight or done
+	)
+
+	return func(s2c proto.Message) error {
+		msg, ok := s2c.(*pb.TrdUpdateOrderResponse)
+		if !ok {
+			return nil
+		}
+
+		order := msg.GetOrder()
+		reason, ok := needConfirm(order)
+		if !ok {
+			return nil
+		}
+
+		mutex.Lock()
+		if seen[order.GetOrderID()] {
+			mutex.Unlock()
+			return nil
+		}
+		seen[order.GetOrderID()] = true
+		mutex.Unlock()
+
+		// push handlers run on response workers,
+		// don't block them while waiting for our own response.
+		go func() {
+			ctx, cancel := context.WithTimeout(context.TODO(), timeout)
+			defer cancel()
+
+			req := &pb.TrdReconfirmOrderRequest{
+				Header:          msg.GetHeader(),
+				OrderID:         proto.Uint64(order.GetOrderID()),
+				ReconfirmReason: proto.Int32(reason),
+			}
+			if _, err := req.Dispatch(ctx, rh); err != nil {
+				log.Error().Err(err).Uint64("orderID", order.GetOrderID()).Msg("reconfirm order error")
+			}
+		}()
+
+		return nil
+	}
+}
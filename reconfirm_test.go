@@ -0,0 +1,75 @@
+package futu_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestReconfirmHandler(t *testing.T) {
+	should := require.New(t)
+
+	done := make(chan uint64, 10)
+	m := pbtest.NewMock()
+	m.OnTrdReconfirmOrder(func(req *pb.TrdReconfirmOrderRequest) (*pb.TrdReconfirmOrderResponse, error) {
+		defer func() { done <- req.GetOrderID() }()
+		if req.GetOrderID() == 2 {
+			return nil, errors.New("connection lost")
+		}
+		return &pb.TrdReconfirmOrderResponse{Header: req.GetHeader(), OrderID: proto.Uint64(req.GetOrderID())}, nil
+	})
+
+	needConfirm := func(order *pb.Order) (int32, bool) {
+		return 1, order.GetOrderStatus() == pb.OrderStatus_WaitingSubmit
+	}
+	handler := futu.NewReconfirmHandler(m, needConfirm, time.Second)
+
+	push := func(orderID uint64, status pb.OrderStatus) {
+		should.NoError(handler(&pb.TrdUpdateOrderResponse{
+			Header: &pb.TrdHeader{
+				TrdEnv:    pb.TrdEnv_Real.Enum(),
+				AccID:     proto.Uint64(1),
+				TrdMarket: pb.TrdMarket_HK.Enum(),
+			},
+			Order: &pb.Order{OrderID: proto.Uint64(orderID), OrderStatus: status.Enum()},
+		}))
+	}
+
+	wait := func() uint64 {
+		select {
+		case id := <-done:
+			return id
+		case <-time.After(time.Second):
+			should.Fail("reconfirm timeout")
+			return 0
+		}
+	}
+
+	// pushed repeatedly, reconfirmed once
+	push(1, pb.OrderStatus_WaitingSubmit)
+	push(1, pb.OrderStatus_WaitingSubmit)
+	should.Equal(uint64(1), wait())
+	push(1, pb.OrderStatus_WaitingSubmit)
+
+	// failed, not retried by later pushes
+	push(2, pb.OrderStatus_WaitingSubmit)
+	should.Equal(uint64(2), wait())
+	push(2, pb.OrderStatus_WaitingSubmit)
+
+	// not selected
+	push(3, pb.OrderStatus_Submitted)
+
+	time.Sleep(20 * time.Millisecond)
+	should.Len(done, 0)
+
+	calls := m.TrdReconfirmOrderCalls()
+	should.Len(calls, 2)
+	should.Equal(int32(1), calls[0].GetReconfirmReason())
+	should.Equal(uint64(1), calls[0].GetHeader().GetAccID())
+}
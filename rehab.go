@@ -0,0 +1,171 @@
+package futu
+
+import (
+	"sort"
+
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+func sortedRehabs(rehabs []*pb.Rehab) []*pb.Rehab {
+	sorted := append([]*pb.Rehab{}, rehabs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return datePart(sorted[i].GetTime()) < datePart(sorted[j].GetTime())
+	})
+	return sorted
+}
+
+// adjustPrice adjusts an unadjusted price of date with rehabs sorted by time.
+// Prices before an ex-date are adjusted forward by price*fwdFactorA + fwdFactorB,
+// prices on or after an ex-date backward by price*bwdFactorA + bwdFactorB.
+// before is true if price is of the previous trading day of date, eg: lastClosePrice.
+func adjustPrice(price float64, date string, before bool, rehabs []*pb.Rehab, t pb.RehabType) float64 {
+	switch t {
+	case pb.RehabType_Forward:
+		// from the nearest ex-date after date
+		for _, r := range rehabs {
+			d := datePart(r.GetTime())
+			if d > date || (before && d == date) {
+				price = price*r.GetFwdFactorA() + r.GetFwdFactorB()
+			}
+		}
+	case pb.RehabType_Backward:
+		// from the nearest ex-date on or before date
+		for i := len(rehabs) - 1; i >= 0; i-- {
+			r := rehabs[i]
+			d := datePart(r.GetTime())
+			if d < date || (!before && d == date) {
+				price = price*r.GetBwdFactorA() + r.GetBwdFactorB()
+			}
+		}
+	}
+	return price
+}
+
+// AdjustPrice adjusts an unadjusted price at time tm with rehabs of QotRequestRehab.
+func AdjustPrice(price float64, tm string, rehabs []*pb.Rehab, t pb.RehabType) float64 {
+	return adjustPrice(price, datePart(tm), false, sortedRehabs(rehabs), t)
+}
+
+// AdjustKLines returns copies of unadjusted klines, with prices adjusted forward
+// or backward by rehabs of QotRequestRehab. Volume and turnover are not changed.
+// Klines are returned as is for RehabType_None.
+func AdjustKLines(kls []*pb.KLine, rehabs []*pb.Rehab, t pb.RehabType) []*pb.KLine {
+	if t == pb.RehabType_None {
+		return kls
+	}
+
+	rehabs = sortedRehabs(rehabs)
+	adjusted := make([]*pb.KLine, 0, len(kls))
+
+	for _, kl := range kls {
+		kl = proto.Clone(kl).(*pb.KLine)
+		date := datePart(kl.GetTime())
+
+		for _, p := range []**float64{&kl.OpenPrice, &kl.HighPrice, &kl.LowPrice, &kl.ClosePrice} {
+			if *p != nil {
+				*p = proto.Float64(adjustPrice(**p, date, false, rehabs, t))
+			}
+		}
+		if kl.LastClosePrice != nil {
+			kl.LastClosePrice = proto.Float64(adjustPrice(*kl.LastClosePrice, date, true, rehabs, t))
+		}
+
+		adjusted = append(adjusted, kl)
+	}
+
+	return adjusted
+}
+
+// ShareRatio is Ert shares for every Base shares, eg: 1 split into 5 is {1, 5}.
+type ShareRatio struct {
+	Base float64
+	Ert  float64
+}
+
+// CorporateAction is a Rehab record decoded by companyActFlag.
+// Fields of actions not flagged are zero.
+type CorporateAction struct {
+	Time  string
+	Flags int64 // CompanyAct combined
+
+	Split    ShareRatio
+	Join     ShareRatio
+	Bonus    ShareRatio
+	Transfer ShareRatio
+	SpinOff  ShareRatio
+
+	Allot      ShareRatio
+	AllotPrice float64
+	Add        ShareRatio
+	AddPrice   float64
+
+	Dividend   float64 // per share
+	SpDividend float64 // per share
+}
+
+// NewCorporateAction decodes a Rehab record.
+func NewCorporateAction(r *pb.Rehab) CorporateAction {
+	a := CorporateAction{
+		Time:  r.GetTime(),
+		Flags: r.GetCompanyActFlag(),
+	}
+
+	if a.Has(pb.CompanyAct_Split) {
+		a.Split = ShareRatio{float64(r.GetSplitBase()), float64(r.GetSplitErt())}
+	}
+	if a.Has(pb.CompanyAct_Join) {
+		a.Join = ShareRatio{float64(r.GetJoinBase()), float64(r.GetJoinErt())}
+	}
+	if a.Has(pb.CompanyAct_Bonus) {
+		a.Bonus = ShareRatio{float64(r.GetBonusBase()), float64(r.GetBonusErt())}
+	}
+	if a.Has(pb.CompanyAct_Transfer) {
+		a.Transfer = ShareRatio{float64(r.GetTransferBase()), float64(r.GetTransferErt())}
+	}
+	if a.Has(pb.CompanyAct_SpinOff) {
+		a.SpinOff = ShareRatio{r.GetSpinOffBase(), r.GetSpinOffErt()}
+	}
+	if a.Has(pb.CompanyAct_Allot) {
+		a.Allot = ShareRatio{float64(r.GetAllotBase()), float64(r.GetAllotErt())}
+		a.AllotPrice = r.GetAllotPrice()
+	}
+	if a.Has(pb.CompanyAct_Add) {
+		a.Add = ShareRatio{float64(r.GetAddBase()), float64(r.GetAddErt())}
+		a.AddPrice = r.GetAddPrice()
+	}
+	if a.Has(pb.CompanyAct_Dividend) {
+		a.Dividend = r.GetDividend()
+	}
+	if a.Has(pb.CompanyAct_SPDividend) {
+		a.SpDividend = r.GetSpDividend()
+	}
+
+	return a
+}
+
+// CorporateActions decodes Rehab records, sorted by time.
+func CorporateActions(rehabs []*pb.Rehab) []CorporateAction {
+	actions := []CorporateAction{}
+	for _, r := range sortedRehabs(rehabs) {
+		actions = append(actions, NewCorporateAction(r))
+	}
+	return actions
+}
+
+// Has reports whether act is flagged.
+func (a CorporateAction) Has(act pb.CompanyAct) bool {
+	return a.Flags&int64(act) != 0
+}
+
+// Acts returns the flagged actions.
+func (a CorporateAction) Acts() []pb.CompanyAct {
+	acts := []pb.CompanyAct{}
+	for _, v := range pb.CompanyAct_value {
+		if act := pb.CompanyAct(v); act != pb.CompanyAct_None && a.Has(act) {
+			acts = append(acts, act)
+		}
+	}
+	sort.Slice(acts, func(i, j int) bool { return acts[i] < acts[j] })
+	return acts
+}
@@ -0,0 +1,98 @@
+package futu_test
+
+import (
+	"testing"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAdjustKLines(t *testing.T) {
+	should := require.New(t)
+
+	rehabs := []*pb.Rehab{
+		{ // cash dividend 0.1
+			Time:           proto.String("2024-06-10"),
+			CompanyActFlag: proto.Int64(int64(pb.CompanyAct_Dividend)),
+			FwdFactorA:     proto.Float64(1),
+			FwdFactorB:     proto.Float64(-0.1),
+			BwdFactorA:     proto.Float64(1),
+			BwdFactorB:     proto.Float64(0.1),
+			Dividend:       proto.Float64(0.1),
+		},
+		{ // 1 split into 2
+			Time:           proto.String("2024-05-20"),
+			CompanyActFlag: proto.Int64(int64(pb.CompanyAct_Split)),
+			FwdFactorA:     proto.Float64(0.5),
+			FwdFactorB:     proto.Float64(0),
+			BwdFactorA:     proto.Float64(2),
+			BwdFactorB:     proto.Float64(0),
+			SplitBase:      proto.Int32(1),
+			SplitErt:       proto.Int32(2),
+		},
+	}
+
+	kls := []*pb.KLine{
+		{Time: proto.String("2024-05-17 00:00:00"), ClosePrice: proto.Float64(100), Volume: proto.Int64(10)},
+		{Time: proto.String("2024-05-20 00:00:00"), ClosePrice: proto.Float64(50), LastClosePrice: proto.Float64(100)},
+		{Time: proto.String("2024-06-10 00:00:00"), ClosePrice: proto.Float64(49.9), LastClosePrice: proto.Float64(50)},
+	}
+
+	fwd := futu.AdjustKLines(kls, rehabs, pb.RehabType_Forward)
+	should.InDelta(49.9, fwd[0].GetClosePrice(), 1e-9)
+	should.InDelta(49.9, fwd[1].GetClosePrice(), 1e-9)
+	should.InDelta(49.9, fwd[1].GetLastClosePrice(), 1e-9)
+	should.InDelta(49.9, fwd[2].GetClosePrice(), 1e-9)
+	should.InDelta(49.9, fwd[2].GetLastClosePrice(), 1e-9)
+	should.Equal(int64(10), fwd[0].GetVolume())
+	should.Nil(fwd[0].OpenPrice)
+
+	bwd := futu.AdjustKLines(kls, rehabs, pb.RehabType_Backward)
+	should.InDelta(100, bwd[0].GetClosePrice(), 1e-9)
+	should.InDelta(100, bwd[1].GetClosePrice(), 1e-9)
+	should.InDelta(100, bwd[1].GetLastClosePrice(), 1e-9)
+	should.InDelta(100, bwd[2].GetClosePrice(), 1e-9)
+	should.InDelta(100, bwd[2].GetLastClosePrice(), 1e-9)
+
+	// inputs are not modified
+	should.Equal(50.0, kls[1].GetClosePrice())
+	should.Equal(kls, futu.AdjustKLines(kls, rehabs, pb.RehabType_None))
+
+	should.InDelta(49.9, futu.AdjustPrice(100, "2024-05-17 10:00:00", rehabs, pb.RehabType_Forward), 1e-9)
+}
+
+func TestCorporateActions(t *testing.T) {
+	should := require.New(t)
+
+	actions := futu.CorporateActions([]*pb.Rehab{
+		{
+			Time:           proto.String("2024-06-10"),
+			CompanyActFlag: proto.Int64(int64(pb.CompanyAct_Dividend | pb.CompanyAct_Allot)),
+			AllotBase:      proto.Int32(10),
+			AllotErt:       proto.Int32(2),
+			AllotPrice:     proto.Float64(6.3),
+			Dividend:       proto.Float64(0.05),
+			SplitBase:      proto.Int32(1), // not flagged
+		},
+		{
+			Time:           proto.String("2024-05-20"),
+			CompanyActFlag: proto.Int64(int64(pb.CompanyAct_Bonus)),
+			BonusBase:      proto.Int32(10),
+			BonusErt:       proto.Int32(3),
+		},
+	})
+
+	should.Len(actions, 2)
+	should.Equal("2024-05-20", actions[0].Time)
+	should.Equal(futu.ShareRatio{Base: 10, Ert: 3}, actions[0].Bonus)
+
+	a := actions[1]
+	should.Equal([]pb.CompanyAct{pb.CompanyAct_Allot, pb.CompanyAct_Dividend}, a.Acts())
+	should.Equal(futu.ShareRatio{Base: 10, Ert: 2}, a.Allot)
+	should.Equal(6.3, a.AllotPrice)
+	should.Equal(0.05, a.Dividend)
+	should.Zero(a.Split)
+	should.False(a.Has(pb.CompanyAct_Split))
+}
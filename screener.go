@@ -0,0 +1,828 @@
+package futu
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// Screener is a stock filter query compiled to QotStockFilterRequest:
+//
+//	market=HK plate=HK.BK1001 pe_ttm between 5 and 20 and change_rate_5d > 3 sort market_val desc
+//
+// Clauses are separated by spaces or "and":
+//
+//	market=HK                        market, required
+//	plate=HK.BK1001                  plate
+//	pe_ttm between 5 and 20          range of a field, closed: > is >= and < is <=
+//	pe_ttm > 5                       field compared with a number
+//	pb_rate                          field returned without filtering
+//	sort market_val desc             sort by a field, asc by default
+//	limit 100                        at most 100 rows
+//	ma(5) crossup ma(20)@day for 2   custom indicators: > < crossup crossdown
+//	kdj_k(9,3,3)@60min > 80          custom indicator compared with a number
+//	macd_gold_cross_low@week for 3   pattern
+//
+// Fields are StockField, AccumulateField with days, eg: change_rate_5d or
+// change_rate(5), FinancialField with quarter, eg: net_profit(annual) by
+// default annual, CustomIndicatorField with params and PatternField.
+// Names are enum names case insensitive, with or without underscores.
+// Indicators and patterns are of the klType after @, day by default,
+// and consecutive periods after "for".
+type Screener struct {
+	Request *pb.QotStockFilterRequest
+	Limit   int // 0 for all
+
+	base       map[pb.StockField]string
+	accumulate map[[2]int32]string // field, days
+	financial  map[[2]int32]string // field, quarter
+	indicator  map[string]string   // by indicatorKey
+}
+
+// ParseScreener compiles query, errors wrap ErrInvalidQuery.
+func ParseScreener(query string) (*Screener, error) {
+	tokens, err := lexQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queryParser{
+		tokens: tokens,
+		s: &Screener{
+			Request: &pb.QotStockFilterRequest{
+				Begin: proto.Int32(0),
+				Num:   proto.Int32(pageSize(pb.ProtoId_QotStockFilter, 0)),
+			},
+			base:       map[pb.StockField]string{},
+			accumulate: map[[2]int32]string{},
+			financial:  map[[2]int32]string{},
+			indicator:  map[string]string{},
+		},
+		bases:       map[pb.StockField]*pb.BaseFilter{},
+		accumulates: map[[2]int32]*pb.AccumulateFilter{},
+		financials:  map[[2]int32]*pb.FinancialFilter{},
+	}
+
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	if err := ValidateStockFilter(p.s.Request); err != nil {
+		return nil, err
+	}
+	return p.s, nil
+}
+
+// ValidateStockFilter checks ranges of filters of req, which pb validation
+// leaves to OpenD: a filtered field requires filterMin or filterMax, not
+// greater than filterMax, at most one field is sorted, and MA and EMA
+// indicators require a period.
+func ValidateStockFilter(req *pb.QotStockFilterRequest) error {
+	sorted := 0
+	check := func(name string, noFilter *bool, fmin, fmax *float64, dir pb.SortDir) error {
+		if dir != pb.SortDir_No {
+			sorted++
+		}
+		if noFilter == nil || *noFilter {
+			return nil
+		}
+		if fmin == nil && fmax == nil {
+			return &responseError{
+				Code:    err_FilterMinMaxRequired,
+				ProtoId: pb.ProtoId_QotStockFilter,
+				RetType: pb.RetType_Failed,
+				RetMsg:  "filter field " + name + " needs to set the range",
+			}
+		}
+		if fmin != nil && fmax != nil && *fmin > *fmax {
+			return fmt.Errorf("%w: %s min %v greater than max %v", ErrInvalidQuery, name, *fmin, *fmax)
+		}
+		return nil
+	}
+
+	if req.Market == nil {
+		return fmt.Errorf("%w: market required", ErrInvalidQuery)
+	}
+	for _, f := range req.GetBaseFilterList() {
+		if err := check(f.GetFieldName().Name(), f.IsNoFilter, f.FilterMin, f.FilterMax, f.GetSortDir()); err != nil {
+			return err
+		}
+	}
+	for _, f := range req.GetAccumulateFilterList() {
+		if f.GetDays() <= 0 {
+			return fmt.Errorf("%w: %s days %d", ErrInvalidQuery, f.GetFieldName().Name(), f.GetDays())
+		}
+		if err := check(f.GetFieldName().Name(), f.IsNoFilter, f.FilterMin, f.FilterMax, f.GetSortDir()); err != nil {
+			return err
+		}
+	}
+	for _, f := range req.GetFinancialFilterList() {
+		if err := check(f.GetFieldName().Name(), f.IsNoFilter, f.FilterMin, f.FilterMax, f.GetSortDir()); err != nil {
+			return err
+		}
+	}
+	if sorted > 1 {
+		return fmt.Errorf("%w: more than one sorted field", ErrInvalidQuery)
+	}
+
+	checkKL := func(name string, kl pb.KLType, period *int32) error {
+		switch kl {
+		case pb.KLType_60Min, pb.KLType_Day, pb.KLType_Week, pb.KLType_Month:
+		default:
+			return fmt.Errorf("%w: %s klType %s", ErrInvalidQuery, name, kl.Name())
+		}
+		if period != nil && (*period < 1 || *period > 12) {
+			return fmt.Errorf("%w: %s consecutive period %d", ErrInvalidQuery, name, *period)
+		}
+		return nil
+	}
+	checkParams := func(f pb.CustomIndicatorField, params []int32) error {
+		switch f {
+		case pb.CustomIndicatorField_MA, pb.CustomIndicatorField_EMA:
+			if len(params) != 1 || params[0] <= 0 {
+				return fmt.Errorf("%w: %s period required, eg: %s(20)",
+					ErrInvalidQuery, f.Name(), strings.ToLower(f.Name()))
+			}
+		}
+		return nil
+	}
+	for _, f := range req.GetCustomIndicatorFilterList() {
+		if err := checkKL(f.GetFirstFieldName().Name(), f.GetKlType(), f.ConsecutivePeriod); err != nil {
+			return err
+		}
+		if err := checkParams(f.GetFirstFieldName(), f.GetFirstFieldParaList()); err != nil {
+			return err
+		}
+		if err := checkParams(f.GetSecondFieldName(), f.GetSecondFieldParaList()); err != nil {
+			return err
+		}
+	}
+	for _, f := range req.GetPatternFilterList() {
+		if err := checkKL(f.GetFieldName().Name(), f.GetKlType(), f.ConsecutivePeriod); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rows pages through all results, up to Limit, as maps keyed by field names
+// of the query, with "code", eg: HK.00700, and "name".
+func (s *Screener) Rows(ctx context.Context, rh pb.RequestHandler) ([]map[string]any, error) {
+	rows := []map[string]any{}
+	for data, err := range StockFilter(ctx, rh, s.Request) {
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, s.Row(data))
+		if s.Limit > 0 && len(rows) >= s.Limit {
+			break
+		}
+	}
+	return rows, nil
+}
+
+// Row converts a result to a map keyed by field names of the query.
+func (s *Screener) Row(data *pb.StockData) map[string]any {
+	row := map[string]any{
+		"code": NewSecurityCode(data.GetSecurity()),
+		"name": data.GetName(),
+	}
+
+	key := func(k string, ok bool, name string) string {
+		if ok {
+			return k
+		}
+		return name
+	}
+
+	for _, d := range data.GetBaseDataList() {
+		k, ok := s.base[d.GetFieldName()]
+		row[key(k, ok, d.GetFieldName().Name())] = d.GetValue()
+	}
+	for _, d := range data.GetAccumulateDataList() {
+		k, ok := s.accumulate[[2]int32{int32(d.GetFieldName()), d.GetDays()}]
+		row[key(k, ok, d.GetFieldName().Name())] = d.GetValue()
+	}
+	for _, d := range data.GetFinancialDataList() {
+		k, ok := s.financial[[2]int32{int32(d.GetFieldName()), int32(d.GetQuarter())}]
+		row[key(k, ok, d.GetFieldName().Name())] = d.GetValue()
+	}
+	for _, d := range data.GetCustomIndicatorDataList() {
+		k, ok := s.indicator[indicatorKey(d.GetFieldName(), d.GetKlType(), d.GetFieldParaList())]
+		row[key(k, ok, d.GetFieldName().Name())] = d.GetValue()
+	}
+
+	return row
+}
+
+// indicatorDefaults are the params OpenD uses and returns in
+// CustomIndicatorData when fieldParaList is empty.
+var indicatorDefaults = map[pb.CustomIndicatorField][]int32{
+	pb.CustomIndicatorField_RSI:          {12},
+	pb.CustomIndicatorField_MACD_DIFF:    {12, 26, 9},
+	pb.CustomIndicatorField_MACD_DEA:     {12, 26, 9},
+	pb.CustomIndicatorField_MACD:         {12, 26, 9},
+	pb.CustomIndicatorField_BOLL_UPPER:   {20, 2},
+	pb.CustomIndicatorField_BOLL_MIDDLER: {20, 2},
+	pb.CustomIndicatorField_BOLL_LOWER:   {20, 2},
+	pb.CustomIndicatorField_KDJ_K:        {9, 3, 3},
+	pb.CustomIndicatorField_KDJ_D:        {9, 3, 3},
+	pb.CustomIndicatorField_KDJ_J:        {9, 3, 3},
+}
+
+func indicatorKey(f pb.CustomIndicatorField, kl pb.KLType, params []int32) string {
+	if len(params) == 0 {
+		params = indicatorDefaults[f]
+	}
+	return fmt.Sprint(f, kl, params)
+}
+
+type tokenKind int
+
+const (
+	tokWord tokenKind = iota
+	tokNumber
+	tokOp // = > >= < <= ( ) , @
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	num  float64
+}
+
+// decimalLiteral excludes what else ParseFloat accepts, eg: inf, nan, 0x1p3, 1_000.
+var decimalLiteral = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)([eE][+-]?\d+)?$`)
+
+func lexQuery(query string) ([]token, error) {
+	tokens := []token{}
+	isWord := func(c byte) bool {
+		return c == '_' || c == '.' || c == '-' || c == '+' ||
+			'0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+	}
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '>' || c == '<':
+			if i+1 < len(query) && query[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokOp, text: query[i : i+2]})
+				i += 2
+			} else {
+				tokens = append(tokens, token{kind: tokOp, text: query[i : i+1]})
+				i++
+			}
+		case strings.IndexByte("=(),@", c) >= 0:
+			tokens = append(tokens, token{kind: tokOp, text: query[i : i+1]})
+			i++
+		case isWord(c):
+			j := i
+			for j < len(query) && isWord(query[j]) {
+				j++
+			}
+			text := query[i:j]
+			if decimalLiteral.MatchString(text) {
+				n, err := strconv.ParseFloat(text, 64)
+				if err != nil {
+					return nil, fmt.Errorf("%w: number %q", ErrInvalidQuery, text)
+				}
+				tokens = append(tokens, token{kind: tokNumber, text: text, num: n})
+			} else {
+				tokens = append(tokens, token{kind: tokWord, text: text})
+			}
+			i = j
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q at %d", ErrInvalidQuery, c, i)
+		}
+	}
+	return tokens, nil
+}
+
+type operandKind int
+
+const (
+	operandBase operandKind = iota
+	operandAccumulate
+	operandFinancial
+	operandIndicator
+	operandPattern
+)
+
+// operand is a field reference of a query.
+type operand struct {
+	kind    operandKind
+	key     string // as in the query
+	base    pb.StockField
+	acc     pb.AccumulateField
+	days    int32
+	fin     pb.FinancialField
+	quarter pb.FinancialQuarter
+	ind     pb.CustomIndicatorField
+	params  []int32
+	pattern pb.PatternField
+	klType  *pb.KLType // set by @
+}
+
+type queryParser struct {
+	tokens []token
+	pos    int
+	s      *Screener
+
+	bases       map[pb.StockField]*pb.BaseFilter
+	accumulates map[[2]int32]*pb.AccumulateFilter
+	financials  map[[2]int32]*pb.FinancialFilter
+}
+
+func (p *queryParser) peek() (token, bool) {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos], true
+	}
+	return token{}, false
+}
+
+func (p *queryParser) next() (token, error) {
+	t, ok := p.peek()
+	if !ok {
+		return t, fmt.Errorf("%w: unexpected end", ErrInvalidQuery)
+	}
+	p.pos++
+	return t, nil
+}
+
+// keyword consumes the next token if it is one of words.
+func (p *queryParser) keyword(words ...string) (string, bool) {
+	t, ok := p.peek()
+	if !ok || t.kind != tokWord {
+		return "", false
+	}
+	for _, w := range words {
+		if strings.EqualFold(t.text, w) {
+			p.pos++
+			return w, true
+		}
+	}
+	return "", false
+}
+
+// op consumes the next token if it is an operator of ops.
+func (p *queryParser) op(ops ...string) (string, bool) {
+	t, ok := p.peek()
+	if !ok || t.kind != tokOp {
+		return "", false
+	}
+	for _, o := range ops {
+		if t.text == o {
+			p.pos++
+			return o, true
+		}
+	}
+	return "", false
+}
+
+func (p *queryParser) expectOp(o string) error {
+	if _, ok := p.op(o); !ok {
+		t, _ := p.peek()
+		return fmt.Errorf("%w: %q expected, got %q", ErrInvalidQuery, o, t.text)
+	}
+	return nil
+}
+
+func (p *queryParser) number() (float64, error) {
+	t, err := p.next()
+	if err != nil {
+		return 0, err
+	}
+	if t.kind != tokNumber {
+		return 0, fmt.Errorf("%w: number expected, got %q", ErrInvalidQuery, t.text)
+	}
+	return t.num, nil
+}
+
+func (p *queryParser) word() (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if t.kind == tokOp {
+		return "", fmt.Errorf("%w: name expected, got %q", ErrInvalidQuery, t.text)
+	}
+	return t.text, nil
+}
+
+func (p *queryParser) parse() error {
+	for {
+		if _, ok := p.peek(); !ok {
+			return nil
+		}
+		if _, ok := p.keyword("and"); ok {
+			continue
+		}
+		if err := p.clause(); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *queryParser) clause() error {
+	req := p.s.Request
+
+	if kw, ok := p.keyword("market", "plate", "sort", "limit"); ok {
+		switch kw {
+		case "market":
+			if err := p.expectOp("="); err != nil {
+				return err
+			}
+			w, err := p.word()
+			if err != nil {
+				return err
+			}
+			m, err := pb.ParseQotMarket(w)
+			if err != nil {
+				return fmt.Errorf("%w: market %q", ErrInvalidQuery, w)
+			}
+			req.Market = m.Enum()
+
+		case "plate":
+			if err := p.expectOp("="); err != nil {
+				return err
+			}
+			w, err := p.word()
+			if err != nil {
+				return err
+			}
+			plate := NewSecurity(strings.ToUpper(w))
+			if plate == nil || plate.GetMarket() == pb.QotMarket_Unknown {
+				return fmt.Errorf("%w: plate %q", ErrInvalidQuery, w)
+			}
+			req.Plate = plate
+
+		case "sort":
+			o, err := p.operand()
+			if err != nil {
+				return err
+			}
+			dir := pb.SortDir_Ascend
+			if _, ok := p.keyword("desc"); ok {
+				dir = pb.SortDir_Descend
+			} else {
+				p.keyword("asc")
+			}
+			return p.sort(o, dir)
+
+		case "limit":
+			n, err := p.number()
+			if err != nil {
+				return err
+			}
+			if n <= 0 || n != float64(int(n)) {
+				return fmt.Errorf("%w: limit %v", ErrInvalidQuery, n)
+			}
+			p.s.Limit = int(n)
+		}
+		return nil
+	}
+
+	o, err := p.operand()
+	if err != nil {
+		return err
+	}
+
+	switch o.kind {
+	case operandIndicator:
+		return p.indicator(o)
+	case operandPattern:
+		period, err := p.period()
+		if err != nil {
+			return err
+		}
+		p.s.Request.AddPatternFilter(&pb.PatternFilter{
+			FieldName:         o.pattern.Enum(),
+			KlType:            klTypeOf(o).Enum(),
+			IsNoFilter:        proto.Bool(false),
+			ConsecutivePeriod: period,
+		})
+		return nil
+	}
+
+	fmin, fmax, filtered, err := p.rangeCond()
+	if err != nil {
+		return err
+	}
+	p.filter(o, fmin, fmax, filtered)
+	return nil
+}
+
+// rangeCond parses an optional condition of a base, accumulate or financial field.
+func (p *queryParser) rangeCond() (fmin, fmax *float64, filtered bool, err error) {
+	if _, ok := p.keyword("between"); ok {
+		a, err := p.number()
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if _, ok := p.keyword("and"); !ok {
+			return nil, nil, false, fmt.Errorf("%w: between without and", ErrInvalidQuery)
+		}
+		b, err := p.number()
+		if err != nil {
+			return nil, nil, false, err
+		}
+		return &a, &b, true, nil
+	}
+
+	o, ok := p.op(">", ">=", "<", "<=", "=")
+	if !ok {
+		return nil, nil, false, nil
+	}
+	n, err := p.number()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	switch o {
+	case ">", ">=":
+		return &n, nil, true, nil
+	case "<", "<=":
+		return nil, &n, true, nil
+	}
+	return &n, &n, true, nil
+}
+
+func (p *queryParser) period() (*int32, error) {
+	if _, ok := p.keyword("for"); !ok {
+		return nil, nil
+	}
+	n, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	if n != float64(int32(n)) {
+		return nil, fmt.Errorf("%w: for period %v", ErrInvalidQuery, n)
+	}
+	return proto.Int32(int32(n)), nil
+}
+
+var accumulateDays = regexp.MustCompile(`^(.+?)_?(\d+)d$`)
+
+// operand parses a field: name[(params)][@klType].
+func (p *queryParser) operand() (*operand, error) {
+	name, err := p.word()
+	if err != nil {
+		return nil, err
+	}
+	start := p.pos - 1
+
+	args := []token{}
+	if _, ok := p.op("("); ok {
+		for {
+			t, err := p.next()
+			if err != nil {
+				return nil, err
+			}
+			if t.kind == tokOp {
+				return nil, fmt.Errorf("%w: %s param expected, got %q", ErrInvalidQuery, name, t.text)
+			}
+			args = append(args, t)
+			if _, ok := p.op(","); ok {
+				continue
+			}
+			if err := p.expectOp(")"); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	o := &operand{}
+	if _, ok := p.op("@"); ok {
+		w, err := p.word()
+		if err != nil {
+			return nil, err
+		}
+		kl, err := pb.ParseKLType(w)
+		if err != nil {
+			return nil, fmt.Errorf("%w: klType %q", ErrInvalidQuery, w)
+		}
+		o.klType = &kl
+	}
+
+	texts := []string{}
+	for _, t := range p.tokens[start:p.pos] {
+		texts = append(texts, t.text)
+	}
+	o.key = strings.ToLower(strings.Join(texts, ""))
+
+	ints := func() ([]int32, error) {
+		list := []int32{}
+		for _, t := range args {
+			if t.kind != tokNumber || t.num != float64(int32(t.num)) {
+				return nil, fmt.Errorf("%w: %s param %q", ErrInvalidQuery, name, t.text)
+			}
+			list = append(list, int32(t.num))
+		}
+		return list, nil
+	}
+
+	if f, err := pb.ParseStockField(name); err == nil && f != pb.StockField_Unknown && len(args) == 0 {
+		o.kind, o.base = operandBase, f
+		return o, nil
+	}
+
+	accName, days := name, int32(0)
+	if m := accumulateDays.FindStringSubmatch(name); m != nil && len(args) == 0 {
+		if _, err := pb.ParseAccumulateField(m[1]); err == nil {
+			accName = m[1]
+			n, _ := strconv.Atoi(m[2])
+			days = int32(n)
+		}
+	}
+	if f, err := pb.ParseAccumulateField(accName); err == nil && f != pb.AccumulateField_Unknown {
+		if len(args) > 0 {
+			list, err := ints()
+			if err != nil {
+				return nil, err
+			}
+			if len(list) != 1 {
+				return nil, fmt.Errorf("%w: %s days expected", ErrInvalidQuery, name)
+			}
+			days = list[0]
+		}
+		if days == 0 {
+			return nil, fmt.Errorf("%w: %s days required, eg: %s_5d", ErrInvalidQuery, name, name)
+		}
+		o.kind, o.acc, o.days = operandAccumulate, f, days
+		return o, nil
+	}
+
+	if f, err := pb.ParseFinancialField(name); err == nil && f != pb.FinancialField_Unknown {
+		o.kind, o.fin, o.quarter = operandFinancial, f, pb.FinancialQuarter_Annual
+		if len(args) > 1 {
+			return nil, fmt.Errorf("%w: %s quarter expected", ErrInvalidQuery, name)
+		}
+		if len(args) == 1 {
+			q, err := pb.ParseFinancialQuarter(args[0].text)
+			if err != nil || q == pb.FinancialQuarter_Unknown {
+				return nil, fmt.Errorf("%w: %s quarter %q", ErrInvalidQuery, name, args[0].text)
+			}
+			o.quarter = q
+		}
+		return o, nil
+	}
+
+	if f, err := pb.ParseCustomIndicatorField(name); err == nil &&
+		f != pb.CustomIndicatorField_Unknown && f != pb.CustomIndicatorField_Value {
+		list, err := ints()
+		if err != nil {
+			return nil, err
+		}
+		o.kind, o.ind, o.params = operandIndicator, f, list
+		return o, nil
+	}
+
+	if f, err := pb.ParsePatternField(name); err == nil && f != pb.PatternField_Unknown && len(args) == 0 {
+		o.kind, o.pattern = operandPattern, f
+		return o, nil
+	}
+
+	return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidQuery, o.key)
+}
+
+func klTypeOf(o *operand) pb.KLType {
+	if o.klType != nil {
+		return *o.klType
+	}
+	return pb.KLType_Day
+}
+
+// filter adds or merges the filter of a base, accumulate or financial field.
+func (p *queryParser) filter(o *operand, fmin, fmax *float64, filtered bool) {
+	merge := func(noFilter **bool, curMin, curMax **float64) {
+		if *noFilter == nil {
+			*noFilter = proto.Bool(true)
+		}
+		if !filtered {
+			return
+		}
+		*noFilter = proto.Bool(false)
+		// conditions on the same field intersect
+		if fmin != nil && (*curMin == nil || *fmin > **curMin) {
+			*curMin = fmin
+		}
+		if fmax != nil && (*curMax == nil || *fmax < **curMax) {
+			*curMax = fmax
+		}
+	}
+
+	req := p.s.Request
+	switch o.kind {
+	case operandBase:
+		f, ok := p.bases[o.base]
+		if !ok {
+			f = &pb.BaseFilter{FieldName: o.base.Enum()}
+			p.bases[o.base] = f
+			req.AddBaseFilter(f)
+			p.s.base[o.base] = o.key
+		}
+		merge(&f.IsNoFilter, &f.FilterMin, &f.FilterMax)
+
+	case operandAccumulate:
+		k := [2]int32{int32(o.acc), o.days}
+		f, ok := p.accumulates[k]
+		if !ok {
+			f = &pb.AccumulateFilter{FieldName: o.acc.Enum(), Days: proto.Int32(o.days)}
+			p.accumulates[k] = f
+			req.AddAccumulateFilter(f)
+			p.s.accumulate[k] = o.key
+		}
+		merge(&f.IsNoFilter, &f.FilterMin, &f.FilterMax)
+
+	case operandFinancial:
+		k := [2]int32{int32(o.fin), int32(o.quarter)}
+		f, ok := p.financials[k]
+		if !ok {
+			f = &pb.FinancialFilter{FieldName: o.fin.Enum(), Quarter: o.quarter.Enum()}
+			p.financials[k] = f
+			req.AddFinancialFilter(f)
+			p.s.financial[k] = o.key
+		}
+		merge(&f.IsNoFilter, &f.FilterMin, &f.FilterMax)
+	}
+}
+
+func (p *queryParser) sort(o *operand, dir pb.SortDir) error {
+	p.filter(o, nil, nil, false)
+
+	switch o.kind {
+	case operandBase:
+		p.bases[o.base].SortDir = dir.Enum()
+	case operandAccumulate:
+		p.accumulates[[2]int32{int32(o.acc), o.days}].SortDir = dir.Enum()
+	case operandFinancial:
+		p.financials[[2]int32{int32(o.fin), int32(o.quarter)}].SortDir = dir.Enum()
+	default:
+		return fmt.Errorf("%w: %s can not be sorted", ErrInvalidQuery, o.key)
+	}
+	return nil
+}
+
+// indicator parses the comparison of a custom indicator, of the klType
+// after @ of either operand.
+func (p *queryParser) indicator(first *operand) error {
+	f := &pb.CustomIndicatorFilter{
+		FirstFieldName:     first.ind.Enum(),
+		FirstFieldParaList: first.params,
+		KlType:             klTypeOf(first).Enum(),
+		IsNoFilter:         proto.Bool(false),
+	}
+
+	if o, ok := p.op(">", "<"); ok {
+		f.RelativePosition = pb.RelativePosition_More.Enum()
+		if o == "<" {
+			f.RelativePosition = pb.RelativePosition_Less.Enum()
+		}
+	} else if kw, ok := p.keyword("crossup", "cross_up", "crossdown", "cross_down"); ok {
+		f.RelativePosition = pb.RelativePosition_CrossUp.Enum()
+		if strings.HasPrefix(kw, "crossd") || strings.HasPrefix(kw, "cross_d") {
+			f.RelativePosition = pb.RelativePosition_CrossDown.Enum()
+		}
+	} else {
+		return fmt.Errorf("%w: %s requires >, <, crossup or crossdown", ErrInvalidQuery, first.key)
+	}
+
+	if t, ok := p.peek(); ok && t.kind == tokNumber {
+		p.pos++
+		f.SecondFieldName = pb.CustomIndicatorField_Value.Enum()
+		f.FieldValue = proto.Float64(t.num)
+	} else {
+		second, err := p.operand()
+		if err != nil {
+			return err
+		}
+		if second.kind != operandIndicator {
+			return fmt.Errorf("%w: %s is not a custom indicator", ErrInvalidQuery, second.key)
+		}
+		if second.klType != nil {
+			if first.klType != nil && *first.klType != *second.klType {
+				return fmt.Errorf("%w: klType of %s and %s differ", ErrInvalidQuery, first.key, second.key)
+			}
+			f.KlType = second.klType.Enum()
+		}
+		f.SecondFieldName = second.ind.Enum()
+		f.SecondFieldParaList = second.params
+		p.s.indicator[indicatorKey(second.ind, f.GetKlType(), second.params)] = second.key
+	}
+
+	period, err := p.period()
+	if err != nil {
+		return err
+	}
+	f.ConsecutivePeriod = period
+
+	p.s.indicator[indicatorKey(first.ind, f.GetKlType(), first.params)] = first.key
+	p.s.Request.AddCustomIndicatorFilter(f)
+	return nil
+}
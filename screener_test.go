@@ -0,0 +1,178 @@
+package futu_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseScreener(t *testing.T) {
+	should := require.New(t)
+
+	s, err := futu.ParseScreener("market=HK plate=HK.BK1001 pe_ttm between 5 and 20 and change_rate_5d > 3 sort market_val desc limit 50")
+	should.NoError(err)
+	should.Equal(50, s.Limit)
+
+	req := s.Request
+	should.Equal(pb.QotMarket_HK_Security, req.GetMarket())
+	should.Equal("BK1001", req.GetPlate().GetCode())
+
+	should.Len(req.GetBaseFilterList(), 2)
+	pe := req.GetBaseFilterList()[0]
+	should.Equal(pb.StockField_PeTTM, pe.GetFieldName())
+	should.Equal(5.0, pe.GetFilterMin())
+	should.Equal(20.0, pe.GetFilterMax())
+	should.False(pe.GetIsNoFilter())
+
+	mv := req.GetBaseFilterList()[1]
+	should.Equal(pb.StockField_MarketVal, mv.GetFieldName())
+	should.True(mv.GetIsNoFilter())
+	should.Equal(pb.SortDir_Descend, mv.GetSortDir())
+
+	acc := req.GetAccumulateFilterList()[0]
+	should.Equal(pb.AccumulateField_ChangeRate, acc.GetFieldName())
+	should.Equal(int32(5), acc.GetDays())
+	should.Equal(3.0, acc.GetFilterMin())
+	should.Nil(acc.FilterMax)
+
+	should.NoError(req.Validate())
+
+	// conditions on the same field intersect
+	s, err = futu.ParseScreener("market=US cur_price > 10 and cur_price >= 20 and cur_price < 100 " +
+		"net_profit_growth(most_recent_quarter) > 0 change_rate(20) roic " +
+		"ma(5) crossup ma(20)@week for 2 kdj_k@60min > 80 macd_gold_cross_low@day")
+	should.NoError(err)
+	req = s.Request
+
+	should.Len(req.GetBaseFilterList(), 1)
+	should.Equal(20.0, req.GetBaseFilterList()[0].GetFilterMin())
+	should.Equal(100.0, req.GetBaseFilterList()[0].GetFilterMax())
+
+	should.Len(req.GetFinancialFilterList(), 2)
+	should.Equal(pb.FinancialQuarter_MostRecentQuarter, req.GetFinancialFilterList()[0].GetQuarter())
+	should.Equal(pb.FinancialField_ROIC, req.GetFinancialFilterList()[1].GetFieldName())
+	should.Equal(pb.FinancialQuarter_Annual, req.GetFinancialFilterList()[1].GetQuarter())
+	should.True(req.GetFinancialFilterList()[1].GetIsNoFilter())
+	should.Equal(int32(20), req.GetAccumulateFilterList()[0].GetDays())
+
+	should.Len(req.GetCustomIndicatorFilterList(), 2)
+	ma := req.GetCustomIndicatorFilterList()[0]
+	should.Equal(pb.CustomIndicatorField_MA, ma.GetFirstFieldName())
+	should.Equal([]int32{5}, ma.GetFirstFieldParaList())
+	should.Equal(pb.CustomIndicatorField_MA, ma.GetSecondFieldName())
+	should.Equal([]int32{20}, ma.GetSecondFieldParaList())
+	should.Equal(pb.RelativePosition_CrossUp, ma.GetRelativePosition())
+	should.Equal(pb.KLType_Week, ma.GetKlType())
+	should.Equal(int32(2), ma.GetConsecutivePeriod())
+
+	kdj := req.GetCustomIndicatorFilterList()[1]
+	should.Equal(pb.CustomIndicatorField_Value, kdj.GetSecondFieldName())
+	should.Equal(80.0, kdj.GetFieldValue())
+	should.Equal(pb.KLType_60Min, kdj.GetKlType())
+
+	should.Equal(pb.PatternField_MACDGoldCrossLow, req.GetPatternFilterList()[0].GetFieldName())
+	should.Equal(pb.KLType_Day, req.GetPatternFilterList()[0].GetKlType())
+	should.NoError(req.Validate())
+
+	for _, query := range []string{
+		"pe_ttm > 5",
+		"market=XX",
+		"market=HK pe_ttm between 20 and 5",
+		"market=HK change_rate > 3",
+		"market=HK foo > 3",
+		"market=HK ma(5) > pe_ttm",
+		"market=HK ma(5) > ma(10)@1min",
+		"market=HK ma(5) > ma(10)@day for 13",
+		"market=HK ma(5) > ma(10)@day for 2.5",
+		"market=HK sort pe_ttm sort pb_rate",
+		"market=HK pe_ttm > 5 $",
+		"market=HK ma > 5",
+		"market=HK ema(20) > ema",
+		"market=HK pe_ttm > inf",
+		"market=HK pe_ttm < nan",
+		"market=HK pe_ttm > 0x10",
+		"market=HK pe_ttm > 1e999",
+	} {
+		_, err := futu.ParseScreener(query)
+		should.ErrorIs(err, futu.ErrInvalidQuery, query)
+	}
+}
+
+func TestValidateStockFilter(t *testing.T) {
+	should := require.New(t)
+
+	req := (&pb.QotStockFilterRequest{}).
+		WithBegin(0).WithMarket(pb.QotMarket_HK_Security).
+		AddBaseFilter(pb.NewBaseFilter(pb.StockField_CurPrice).WithIsNoFilter(false))
+
+	err := futu.ValidateStockFilter(req)
+	should.True(errors.Is(err, futu.ErrFilterMinMaxRequired))
+	should.Contains(err.Error(), "CurPrice")
+
+	req.GetBaseFilterList()[0].FilterMin = proto.Float64(10)
+	should.NoError(futu.ValidateStockFilter(req))
+}
+
+func TestScreenerRows(t *testing.T) {
+	should := require.New(t)
+
+	m := pbtest.NewMock()
+	m.OnQotStockFilter(func(req *pb.QotStockFilterRequest) (*pb.QotStockFilterResponse, error) {
+		data := []*pb.StockData{}
+		for i := req.GetBegin(); i < min(req.GetBegin()+2, 3); i++ {
+			data = append(data, &pb.StockData{
+				Security: futu.NewSecurity("HK.0070" + string(rune('0'+i))),
+				Name:     proto.String("stock"),
+				BaseDataList: []*pb.BaseData{
+					{FieldName: pb.StockField_PeTTM.Enum(), Value: proto.Float64(10 + float64(i))},
+				},
+				AccumulateDataList: []*pb.AccumulateData{
+					{FieldName: pb.AccumulateField_ChangeRate.Enum(), Value: proto.Float64(4), Days: proto.Int32(5)},
+				},
+				CustomIndicatorDataList: []*pb.CustomIndicatorData{
+					{FieldName: pb.CustomIndicatorField_RSI.Enum(), Value: proto.Float64(30),
+						KlType: pb.KLType_Day.Enum(), FieldParaList: []int32{12}},
+				},
+			})
+		}
+		return &pb.QotStockFilterResponse{
+			LastPage: proto.Bool(req.GetBegin()+2 >= 3),
+			AllCount: proto.Int32(3),
+			DataList: data,
+		}, nil
+	})
+
+	s, err := futu.ParseScreener("market=HK pe_ttm between 5 and 20 change_rate_5d > 3 rsi(12) < 40")
+	should.NoError(err)
+	s.Request.Num = proto.Int32(2)
+
+	rows, err := s.Rows(context.Background(), m)
+	should.NoError(err)
+	should.Len(rows, 3)
+	should.Equal(map[string]any{
+		"code":           "HK.00702",
+		"name":           "stock",
+		"pe_ttm":         12.0,
+		"change_rate_5d": 4.0,
+		"rsi(12)":        30.0,
+	}, rows[2])
+	should.Len(m.QotStockFilterCalls(), 2)
+
+	s.Limit = 1
+	rows, err = s.Rows(context.Background(), m)
+	should.NoError(err)
+	should.Len(rows, 1)
+
+	// params omitted, returned as the defaults of OpenD
+	s, err = futu.ParseScreener("market=HK rsi < 40")
+	should.NoError(err)
+	rows, err = s.Rows(context.Background(), m)
+	should.NoError(err)
+	should.Equal(30.0, rows[0]["rsi"])
+}
@@ -0,0 +1,399 @@
+package futu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// OpenD rejects unsubscribing within one minute after subscribing.
+const MinSubDuration = time.Minute
+
+type subKey struct {
+	market  pb.QotMarket
+	code    string
+	subType pb.SubType
+}
+
+type subEntry struct {
+	refs          int
+	subscribedAt  time.Time
+	unsubscribing bool // QotSub in flight
+}
+
+// Subscriptions shares quote subscriptions among strategies on one connection.
+// Each (security, SubType) is ref-counted, subscribed on first Subscribe and
+// unsubscribed when the last handle is released, deferred until
+// MinSubDuration has passed since subscribing.
+//
+// Each (security, SubType) takes 1 subscription quota, whatever the SubType.
+type Subscriptions struct {
+	rh          pb.RequestHandler
+	minDuration time.Duration
+
+	ioMutex    sync.Mutex // serializes requests, locked before mutex
+	mutex      sync.Mutex // guards states below, not held during requests
+	entries    map[subKey]*subEntry
+	timer      *time.Timer
+	quotaKnown bool
+	usedQuota  int32 // of OpenD, all connections
+	remain     int32
+	closed     bool
+}
+
+type SubscriptionsOption func(*Subscriptions)
+
+// WithMinSubDuration overrides MinSubDuration, eg: for tests.
+func WithMinSubDuration(d time.Duration) SubscriptionsOption {
+	return func(s *Subscriptions) {
+		s.minDuration = d
+	}
+}
+
+// NewSubscriptions creates Subscriptions.
+// Requests must go through the same connection, eg: the same Client.
+func NewSubscriptions(rh pb.RequestHandler, opts ...SubscriptionsOption) *Subscriptions {
+	s := &Subscriptions{
+		rh:          rh,
+		minDuration: MinSubDuration,
+		entries:     map[subKey]*subEntry{},
+	}
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	return s
+}
+
+// Subscription is a handle returned by Subscribe.
+type Subscription struct {
+	s    *Subscriptions
+	keys []subKey
+	once sync.Once
+}
+
+// Unsubscribe releases the handle. Unsubscribing from OpenD happens when
+// no handle refers to a (security, SubType), possibly in background.
+// Calling it more than once has no effect.
+func (sub *Subscription) Unsubscribe(ctx context.Context) error {
+	var err error
+	sub.once.Do(func() {
+		err = sub.s.release(ctx, sub.keys)
+	})
+	return err
+}
+
+// RefreshQuota reads the subscription quota from OpenD.
+func (s *Subscriptions) RefreshQuota(ctx context.Context) error {
+	req := &pb.QotGetSubInfoRequest{}
+	resp, err := req.Dispatch(ctx, s.rh)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	s.usedQuota = resp.GetTotalUsedQuota()
+	s.remain = resp.GetRemainQuota()
+	s.quotaKnown = true
+	s.mutex.Unlock()
+	return nil
+}
+
+// Quota returns the used & remaining subscription quota of OpenD,
+// as of last refresh, adjusted by subscriptions made since.
+func (s *Subscriptions) Quota() (used, remain int32) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.usedQuota, s.remain
+}
+
+// Usage returns the quota taken by this Subscriptions, by SubType.
+func (s *Subscriptions) Usage() map[pb.SubType]int32 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	usage := map[pb.SubType]int32{}
+	for k := range s.entries {
+		usage[k.subType]++
+	}
+	return usage
+}
+
+// Subscribed reports whether security is subscribed with t, and held by
+// a handle not yet released.
+func (s *Subscriptions) Subscribed(security *pb.Security, t pb.SubType) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	e, ok := s.entries[subKey{security.GetMarket(), security.GetCode(), t}]
+	return ok && e.refs > 0
+}
+
+// Pending reports whether security with t is released by all handles, but
+// still subscribed in OpenD, waiting for the deferred unsubscribe.
+func (s *Subscriptions) Pending(security *pb.Security, t pb.SubType) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	e, ok := s.entries[subKey{security.GetMarket(), security.GetCode(), t}]
+	return ok && e.refs == 0
+}
+
+// missing returns keys to be subscribed in OpenD.
+func (s *Subscriptions) missing(keys []subKey) []subKey {
+	missing := []subKey{}
+	for _, k := range keys {
+		if e, ok := s.entries[k]; !ok || e.unsubscribing {
+			missing = append(missing, k)
+		}
+	}
+	return missing
+}
+
+func (s *Subscriptions) ref(keys []subKey) {
+	for _, k := range keys {
+		s.entries[k].refs++
+	}
+}
+
+// Subscribe subscribes securities with subTypes, and registers pushes.
+// Only (security, SubType) not yet subscribed are sent to OpenD.
+// ErrSubQuotaExceed is returned without calling OpenD if the remaining
+// quota is not enough.
+func (s *Subscriptions) Subscribe(ctx context.Context, securities []*pb.Security, subTypes ...pb.SubType) (*Subscription, error) {
+
+	keys := []subKey{}
+	for _, sec := range securities {
+		for _, t := range subTypes {
+			keys = append(keys, subKey{sec.GetMarket(), sec.GetCode(), t})
+		}
+	}
+
+	// pending unsubscribes are revived, not sent again
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return nil, ErrSubscriptionsClosed
+	}
+	if len(s.missing(keys)) == 0 {
+		s.ref(keys)
+		s.mutex.Unlock()
+		return &Subscription{s: s, keys: keys}, nil
+	}
+	s.mutex.Unlock()
+
+	s.ioMutex.Lock()
+	defer s.ioMutex.Unlock()
+
+	// states may have changed while waiting for ioMutex
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return nil, ErrSubscriptionsClosed
+	}
+	newKeys := s.missing(keys)
+	quotaKnown := s.quotaKnown
+	s.mutex.Unlock()
+
+	if len(newKeys) > 0 {
+		if !quotaKnown {
+			if err := s.RefreshQuota(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		s.mutex.Lock()
+		need, remain := int32(len(newKeys)), s.remain
+		s.mutex.Unlock()
+
+		if need > remain {
+			return nil, fmt.Errorf("%w: need %d, remain %d", ErrSubQuotaExceed, need, remain)
+		}
+
+		err := s.send(ctx, true, newKeys, func(done []subKey) {
+			now := time.Now()
+			for _, k := range done {
+				s.entries[k] = &subEntry{subscribedAt: now}
+				s.usedQuota++
+				s.remain--
+			}
+		})
+		if err != nil {
+			// subscribed SubTypes are released later
+			s.mutex.Lock()
+			s.quotaKnown = false
+			s.flushLater()
+			s.mutex.Unlock()
+			return nil, err
+		}
+	}
+
+	s.mutex.Lock()
+	s.ref(keys)
+	s.mutex.Unlock()
+
+	return &Subscription{s: s, keys: keys}, nil
+}
+
+func (s *Subscriptions) release(ctx context.Context, keys []subKey) error {
+	s.mutex.Lock()
+	for _, k := range keys {
+		if e, ok := s.entries[k]; ok && e.refs > 0 {
+			e.refs--
+		}
+	}
+	s.mutex.Unlock()
+
+	return s.flush(ctx)
+}
+
+// flush unsubscribes unreferenced entries which are old enough,
+// and schedules itself for the rest.
+func (s *Subscriptions) flush(ctx context.Context) error {
+
+	s.ioMutex.Lock()
+	defer s.ioMutex.Unlock()
+
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return nil
+	}
+
+	now := time.Now()
+	due := []subKey{}
+	next := time.Duration(0)
+
+	for k, e := range s.entries {
+		if e.refs > 0 {
+			continue
+		}
+		if wait := e.subscribedAt.Add(s.minDuration).Sub(now); wait > 0 {
+			if next == 0 || wait < next {
+				next = wait
+			}
+			continue
+		}
+		e.unsubscribing = true
+		due = append(due, k)
+	}
+	s.mutex.Unlock()
+
+	var err error
+	if len(due) > 0 {
+		err = s.send(ctx, false, due, func(done []subKey) {
+			for _, k := range done {
+				delete(s.entries, k)
+				s.usedQuota--
+				s.remain++
+			}
+		})
+
+		s.mutex.Lock()
+		for _, k := range due {
+			if e, ok := s.entries[k]; ok {
+				e.unsubscribing = false
+			}
+		}
+		if err != nil {
+			// retry later, clocks may differ for ErrSubTimeTooShort
+			next = s.minDuration
+			s.quotaKnown = false
+		}
+		s.mutex.Unlock()
+
+		if errors.Is(err, ErrSubTimeTooShort) {
+			err = nil
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if next > 0 && !s.closed {
+		s.timer = time.AfterFunc(next, s.deferredFlush)
+	}
+
+	return err
+}
+
+// flushLater schedules a flush, called with mutex held.
+func (s *Subscriptions) flushLater() {
+	if s.timer == nil && !s.closed {
+		s.timer = time.AfterFunc(s.minDuration, s.deferredFlush)
+	}
+}
+
+func (s *Subscriptions) deferredFlush() {
+	if err := s.flush(context.TODO()); err != nil {
+		log.Error().Err(err).Msg("deferred unsubscribe error")
+	}
+}
+
+// send subscribes/unsubscribes keys, one request per SubType, called with
+// ioMutex held. done is called with keys of each succeeded request, with
+// mutex held.
+func (s *Subscriptions) send(ctx context.Context, sub bool, keys []subKey, done func([]subKey)) error {
+
+	byKeys := map[pb.SubType][]subKey{}
+	for _, k := range keys {
+		byKeys[k.subType] = append(byKeys[k.subType], k)
+	}
+
+	byType := map[pb.SubType][]*pb.Security{}
+	for _, k := range keys {
+		byType[k.subType] = append(byType[k.subType], &pb.Security{
+			Market: k.market.Enum(),
+			Code:   proto.String(k.code),
+		})
+	}
+
+	types := []pb.SubType{}
+	for t := range byType {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	for _, t := range types {
+		req := &pb.QotSubRequest{
+			SecurityList:     byType[t],
+			SubTypeList:      []pb.SubType{t},
+			IsSubOrUnSub:     proto.Bool(sub),
+			IsRegOrUnRegPush: proto.Bool(sub),
+		}
+		if _, err := req.Dispatch(ctx, s.rh); err != nil {
+			return err
+		}
+
+		s.mutex.Lock()
+		done(byKeys[t])
+		s.mutex.Unlock()
+	}
+
+	return nil
+}
+
+// Close stops deferred unsubscribes. OpenD drops all subscriptions of a
+// connection when it is closed.
+func (s *Subscriptions) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.closed = true
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+}
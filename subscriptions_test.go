@@ -0,0 +1,127 @@
+package futu_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/santsai/futu-go/pb/pbtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSubscriptions(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	m := pbtest.NewMock()
+	m.OnQotGetSubInfo(func(*pb.QotGetSubInfoRequest) (*pb.QotGetSubInfoResponse, error) {
+		return &pb.QotGetSubInfoResponse{
+			TotalUsedQuota: proto.Int32(0),
+			RemainQuota:    proto.Int32(3),
+		}, nil
+	})
+	m.OnQotSub(func(*pb.QotSubRequest) (*pb.QotSubResponse, error) {
+		return &pb.QotSubResponse{}, nil
+	})
+
+	subs := futu.NewSubscriptions(m, futu.WithMinSubDuration(100*time.Millisecond))
+	defer subs.Close()
+
+	tencent := futu.NewSecurity("HK.00700")
+	apple := futu.NewSecurity("US.AAPL")
+
+	a, err := subs.Subscribe(ctx, []*pb.Security{tencent}, pb.SubType_Basic, pb.SubType_KL_Day)
+	should.NoError(err)
+	b, err := subs.Subscribe(ctx, []*pb.Security{tencent}, pb.SubType_Basic)
+	should.NoError(err)
+
+	// second Subscribe is ref-counted only
+	reqs := m.QotSubCalls()
+	should.Len(reqs, 2) // one per SubType
+	should.True(reqs[0].GetIsSubOrUnSub())
+
+	used, remain := subs.Quota()
+	should.Equal(int32(2), used)
+	should.Equal(int32(1), remain)
+	should.Equal(map[pb.SubType]int32{pb.SubType_Basic: 1, pb.SubType_KL_Day: 1}, subs.Usage())
+
+	// quota exceeded without calling OpenD
+	_, err = subs.Subscribe(ctx, []*pb.Security{apple}, pb.SubType_Basic, pb.SubType_RT)
+	should.True(errors.Is(err, futu.ErrSubQuotaExceed))
+	should.Len(m.QotSubCalls(), 2)
+
+	// released before MinSubDuration, deferred
+	should.NoError(a.Unsubscribe(ctx))
+	should.NoError(a.Unsubscribe(ctx))
+	should.Len(m.QotSubCalls(), 2)
+	should.False(subs.Subscribed(tencent, pb.SubType_KL_Day))
+	should.True(subs.Pending(tencent, pb.SubType_KL_Day))
+
+	should.Eventually(func() bool {
+		return !subs.Pending(tencent, pb.SubType_KL_Day)
+	}, time.Second, 10*time.Millisecond)
+
+	// still referenced by b
+	should.True(subs.Subscribed(tencent, pb.SubType_Basic))
+
+	reqs = m.QotSubCalls()
+	should.Len(reqs, 3)
+	should.False(reqs[2].GetIsSubOrUnSub())
+	should.Equal([]pb.SubType{pb.SubType_KL_Day}, reqs[2].GetSubTypeList())
+
+	// released after MinSubDuration, immediately
+	should.NoError(b.Unsubscribe(ctx))
+	should.False(subs.Subscribed(tencent, pb.SubType_Basic))
+	should.False(subs.Pending(tencent, pb.SubType_Basic))
+	should.Len(m.QotSubCalls(), 4)
+
+	used, remain = subs.Quota()
+	should.Equal(int32(0), used)
+	should.Equal(int32(3), remain)
+}
+
+func TestSubscriptionsNotBlocked(t *testing.T) {
+	should := require.New(t)
+	ctx := context.Background()
+
+	sent, resume := make(chan struct{}), make(chan struct{})
+
+	m := pbtest.NewMock()
+	m.OnQotGetSubInfo(func(*pb.QotGetSubInfoRequest) (*pb.QotGetSubInfoResponse, error) {
+		return &pb.QotGetSubInfoResponse{
+			TotalUsedQuota: proto.Int32(0),
+			RemainQuota:    proto.Int32(10),
+		}, nil
+	})
+	m.OnQotSub(func(*pb.QotSubRequest) (*pb.QotSubResponse, error) {
+		close(sent)
+		<-resume
+		return &pb.QotSubResponse{}, nil
+	})
+
+	subs := futu.NewSubscriptions(m)
+	defer subs.Close()
+
+	tencent := futu.NewSecurity("HK.00700")
+
+	errc := make(chan error)
+	go func() {
+		_, err := subs.Subscribe(ctx, []*pb.Security{tencent}, pb.SubType_Ticker)
+		errc <- err
+	}()
+
+	// states are readable while QotSub is in flight
+	<-sent
+	used, remain := subs.Quota()
+	should.Equal(int32(0), used)
+	should.Equal(int32(10), remain)
+	should.False(subs.Subscribed(tencent, pb.SubType_Ticker))
+
+	close(resume)
+	should.NoError(<-errc)
+	should.True(subs.Subscribed(tencent, pb.SubType_Ticker))
+}
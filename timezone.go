@@ -0,0 +1,163 @@
+package futu
+
+import (
+	"math"
+	"time"
+	_ "time/tzdata" // exchange zones without system tzdata
+
+	"github.com/santsai/futu-go/pb"
+)
+
+// exchange time zones, futu time strings are in these zones.
+var (
+	LocHongKong  = mustLoadLocation("Asia/Hong_Kong")
+	LocNewYork   = mustLoadLocation("America/New_York")
+	LocShanghai  = mustLoadLocation("Asia/Shanghai")
+	LocSingapore = mustLoadLocation("Asia/Singapore")
+	LocTokyo     = mustLoadLocation("Asia/Tokyo")
+	LocSydney    = mustLoadLocation("Australia/Sydney")
+	LocMalaysia  = mustLoadLocation("Asia/Kuala_Lumpur")
+	LocToronto   = mustLoadLocation("America/Toronto")
+)
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// QotMarketLocation returns the time zone of a quote market, UTC if unknown.
+func QotMarketLocation(m pb.QotMarket) *time.Location {
+	switch m {
+	case pb.QotMarket_HK_Security, pb.QotMarket_HK_Future:
+		return LocHongKong
+	case pb.QotMarket_US_Security:
+		return LocNewYork
+	case pb.QotMarket_CNSH_Security, pb.QotMarket_CNSZ_Security:
+		return LocShanghai
+	case pb.QotMarket_SG_Security:
+		return LocSingapore
+	case pb.QotMarket_JP_Security:
+		return LocTokyo
+	case pb.QotMarket_AU_Security:
+		return LocSydney
+	case pb.QotMarket_MY_Security:
+		return LocMalaysia
+	case pb.QotMarket_CA_Security:
+		return LocToronto
+	}
+	return time.UTC
+}
+
+// TrdMarketLocation returns the time zone of a trade market, UTC if unknown.
+func TrdMarketLocation(m pb.TrdMarket) *time.Location {
+	switch m {
+	case pb.TrdMarket_HK, pb.TrdMarket_HK_Fund, pb.TrdMarket_Futures, pb.TrdMarket_Futures_Simulate_HK:
+		return LocHongKong
+	case pb.TrdMarket_US, pb.TrdMarket_US_Fund, pb.TrdMarket_Futures_Simulate_US:
+		return LocNewYork
+	case pb.TrdMarket_CN, pb.TrdMarket_HKCC:
+		return LocShanghai
+	case pb.TrdMarket_SG, pb.TrdMarket_Futures_Simulate_SG:
+		return LocSingapore
+	case pb.TrdMarket_JP, pb.TrdMarket_Futures_Simulate_JP:
+		return LocTokyo
+	case pb.TrdMarket_AU:
+		return LocSydney
+	case pb.TrdMarket_MY:
+		return LocMalaysia
+	case pb.TrdMarket_CA:
+		return LocToronto
+	}
+	return time.UTC
+}
+
+// TrdSecMarketLocation returns the time zone of a security market, UTC if unknown.
+func TrdSecMarketLocation(m pb.TrdSecMarket) *time.Location {
+	switch m {
+	case pb.TrdSecMarket_HK:
+		return LocHongKong
+	case pb.TrdSecMarket_US:
+		return LocNewYork
+	case pb.TrdSecMarket_CN_SH, pb.TrdSecMarket_CN_SZ:
+		return LocShanghai
+	case pb.TrdSecMarket_SG:
+		return LocSingapore
+	case pb.TrdSecMarket_JP:
+		return LocTokyo
+	case pb.TrdSecMarket_AU:
+		return LocSydney
+	case pb.TrdSecMarket_MY:
+		return LocMalaysia
+	case pb.TrdSecMarket_CA:
+		return LocToronto
+	}
+	return time.UTC
+}
+
+// ParseTime parses a futu time string in loc, in the format of
+// "YYYY-MM-DD HH:MM:SS", "YYYY-MM-DD HH:MM:SS.MS" or "YYYY-MM-DD".
+func ParseTime(s string, loc *time.Location) (time.Time, error) {
+	if len(s) == len(DateFormat) {
+		return time.ParseInLocation(DateFormat, s, loc)
+	}
+	// fractional seconds are accepted after seconds
+	return time.ParseInLocation(TimeFormat, s, loc)
+}
+
+// TimestampTime converts a futu timestamp in seconds to time in loc,
+// rounded to microseconds.
+func TimestampTime(ts float64, loc *time.Location) time.Time {
+	return time.UnixMicro(int64(math.Round(ts * 1e6))).In(loc)
+}
+
+// ParseTimeTimestamp returns the time of a timestamp field if set,
+// which is exact, or parses the time string in loc.
+func ParseTimeTimestamp(s string, ts float64, loc *time.Location) (time.Time, error) {
+	if ts > 0 {
+		return TimestampTime(ts, loc), nil
+	}
+	return ParseTime(s, loc)
+}
+
+// ParseQotTime parses a time string of quote market m.
+func ParseQotTime(m pb.QotMarket, s string) (time.Time, error) {
+	return ParseTime(s, QotMarketLocation(m))
+}
+
+// ParseTrdTime parses a time string of trade market m.
+func ParseTrdTime(m pb.TrdMarket, s string) (time.Time, error) {
+	return ParseTime(s, TrdMarketLocation(m))
+}
+
+// KLineTime returns the time of a kline of security.
+func KLineTime(security *pb.Security, kl *pb.KLine) (time.Time, error) {
+	return ParseTimeTimestamp(kl.GetTime(), kl.GetTimestamp(), QotMarketLocation(security.GetMarket()))
+}
+
+// TickerTime returns the time of a ticker of security.
+func TickerTime(security *pb.Security, t *pb.Ticker) (time.Time, error) {
+	return ParseTimeTimestamp(t.GetTime(), t.GetTimestamp(), QotMarketLocation(security.GetMarket()))
+}
+
+// TimeShareTime returns the time of a time share point of security.
+func TimeShareTime(security *pb.Security, ts *pb.TimeShare) (time.Time, error) {
+	return ParseTimeTimestamp(ts.GetTime(), ts.GetTimestamp(), QotMarketLocation(security.GetMarket()))
+}
+
+// OrderCreateTime returns the create time of an order.
+func OrderCreateTime(o *pb.Order) (time.Time, error) {
+	return ParseTimeTimestamp(o.GetCreateTime(), o.GetCreateTimestamp(), TrdSecMarketLocation(o.GetSecMarket()))
+}
+
+// OrderUpdateTime returns the last update time of an order.
+func OrderUpdateTime(o *pb.Order) (time.Time, error) {
+	return ParseTimeTimestamp(o.GetUpdateTime(), o.GetUpdateTimestamp(), TrdSecMarketLocation(o.GetSecMarket()))
+}
+
+// OrderFillTime returns the time of a fill.
+func OrderFillTime(f *pb.OrderFill) (time.Time, error) {
+	return ParseTimeTimestamp(f.GetCreateTime(), f.GetCreateTimestamp(), TrdSecMarketLocation(f.GetSecMarket()))
+}
@@ -0,0 +1,72 @@
+package futu_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseTime(t *testing.T) {
+	should := require.New(t)
+
+	tm, err := futu.ParseQotTime(pb.QotMarket_HK_Security, "2024-03-01 09:30:00")
+	should.NoError(err)
+	should.Equal(time.Date(2024, 3, 1, 1, 30, 0, 0, time.UTC), tm.UTC())
+
+	// millisecond variant
+	tm, err = futu.ParseQotTime(pb.QotMarket_CNSH_Security, "2024-03-01 09:30:00.123")
+	should.NoError(err)
+	should.Equal(123*time.Millisecond, time.Duration(tm.Nanosecond()))
+
+	tm, err = futu.ParseTrdTime(pb.TrdMarket_JP, "2024-03-01")
+	should.NoError(err)
+	should.Equal(time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC), tm.UTC())
+
+	// new york, with and without DST
+	tm, err = futu.ParseQotTime(pb.QotMarket_US_Security, "2024-03-08 09:30:00")
+	should.NoError(err)
+	should.Equal(14, tm.UTC().Hour())
+	tm, err = futu.ParseQotTime(pb.QotMarket_US_Security, "2024-03-11 09:30:00")
+	should.NoError(err)
+	should.Equal(13, tm.UTC().Hour())
+
+	_, err = futu.ParseQotTime(pb.QotMarket_HK_Security, "")
+	should.Error(err)
+
+	should.Equal(futu.LocSingapore, futu.TrdSecMarketLocation(pb.TrdSecMarket_SG))
+	should.Equal(time.UTC, futu.QotMarketLocation(pb.QotMarket_Unknown))
+}
+
+func TestParseTimeTimestamp(t *testing.T) {
+	should := require.New(t)
+
+	apple := futu.NewSecurity("US.AAPL")
+
+	// timestamp wins over the string
+	ts := time.Date(2024, 7, 1, 13, 30, 0, 250_000_000, time.UTC)
+	kl := &pb.KLine{
+		Time:      proto.String("2024-07-01 00:00:00"),
+		Timestamp: proto.Float64(float64(ts.UnixMicro()) / 1e6),
+	}
+	tm, err := futu.KLineTime(apple, kl)
+	should.NoError(err)
+	should.True(ts.Equal(tm))
+	should.Equal("2024-07-01 09:30:00", tm.Format(futu.TimeFormat))
+
+	tk := &pb.Ticker{Time: proto.String("2024-07-01 09:30:00.250")}
+	tm, err = futu.TickerTime(apple, tk)
+	should.NoError(err)
+	should.True(ts.Equal(tm))
+
+	order := &pb.Order{
+		SecMarket:  pb.TrdSecMarket_HK.Enum(),
+		CreateTime: proto.String("2024-07-01 21:30:00"),
+	}
+	tm, err = futu.OrderCreateTime(order)
+	should.NoError(err)
+	should.True(ts.Add(-250 * time.Millisecond).Equal(tm))
+}
@@ -0,0 +1,8 @@
+# removes the deprecated UnmarshalJSON of proto2 enums, which only accepts
+# full value names, as UnmarshalJSON generated in adapt_enum_names.go
+# accepts short or full names and numbers instead.
+/^\/\/ Deprecated: Do not use\.$/ { skip = 1; next }
+skip && /^}$/ { skip = 0; blank = 1; next }
+skip { next }
+blank && /^$/ { blank = 0; next }
+{ blank = 0; print }
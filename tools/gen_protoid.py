@@ -3,6 +3,19 @@
 
 from futu import ProtoId
 
+# supported by OpenD, but missing in futu.ProtoId
+EXTRA_PROTOIDS = {
+    "UsedQuota": 1010,
+    "Trd_ReconfirmOrder": 2209,
+}
+
+# InitQuantMode (1009) stays unused: it is listed in futu.ProtoId, but no
+# .proto is published for it, so there are no messages to dispatch.
+
+# protocols with a .proto but removed from OpenD, superseded by
+# QotRequestHistoryKL & QotRequestRehab.
+REMOVED_PROTOS = ["QotGetHistoryKL", "QotGetHistoryKLPoints", "QotGetRehab"]
+
 def python_dict_to_go_map(py_dict):
     lines_id2name = [f"var protoid_id2name = map[int]string{{"]
     lines_name2id = [f"var protoid_name2id = map[string]int{{"]
@@ -26,11 +39,24 @@ def gen_all_pushid(py_dict):
     return line
 
 
+def gen_removed():
+    lines = [
+        "// protocols with a .proto but removed from OpenD, superseded by",
+        "// QotRequestHistoryKL & QotRequestRehab.",
+        "var protoid_removed = map[string]bool{",
+    ]
+    lines += [f'"{name}": true,' for name in REMOVED_PROTOS]
+    lines.append("}")
+    return "\n".join(lines)
+
+
 def main():
     items = {k:v for (k,v) in vars(ProtoId).items() if not k.startswith("_")}
+    items.update(EXTRA_PROTOIDS)
     print("package main")
     print(python_dict_to_go_map(items))
     print(gen_all_pushid(items))
+    print(gen_removed())
 
 
 if __name__ == "__main__":
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// suffixes trimmed from short names, eg: QotMarket_HK_Security => HK
+var enum_name_trims = map[string]string{
+	"QotMarket": "_Security",
+}
+
+// enumShortName strips the enum name prefix from a value name,
+// eg: KLType_Day => Day
+func enumShortName(enum *protogen.Enum, v *protogen.EnumValue) string {
+	enumName := string(enum.Desc.Name())
+	name := strings.TrimPrefix(string(v.Desc.Name()), enumName+"_")
+	if suffix, ok := enum_name_trims[enumName]; ok {
+		if trimmed := strings.TrimSuffix(name, suffix); trimmed != "" {
+			name = trimmed
+		}
+	}
+	return name
+}
+
+// normEnumName must match normEnumName in generated code.
+func normEnumName(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+// enumParseNames returns the normalized names accepted by ParseXxx.
+func enumParseNames(enum *protogen.Enum) (map[string]*protogen.EnumValue, error) {
+
+	enumName := string(enum.Desc.Name())
+	names := map[string]*protogen.EnumValue{}
+
+	add := func(name string, v *protogen.EnumValue) error {
+		key := normEnumName(name)
+		if old, ok := names[key]; ok && old.Desc.Number() != v.Desc.Number() {
+			return fmt.Errorf("%s: ambiguous name %s: %s, %s",
+				enumName, name, old.Desc.Name(), v.Desc.Name())
+		}
+		names[key] = v
+		return nil
+	}
+
+	for _, v := range enum.Values {
+		short := enumShortName(enum, v)
+		long := strings.TrimPrefix(string(v.Desc.Name()), enumName+"_")
+
+		candidates := []string{short, long, string(v.Desc.Name())}
+
+		// eg: KL_Day for KLType_Day
+		if prefix, ok := strings.CutSuffix(enumName, "Type"); ok && prefix != "" {
+			candidates = append(candidates, prefix+"_"+short)
+		}
+
+		for _, name := range candidates {
+			if err := add(name, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return names, nil
+}
+
+func generateEnumNames(plugin *protogen.Plugin, enums []*protogen.Enum) error {
+
+	g := newGeneratedFile(plugin, "adapt_enum_names.go")
+
+	g.P(`
+		import (
+			"encoding/json"
+			"errors"
+			"fmt"
+			"strconv"
+			"strings"
+		)
+
+		var ErrUnknownEnumName = errors.New("unknown enum name")
+
+		func normEnumName(s string) string {
+			return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+		}
+
+		// enumJSONText returns the text of a JSON string or number, eg: "Real" or 1.
+		func enumJSONText(b []byte) (string, error) {
+			if len(b) > 0 && b[0] == '"' {
+				var s string
+				err := json.Unmarshal(b, &s)
+				return s, err
+			}
+			return string(b), nil
+		}
+	`)
+
+	for _, enum := range enums {
+		name := enum.GoIdent.GoName
+
+		names, err := enumParseNames(enum)
+		if err != nil {
+			panic(err)
+		}
+
+		keys := []string{}
+		for k := range names {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		// Name
+		g.P()
+		g.P(`// Name returns the value name without the `, enum.Desc.Name(), `_ prefix.`)
+		g.P(`func (x `, name, `) Name() string {`)
+		g.P(`	switch x {`)
+		seen := map[int32]bool{}
+		for _, v := range enum.Values {
+			if seen[int32(v.Desc.Number())] {
+				continue
+			}
+			seen[int32(v.Desc.Number())] = true
+			g.P(`	case `, v.Desc.Name(), `:`)
+			g.P(`		return "`, enumShortName(enum, v), `"`)
+		}
+		g.P(`	}`)
+		g.P(`	return strconv.Itoa(int(x))`)
+		g.P(`}`)
+
+		// Parse
+		g.P()
+		g.P(`var parseNames_`, name, ` = map[string]`, name, `{`)
+		for _, k := range keys {
+			g.P(`"`, k, `": `, names[k].Desc.Name(), `,`)
+		}
+		g.P(`}`)
+
+		g.P(`
+			// Parse`, name, ` parses short or full names, case and underscore insensitive,
+			// or numbers of defined values.
+			func Parse`, name, `(s string) (`, name, `, error) {
+				if v, ok := parseNames_`, name, `[normEnumName(s)]; ok {
+					return v, nil
+				}
+				if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+					if _, ok := `, name, `_name[int32(n)]; ok {
+						return `, name, `(n), nil
+					}
+				}
+				return 0, fmt.Errorf("%w: `, name, ` %q", ErrUnknownEnumName, s)
+			}
+
+			func (x `, name, `) MarshalText() ([]byte, error) {
+				return []byte(x.Name()), nil
+			}
+
+			func (x *`, name, `) UnmarshalText(b []byte) error {
+				v, err := Parse`, name, `(string(b))
+				if err != nil {
+					return err
+				}
+				*x = v
+				return nil
+			}
+
+			// UnmarshalJSON accepts names or numbers, as strings or JSON numbers.
+			func (x *`, name, `) UnmarshalJSON(b []byte) error {
+				if string(b) == "null" {
+					return nil
+				}
+				s, err := enumJSONText(b)
+				if err != nil {
+					return err
+				}
+				return x.UnmarshalText([]byte(s))
+			}
+		`)
+	}
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// facade is generated into the parent package of pb, as methods on Client.
+const facadePackageName = "futu"
+
+// requests handled by Client itself
+var facade_skip = map[string]bool{
+	"InitConnect": true,
+	"KeepAlive":   true,
+}
+
+// repeated fields which are semantically required, in parameter order.
+// the last one becomes variadic.
+var facade_required_lists = map[string][]string{
+	"QotGetBasicQot":         {"securityList"},
+	"QotGetFutureInfo":       {"securityList"},
+	"QotGetMarketState":      {"securityList"},
+	"QotGetOwnerPlate":       {"securityList"},
+	"QotGetSecuritySnapshot": {"securityList"},
+	"QotGetSuspend":          {"securityList"},
+	"QotModifyUserSecurity":  {"securityList"},
+	"QotRegQotPush":          {"subTypeList", "securityList"},
+	"QotSub":                 {"subTypeList", "securityList"},
+	"TrdGetMarginRatio":      {"securityList"},
+	"TrdGetOrderFee":         {"orderIdExList"},
+	"TrdSubAccPush":          {"accIDList"},
+}
+
+// response fields echoing the request, ignored when unwrapping
+var facade_echo_fields = map[string]bool{
+	"header":   true,
+	"security": true,
+	"name":     true,
+}
+
+var goKeywords = map[string]string{
+	"type": "typ",
+}
+
+type facadeParam struct {
+	Field *protogen.Field
+	Name  string
+}
+
+// facadeMethodName strips Qot, Trd from the protocol name.
+func facadeMethodName(idName string) string {
+	for _, prefix := range []string{"Qot", "Trd"} {
+		if strings.HasPrefix(idName, prefix) {
+			return strings.TrimPrefix(idName, prefix)
+		}
+	}
+	return idName
+}
+
+func facadeParamName(f *protogen.Field) string {
+	name := f.Desc.JSONName()
+	if v, ok := goKeywords[name]; ok {
+		return v
+	}
+	return name
+}
+
+func isSecurityField(f *protogen.Field) bool {
+	return f.Message != nil && f.Message.Desc.Name() == "Security"
+}
+
+// facadeParams splits request fields into parameters & options.
+func facadeParams(idName string, req *protogen.Message) ([]facadeParam, []*protogen.Field) {
+
+	params := []facadeParam{}
+	options := []*protogen.Field{}
+
+	lists := map[string]bool{}
+	for _, name := range facade_required_lists[idName] {
+		lists[name] = true
+	}
+
+	for _, f := range req.Fields {
+		name := string(f.Desc.Name())
+		switch {
+		case autofill_fields[name]:
+		case f.Desc.Cardinality() == protoreflect.Required:
+			params = append(params, facadeParam{Field: f, Name: facadeParamName(f)})
+		case !lists[name]:
+			options = append(options, f)
+		}
+	}
+
+	// lists go last, in the listed order
+	for _, name := range facade_required_lists[idName] {
+		f := findField(req, name)
+		if f == nil {
+			panic(fmt.Sprintf("%s: field not found: %s", idName, name))
+		}
+		params = append(params, facadeParam{Field: f, Name: facadeParamName(f)})
+	}
+
+	return params, options
+}
+
+func findField(msg *protogen.Message, name string) *protogen.Field {
+	for _, f := range msg.Fields {
+		if string(f.Desc.Name()) == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// facadeResult returns the response field to unwrap, or nil for the whole response.
+func facadeResult(resp *protogen.Message) *protogen.Field {
+	var result *protogen.Field
+	for _, f := range resp.Fields {
+		if facade_echo_fields[string(f.Desc.Name())] {
+			continue
+		}
+		if result != nil {
+			return nil
+		}
+		result = f
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	// only unwrap lists & messages
+	if !result.Desc.IsList() && result.Desc.Kind() != protoreflect.MessageKind {
+		return nil
+	}
+
+	return result
+}
+
+func generateFacade(plugin *protogen.Plugin, reqs []*protogen.Message, resps []*protogen.Message) error {
+
+	pbImportPath := plugin.Files[0].GoImportPath
+	importPath := protogen.GoImportPath(path.Dir(string(pbImportPath)))
+
+	g := plugin.NewGeneratedFile(string(importPath)+"/client_facade.go", importPath)
+	g.P(`// Code generated by protoc-gen-go-futu. DO NOT EDIT.`)
+	g.P()
+	g.P(`package `, facadePackageName)
+	g.P()
+
+	pbIdent := func(id protogen.GoIdent) string {
+		return g.QualifiedGoIdent(id)
+	}
+	ctxIdent := g.QualifiedGoIdent(protogen.GoIdent{GoName: "Context", GoImportPath: "context"})
+
+	respMap := map[string]*protogen.Message{}
+	for _, msg := range resps {
+		respMap[string(msg.Desc.Name())] = msg
+	}
+
+	for _, req := range reqs {
+		reqName := string(req.Desc.Name())
+		idName := strings.TrimSuffix(reqName, "Request")
+
+		if _, ok := protoid_name2id[idName]; !ok || facade_skip[idName] {
+			continue
+		}
+
+		resp := respMap[idName+"Response"]
+		if resp == nil {
+			continue
+		}
+
+		methodName := facadeMethodName(idName)
+		optionType := methodName + "Option"
+		reqType := pbIdent(req.GoIdent)
+
+		params, options := facadeParams(idName, req)
+
+		// option type & setters
+		if len(options) > 0 {
+			g.P()
+			g.P(`// `, optionType, ` sets an optional field of `, reqType, `.`)
+			g.P(`type `, optionType, ` func(*`, reqType, `)`)
+		}
+
+		for _, f := range options {
+			fType := fieldTypeName(f, pbIdent)
+			arg := "o"
+			if f.Desc.IsList() {
+				fType = "..." + fType
+				arg = "o..."
+			}
+
+			funcName := builderFuncName(f)
+			optionFunc := methodName + `With` + funcName
+			g.P()
+			g.P(`// `, optionFunc, ` sets `, f.Desc.Name(), `.`)
+			if c := commentStr(f.Comments.Trailing); c != "" {
+				g.P(c)
+			}
+			g.P(`func `, optionFunc, `(o `, fType, `) `, optionType, ` {`)
+			g.P(`	return func(r *`, reqType, `) { r.Set`, funcName, `(`, arg, `) }`)
+			g.P(`}`)
+		}
+
+		// method signature
+		// a list param can only be variadic if there is no option.
+		args := []string{"ctx " + ctxIdent}
+		for i, p := range params {
+			isLast := i == len(params)-1 && len(options) == 0
+			pType := fieldTypeName(p.Field, pbIdent)
+			if isSecurityField(p.Field) {
+				pType = "string"
+			}
+			if p.Field.Desc.IsList() {
+				if isLast {
+					pType = "..." + pType
+				} else {
+					pType = "[]" + pType
+				}
+			}
+			args = append(args, p.Name+" "+pType)
+		}
+		if len(options) > 0 {
+			args = append(args, "opts ..."+optionType)
+		}
+
+		result := facadeResult(resp)
+		returns := "error"
+		switch {
+		case result != nil:
+			rType := fieldTypeName(result, pbIdent)
+			if result.Desc.IsList() {
+				rType = "[]" + rType
+			}
+			returns = "(" + rType + ", error)"
+		case len(resp.Fields) > 0:
+			returns = "(*" + pbIdent(resp.GoIdent) + ", error)"
+		}
+
+		g.P()
+		g.P(`// `, methodName, ` dispatches `, reqType, `.`)
+		g.P(`func (client *Client) `, methodName, `(`, strings.Join(args, ", "), `) `, returns, ` {`)
+		g.P(`	req := &`, reqType, `{}`)
+		for _, p := range params {
+			funcName := builderFuncName(p.Field)
+			switch {
+			case isSecurityField(p.Field) && p.Field.Desc.IsList():
+				g.P(`	req.Set`, funcName, `(NewSecurityList(`, p.Name, `...)...)`)
+			case isSecurityField(p.Field):
+				g.P(`	req.Set`, funcName, `(NewSecurity(`, p.Name, `))`)
+			case p.Field.Desc.IsList():
+				g.P(`	req.Set`, funcName, `(`, p.Name, `...)`)
+			default:
+				g.P(`	req.Set`, funcName, `(`, p.Name, `)`)
+			}
+		}
+		if len(options) > 0 {
+			g.P(`	for _, o := range opts {`)
+			g.P(`		o(req)`)
+			g.P(`	}`)
+		}
+		g.P()
+
+		switch {
+		case result != nil:
+			g.P(`	resp, err := req.Dispatch(ctx, client)`)
+			g.P(`	if err != nil {`)
+			g.P(`		return nil, err`)
+			g.P(`	}`)
+			g.P(`	return resp.Get`, result.GoName, `(), nil`)
+		case len(resp.Fields) > 0:
+			g.P(`	return req.Dispatch(ctx, client)`)
+		default:
+			g.P(`	_, err := req.Dispatch(ctx, client)`)
+			g.P(`	return err`)
+		}
+		g.P(`}`)
+	}
+
+	return nil
+}
@@ -10,6 +10,12 @@ import (
 	"strings"
 )
 
+// request fields filled by Client.patchRequest
+var autofill_fields = map[string]bool{
+	"packetID": true,
+	"userID":   true,
+}
+
 func newFilename(base, fn string) string {
 	return filepath.Dir(base) + "/" + fn
 }
@@ -141,7 +147,11 @@ func generateRequestAdapt(plugin *protogen.Plugin, msgs []*protogen.Message) err
 		id, idExist := protoid_name2id[idName]
 
 		if !idExist {
-			g.P(`/* ProtoId Not Exist: `, idName)
+			if protoid_removed[idName] {
+				g.P(`/* ProtoId Removed from OpenD: `, idName)
+			} else {
+				g.P(`/* ProtoId Not Exist: `, idName)
+			}
 		}
 
 		g.P(fmt.Sprintf(`
@@ -177,11 +187,16 @@ func generateRequestAdapt(plugin *protogen.Plugin, msgs []*protogen.Message) err
 	}
 	g.P(`/* `, fmt.Sprintf("protoid: used/total (%d/%d)", len(used_ids), len(protoid_name2id)))
 	g.P(`unused:`)
+	unused := []string{}
 	for name, id := range protoid_name2id {
 		if _, ok := used_ids[id]; !ok {
-			g.P(name)
+			unused = append(unused, name)
 		}
 	}
+	sort.Strings(unused)
+	for _, name := range unused {
+		g.P(name)
+	}
 	g.P(`*/`)
 
 	return nil
@@ -248,6 +263,37 @@ func collect_msgs(msg *protogen.Message, msgs map[string]*protogen.Message) {
 	msgs[goname] = msg
 }
 
+// fieldTypeName returns the go type of a field, without repeated.
+// qualify converts message & enum idents into type names.
+func fieldTypeName(f *protogen.Field, qualify func(protogen.GoIdent) string) string {
+	switch f.Desc.Kind() {
+	case protoreflect.MessageKind:
+		return "*" + qualify(f.Message.GoIdent)
+	case protoreflect.EnumKind:
+		return qualify(f.Enum.GoIdent)
+	case protoreflect.FloatKind:
+		return "float32"
+	case protoreflect.DoubleKind:
+		return "float64"
+	case protoreflect.BytesKind:
+		return "[]byte"
+	}
+	return f.Desc.Kind().String()
+}
+
+// builderFuncName returns the name used in With/Set builders,
+// with Trd, Qot stripped.
+func builderFuncName(f *protogen.Field) string {
+	funcName := f.GoName
+	if len(funcName) > 3 {
+		switch funcName[:3] {
+		case "Trd", "Qot":
+			funcName = funcName[3:]
+		}
+	}
+	return funcName
+}
+
 func generateRequestBuilderForMessage(g *protogen.GeneratedFile, msg *protogen.Message) {
 
 	fields := map[string]*protogen.Field{}
@@ -265,32 +311,15 @@ func generateRequestBuilderForMessage(g *protogen.GeneratedFile, msg *protogen.M
 		fKind := f.Desc.Kind()
 		fIsList := f.Desc.IsList()
 
-		fTypeName := f.Desc.Kind().String()
-		switch fKind {
-		case protoreflect.MessageKind:
-			fTypeName = "*" + f.Message.GoIdent.GoName
-		case protoreflect.EnumKind:
-			fTypeName = f.Enum.GoIdent.GoName
-		case protoreflect.FloatKind:
-			fTypeName = "float32"
-		case protoreflect.DoubleKind:
-			fTypeName = "float64"
-		case protoreflect.BytesKind:
-			fTypeName = "[]byte"
-		}
+		fTypeName := fieldTypeName(f, func(id protogen.GoIdent) string {
+			return id.GoName
+		})
 
 		if fIsList {
 			fTypeName = "..." + fTypeName
 		}
 
-		// strip Trd, Qot
-		funcName := fName
-		if len(funcName) > 3 {
-			switch funcName[:3] {
-			case "Trd", "Qot":
-				funcName = funcName[3:]
-			}
-		}
+		funcName := builderFuncName(f)
 
 		// body
 		funcBody := ""
@@ -323,9 +352,64 @@ func generateRequestBuilderForMessage(g *protogen.GeneratedFile, msg *protogen.M
 			func (s *%s) Set%s(o %s) {
 				%s
 			}`, msgName, funcName, fTypeName, funcBody))
+
+		// appender for repeated fields, eg: AddBaseFilter for baseFilterList
+		if fIsList {
+			addName := strings.TrimSuffix(funcName, "List")
+			if addName == "" {
+				addName = funcName
+			}
+			g.P(fmt.Sprintf(`
+				func (s *%s) Add%s(o %s) *%s {
+					s.%s = append(s.%s, o...)
+					return s
+				}`, msgName, addName, fTypeName, msgName, fName, fName))
+		}
 	}
 }
 
+// generateConstructor generates NewXxx with required fields as arguments.
+func generateConstructor(g *protogen.GeneratedFile, msg *protogen.Message) {
+
+	params := []string{}
+	fields := []string{}
+	for _, f := range msg.Fields {
+		if f.Desc.Cardinality() != protoreflect.Required {
+			continue
+		}
+
+		name := facadeParamName(f)
+		fType := fieldTypeName(f, func(id protogen.GoIdent) string {
+			return id.GoName
+		})
+		params = append(params, name+" "+fType)
+
+		switch f.Desc.Kind() {
+		case protoreflect.MessageKind, protoreflect.BytesKind:
+			fields = append(fields, fmt.Sprintf("%s: %s,", f.GoName, name))
+		case protoreflect.EnumKind:
+			fields = append(fields, fmt.Sprintf("%s: %s.Enum(),", f.GoName, name))
+		default:
+			fields = append(fields, fmt.Sprintf("%s: &%s,", f.GoName, name))
+		}
+	}
+
+	if len(params) == 0 {
+		return
+	}
+
+	msgName := msg.GoIdent.GoName
+	g.P()
+	g.P(`// New`, msgName, ` creates a `, msgName, ` with required fields.`)
+	g.P(`func New`, msgName, `(`, strings.Join(params, ", "), `) *`, msgName, ` {`)
+	g.P(`	return &`, msgName, `{`)
+	for _, f := range fields {
+		g.P(f)
+	}
+	g.P(`	}`)
+	g.P(`}`)
+}
+
 func generateRequestBuilder(plugin *protogen.Plugin, msgs []*protogen.Message) error {
 
 	g := newGeneratedFile(plugin, "adapt_req_builder.go")
@@ -342,8 +426,17 @@ func generateRequestBuilder(plugin *protogen.Plugin, msgs []*protogen.Message) e
 		type HeaderSetter interface {
 			SetHeader(*TrdHeader)
 		}
+
+		type HeaderGetter interface {
+			GetHeader() *TrdHeader
+		}
 	`)
 
+	isRequest := map[string]bool{}
+	for _, msg := range msgs {
+		isRequest[msg.GoIdent.GoName] = true
+	}
+
 	all_msgs := map[string]*protogen.Message{}
 	for _, msg := range msgs {
 		collect_msgs(msg, all_msgs)
@@ -362,6 +455,9 @@ func generateRequestBuilder(plugin *protogen.Plugin, msgs []*protogen.Message) e
 			g.P(c)
 		}
 		generateRequestBuilderForMessage(g, msg)
+		if !isRequest[k] {
+			generateConstructor(g, msg)
+		}
 	}
 	return nil
 }
@@ -398,10 +494,15 @@ func main() {
 		}
 
 		generateEnumAdapt(plugin, enums)
+		generateEnumNames(plugin, enums)
 		generateProtoIdAdapt(plugin)
+		generateProtoMeta(plugin)
 		generateRequestAdapt(plugin, reqs)
 		generateResponseAdapt(plugin, resps)
 		generateRequestBuilder(plugin, reqs)
+		generateValidate(plugin, reqs)
+		generateFacade(plugin, reqs, resps)
+		generateMock(plugin, reqs, resps)
 
 		return nil
 	})
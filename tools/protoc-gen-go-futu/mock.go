@@ -0,0 +1,183 @@
+package main
+
+import (
+	"path"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// mock is generated into pb/pbtest, for testing code depending on pb.RequestHandler.
+const mockPackageName = "pbtest"
+
+func generateMock(plugin *protogen.Plugin, reqs []*protogen.Message, resps []*protogen.Message) error {
+
+	pbImportPath := plugin.Files[0].GoImportPath
+	importPath := protogen.GoImportPath(string(pbImportPath) + "/" + mockPackageName)
+	futuImportPath := protogen.GoImportPath(path.Dir(string(pbImportPath)))
+
+	g := plugin.NewGeneratedFile(string(importPath)+"/mock.go", importPath)
+	g.P(`// Code generated by protoc-gen-go-futu. DO NOT EDIT.`)
+	g.P()
+	g.P(`// Package pbtest provides a mock pb.RequestHandler for tests.`)
+	g.P(`package `, mockPackageName)
+	g.P()
+
+	pbIdent := func(name string) string {
+		return g.QualifiedGoIdent(protogen.GoIdent{GoName: name, GoImportPath: pbImportPath})
+	}
+	ident := func(importPath protogen.GoImportPath, name string) string {
+		return g.QualifiedGoIdent(protogen.GoIdent{GoName: name, GoImportPath: importPath})
+	}
+
+	protoMessage := ident("google.golang.org/protobuf/proto", "Message")
+	protoId := pbIdent("ProtoId")
+	request := pbIdent("Request")
+	response := pbIdent("Response")
+
+	g.P(`// ErrNoStub is returned by Mock for protocols without a stub.`)
+	g.P(`var ErrNoStub = `, ident("errors", "New"), `("no stub for protocol")`)
+	g.P(`
+		// Call records a request received by Mock.
+		type Call struct {
+			ProtoId `, protoId, `
+			Request `, protoMessage, `
+		}
+
+		// Stub returns a response of Response_Internal type, eg: from NewQotGetKLResponse.
+		type Stub func(`, request, `) (`, response, `, error)
+
+		// Mock is a `, pbIdent("RequestHandler"), ` returning stubbed responses.
+		// Responses failed in RetType are converted to errors as Client does.
+		type Mock struct {
+			mutex `, ident("sync", "Mutex"), `
+			stubs map[`, protoId, `]Stub
+			calls []Call
+		}
+
+		// NewMock creates a Mock without stubs.
+		func NewMock() *Mock {
+			return &Mock{
+				stubs: map[`, protoId, `]Stub{},
+			}
+		}
+
+		// On sets the stub of protocol id.
+		func (m *Mock) On(id `, protoId, `, stub Stub) {
+			m.mutex.Lock()
+			m.stubs[id] = stub
+			m.mutex.Unlock()
+		}
+
+		// Calls returns all recorded requests, in order.
+		func (m *Mock) Calls() []Call {
+			m.mutex.Lock()
+			defer m.mutex.Unlock()
+			return append([]Call{}, m.calls...)
+		}
+
+		// CallsOf returns recorded requests of protocol id.
+		func (m *Mock) CallsOf(id `, protoId, `) []`, protoMessage, ` {
+			list := []`, protoMessage, `{}
+			for _, c := range m.Calls() {
+				if c.ProtoId == id {
+					list = append(list, c.Request)
+				}
+			}
+			return list
+		}
+
+		// Reset clears recorded requests, stubs are kept.
+		func (m *Mock) Reset() {
+			m.mutex.Lock()
+			m.calls = nil
+			m.mutex.Unlock()
+		}
+
+		func (m *Mock) Request(ctx `, ident("context", "Context"), `, id `, protoId, `, req `, request, `, resp `, response, `) (`, protoMessage, `, error) {
+
+			m.mutex.Lock()
+			m.calls = append(m.calls, Call{ProtoId: id, Request: req.GetRequestPayload()})
+			stub := m.stubs[id]
+			m.mutex.Unlock()
+
+			if stub == nil {
+				return nil, `, ident("fmt", "Errorf"), `("%w: %s", ErrNoStub, id)
+			}
+
+			r, err := stub(req)
+			if err != nil {
+				return nil, err
+			}
+
+			`, ident("google.golang.org/protobuf/proto", "Merge"), `(resp, r)
+			if err := `, ident(futuImportPath, "ResponseError"), `(id, resp); err != nil {
+				return nil, err
+			}
+
+			return resp.GetResponsePayload(), nil
+		}
+	`)
+
+	respMap := map[string]*protogen.Message{}
+	for _, msg := range resps {
+		respMap[string(msg.Desc.Name())] = msg
+	}
+
+	for _, req := range reqs {
+		reqName := string(req.Desc.Name())
+		idName := strings.TrimSuffix(reqName, "Request")
+
+		if _, ok := protoid_name2id[idName]; !ok {
+			continue
+		}
+		if respMap[idName+"Response"] == nil {
+			continue
+		}
+
+		reqType := pbIdent(reqName)
+		respType := pbIdent(idName + "Response")
+		internalType := pbIdent(idName + "Response_Internal")
+		id := pbIdent("ProtoId_" + idName)
+
+		g.P(`
+			// On`, idName, ` sets the stub of `, id, `.
+			func (m *Mock) On`, idName, `(fn func(*`, reqType, `) (*`, respType, `, error)) {
+				m.On(`, id, `, func(req `, request, `) (`, response, `, error) {
+					resp, err := fn(req.GetRequestPayload().(*`, reqType, `))
+					if err != nil {
+						return nil, err
+					}
+					return New`, idName, `Response(resp), nil
+				})
+			}
+
+			// `, idName, `Calls returns recorded requests of `, id, `.
+			func (m *Mock) `, idName, `Calls() []*`, reqType, ` {
+				list := []*`, reqType, `{}
+				for _, req := range m.CallsOf(`, id, `) {
+					list = append(list, req.(*`, reqType, `))
+				}
+				return list
+			}
+
+			// New`, idName, `Response builds a succeeded `, internalType, `.
+			func New`, idName, `Response(payload *`, respType, `) *`, internalType, ` {
+				return &`, internalType, `{
+					RetType: `, pbIdent("RetType_Succeed"), `.Enum(),
+					Payload: payload,
+				}
+			}
+
+			// New`, idName, `Failure builds a failed `, internalType, `.
+			func New`, idName, `Failure(retType `, pbIdent("RetType"), `, retMsg string) *`, internalType, ` {
+				return &`, internalType, `{
+					RetType: retType.Enum(),
+					RetMsg:  &retMsg,
+				}
+			}
+		`)
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ var protoid_id2name = map[int]string{
 	1007: "GetDelayStatistics",
 	1008: "TestCmd",
 	1009: "InitQuantMode",
+	1010: "UsedQuota",
 	2001: "TrdGetAccList",
 	2005: "TrdUnlockTrade",
 	2008: "TrdSubAccPush",
@@ -19,6 +20,7 @@ var protoid_id2name = map[int]string{
 	2202: "TrdPlaceOrder",
 	2205: "TrdModifyOrder",
 	2208: "TrdUpdateOrder",
+	2209: "TrdReconfirmOrder",
 	2211: "TrdGetOrderFillList",
 	2218: "TrdUpdateOrderFill",
 	2221: "TrdGetHistoryOrderList",
@@ -81,6 +83,7 @@ var protoid_name2id = map[string]int{
 	"GetDelayStatistics":         1007,
 	"TestCmd":                    1008,
 	"InitQuantMode":              1009,
+	"UsedQuota":                  1010,
 	"TrdGetAccList":              2001,
 	"TrdUnlockTrade":             2005,
 	"TrdSubAccPush":              2008,
@@ -90,6 +93,7 @@ var protoid_name2id = map[string]int{
 	"TrdPlaceOrder":              2202,
 	"TrdModifyOrder":             2205,
 	"TrdUpdateOrder":             2208,
+	"TrdReconfirmOrder":          2209,
 	"TrdGetOrderFillList":        2211,
 	"TrdUpdateOrderFill":         2218,
 	"TrdGetHistoryOrderList":     2221,
@@ -143,3 +147,11 @@ var protoid_name2id = map[string]int{
 	"QotGetOptionExpirationDate": 3224,
 }
 var protoid_push = []int{1003, 2208, 2218, 3015, 3013, 3007, 3009, 3005, 3011, 3019}
+
+// protocols with a .proto but removed from OpenD, superseded by
+// QotRequestHistoryKL & QotRequestRehab.
+var protoid_removed = map[string]bool{
+	"QotGetHistoryKL":       true,
+	"QotGetHistoryKLPoints": true,
+	"QotGetRehab":           true,
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// rate limits of OpenD are all per 30 seconds.
+const protometa_rate_window = "30 * time.Second"
+
+type protoMeta struct {
+	Write               bool
+	RequireUnlock       bool
+	RequireSubscription bool
+	SubscriptionQuota   bool
+	HistoryKLQuota      bool
+	RateLimit           int
+	PageSize            int
+}
+
+// from futu api docs, keyed by protocol name.
+// protocols not listed are reads without limits.
+var protometa = map[string]protoMeta{
+	"Verification": {Write: true},
+
+	"TrdUnlockTrade":             {Write: true, RateLimit: 10},
+	"TrdSubAccPush":              {Write: true},
+	"TrdGetFunds":                {RateLimit: 10},
+	"TrdGetPositionList":         {RateLimit: 10},
+	"TrdGetMaxTrdQtys":           {RateLimit: 10},
+	"TrdGetOrderList":            {RateLimit: 10},
+	"TrdPlaceOrder":              {Write: true, RequireUnlock: true, RateLimit: 15},
+	"TrdModifyOrder":             {Write: true, RequireUnlock: true, RateLimit: 20},
+	"TrdReconfirmOrder":          {Write: true, RequireUnlock: true},
+	"TrdGetOrderFillList":        {RateLimit: 10},
+	"TrdGetHistoryOrderList":     {RateLimit: 10},
+	"TrdGetHistoryOrderFillList": {RateLimit: 10},
+	"TrdGetMarginRatio":          {RateLimit: 10},
+	"TrdGetOrderFee":             {RateLimit: 10},
+	"TrdFlowSummary":             {RateLimit: 20},
+
+	"QotSub":                     {Write: true, SubscriptionQuota: true},
+	"QotRegQotPush":              {Write: true},
+	"QotGetBasicQot":             {RequireSubscription: true},
+	"QotUpdateBasicQot":          {RequireSubscription: true},
+	"QotGetKL":                   {RequireSubscription: true, PageSize: 1000},
+	"QotUpdateKL":                {RequireSubscription: true},
+	"QotGetRT":                   {RequireSubscription: true},
+	"QotUpdateRT":                {RequireSubscription: true},
+	"QotGetTicker":               {RequireSubscription: true, PageSize: 1000},
+	"QotUpdateTicker":            {RequireSubscription: true},
+	"QotGetOrderBook":            {RequireSubscription: true},
+	"QotUpdateOrderBook":         {RequireSubscription: true},
+	"QotGetBroker":               {RequireSubscription: true},
+	"QotUpdateBroker":            {RequireSubscription: true},
+	"QotRequestHistoryKL":        {HistoryKLQuota: true, RateLimit: 60, PageSize: 1000},
+	"QotRequestRehab":            {RateLimit: 60},
+	"QotGetSuspend":              {RateLimit: 30},
+	"QotGetSecuritySnapshot":     {RateLimit: 60, PageSize: 400},
+	"QotGetPlateSet":             {RateLimit: 10},
+	"QotGetPlateSecurity":        {RateLimit: 10},
+	"QotGetReference":            {RateLimit: 10},
+	"QotGetOwnerPlate":           {RateLimit: 10, PageSize: 200},
+	"QotGetHoldingChangeList":    {RateLimit: 10},
+	"QotGetOptionChain":          {RateLimit: 10},
+	"QotGetWarrant":              {RateLimit: 60, PageSize: 200},
+	"QotGetCapitalFlow":          {RateLimit: 30},
+	"QotGetCapitalDistribution":  {RateLimit: 30},
+	"QotGetUserSecurity":         {RateLimit: 10},
+	"QotModifyUserSecurity":      {Write: true, RateLimit: 10},
+	"QotStockFilter":             {RateLimit: 10, PageSize: 200},
+	"QotGetCodeChange":           {RateLimit: 60},
+	"QotGetIpoList":              {RateLimit: 10},
+	"QotGetFutureInfo":           {RateLimit: 30, PageSize: 200},
+	"QotRequestTradeDate":        {RateLimit: 30},
+	"QotSetPriceReminder":        {Write: true, RateLimit: 60},
+	"QotGetPriceReminder":        {RateLimit: 10},
+	"QotGetUserSecurityGroup":    {RateLimit: 10},
+	"QotGetMarketState":          {RateLimit: 10, PageSize: 400},
+	"QotGetOptionExpirationDate": {RateLimit: 60},
+}
+
+// system protocols listed under other categories in futu api docs.
+var protoCategories = map[string]string{
+	"UsedQuota": "ProtoCategory_Quote",
+}
+
+func protoCategory(name string) string {
+	if c, ok := protoCategories[name]; ok {
+		return c
+	}
+
+	switch {
+	case strings.HasPrefix(name, "Qot"):
+		return "ProtoCategory_Quote"
+	case strings.HasPrefix(name, "Trd"):
+		return "ProtoCategory_Trade"
+	}
+	return "ProtoCategory_System"
+}
+
+func generateProtoMeta(plugin *protogen.Plugin) error {
+
+	for name := range protometa {
+		if _, ok := protoid_name2id[name]; !ok {
+			return fmt.Errorf("protometa: unknown protocol: %s", name)
+		}
+	}
+
+	g := newGeneratedFile(plugin, "adapt_protometa.go")
+
+	g.P(`
+		import "time"
+
+		type ProtoCategory int
+
+		const (
+			ProtoCategory_System ProtoCategory = iota
+			ProtoCategory_Quote
+			ProtoCategory_Trade
+		)
+
+		func (c ProtoCategory) String() string {
+			switch c {
+			case ProtoCategory_System:
+				return "System"
+			case ProtoCategory_Quote:
+				return "Quote"
+			case ProtoCategory_Trade:
+				return "Trade"
+			}
+			return "(Unknown)"
+		}
+
+		// ProtoMetaInfo describes a protocol, from futu api docs.
+		type ProtoMetaInfo struct {
+			Id       ProtoId
+			Category ProtoCategory
+			// changes state on OpenD or server, not safe to retry blindly.
+			Write bool
+			Push  bool
+			// trade must be unlocked in real environment.
+			RequireUnlock bool
+			// security must be subscribed with the matching SubType.
+			RequireSubscription bool
+			// takes or releases subscription quota, see QotGetSubInfo.
+			SubscriptionQuota bool
+			// consumes history kline quota, see QotRequestHistoryKLQuota.
+			HistoryKLQuota bool
+			// at most RateLimit requests in RateWindow, 0 for no limit.
+			RateLimit  int
+			RateWindow time.Duration
+			// max items per request, 0 for no limit.
+			PageSize int
+		}
+	`)
+
+	ids := []int{}
+	for id := range protoid_id2name {
+		if protoid_id2name[id] != "Unknown" {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+
+	push := map[int]bool{}
+	for _, id := range protoid_push {
+		push[id] = true
+	}
+
+	g.P(`var protoMetas = map[ProtoId]ProtoMetaInfo{`)
+	for _, id := range ids {
+		name := protoid_id2name[id]
+		m := protometa[name]
+
+		fields := []string{
+			"Id: ProtoId_" + name,
+			"Category: " + protoCategory(name),
+		}
+		add := func(ok bool, s string) {
+			if ok {
+				fields = append(fields, s)
+			}
+		}
+		add(m.Write, "Write: true")
+		add(push[id], "Push: true")
+		add(m.RequireUnlock, "RequireUnlock: true")
+		add(m.RequireSubscription, "RequireSubscription: true")
+		add(m.SubscriptionQuota, "SubscriptionQuota: true")
+		add(m.HistoryKLQuota, "HistoryKLQuota: true")
+		add(m.RateLimit > 0, fmt.Sprintf("RateLimit: %d, RateWindow: %s", m.RateLimit, protometa_rate_window))
+		add(m.PageSize > 0, fmt.Sprintf("PageSize: %d", m.PageSize))
+
+		g.P(`ProtoId_`, name, `: {`, strings.Join(fields, ", "), `},`)
+	}
+	g.P(`}`)
+
+	g.P(`
+		// ProtoMeta returns the metadata of id, false if id is unknown.
+		func ProtoMeta(id ProtoId) (ProtoMetaInfo, bool) {
+			m, ok := protoMetas[id]
+			return m, ok
+		}
+	`)
+
+	return nil
+}
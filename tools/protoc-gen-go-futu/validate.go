@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// constraints documented in proto comments, keyed by Message.field.
+// the snippet is an expression returning error, %s is the field path.
+var validate_rules = map[string]string{
+	"TrdPlaceOrderRequest.remark": `validateMaxBytes(%s, m.GetRemark(), 64)`,
+	"TrdPlaceOrderRequest.code": `validateCode(%s, m.GetCode(),
+		isCNTrdSecMarket(m.GetSecMarket(), m.GetHeader().GetTrdMarket()))`,
+	"TrdGetMaxTrdQtysRequest.code": `validateCode(%s, m.GetCode(),
+		isCNTrdSecMarket(m.GetSecMarket(), m.GetHeader().GetTrdMarket()))`,
+	"Security.code": `validateCode(%s, m.GetCode(),
+		(m.GetMarket() == QotMarket_CNSH_Security || m.GetMarket() == QotMarket_CNSZ_Security) &&
+			!isCNPlateCode(m.GetCode()))`,
+}
+
+func generateValidateForMessage(g *protogen.GeneratedFile, msg *protogen.Message, isRequest bool) {
+
+	msgName := string(msg.GoIdent.GoName)
+	protoName := string(msg.Desc.Name())
+
+	if isRequest {
+		g.P()
+		g.P(`// Validate checks required fields, enum values and documented constraints.`)
+		g.P(`func (m *`, msgName, `) Validate() error {`)
+		g.P(`	return m.validate("`, protoName, `")`)
+		g.P(`}`)
+	}
+
+	g.P()
+	g.P(`func (m *`, msgName, `) validate(path string) error {`)
+
+	for _, f := range msg.Fields {
+		name := string(f.Desc.Name())
+		if isRequest && autofill_fields[name] {
+			continue
+		}
+
+		fPath := fmt.Sprintf(`path+".%s"`, name)
+		required := f.Desc.Cardinality() == protoreflect.Required
+		kind := f.Desc.Kind()
+
+		switch {
+		case f.Desc.IsList() && kind == protoreflect.MessageKind:
+			g.P(`	for i, v := range m.`, f.GoName, ` {`)
+			g.P(`		if v == nil {`)
+			g.P(`			return &ValidationError{Field: fmt.Sprintf("%s.`, name, `[%d]", path, i), Reason: "nil element"}`)
+			g.P(`		}`)
+			g.P(`		if err := v.validate(fmt.Sprintf("%s.`, name, `[%d]", path, i)); err != nil {`)
+			g.P(`			return err`)
+			g.P(`		}`)
+			g.P(`	}`)
+
+		case f.Desc.IsList() && kind == protoreflect.EnumKind:
+			g.P(`	for i, v := range m.`, f.GoName, ` {`)
+			g.P(`		if err := validateEnum(fmt.Sprintf("%s.`, name, `[%d]", path, i), v, false); err != nil {`)
+			g.P(`			return err`)
+			g.P(`		}`)
+			g.P(`	}`)
+
+		case f.Desc.IsList():
+
+		default:
+			if required {
+				g.P(`	if m.`, f.GoName, ` == nil {`)
+				g.P(`		return &ValidationError{Field: `, fPath, `, Reason: "required"}`)
+				g.P(`	}`)
+			}
+
+			switch kind {
+			case protoreflect.MessageKind:
+				g.P(`	if m.`, f.GoName, ` != nil {`)
+				g.P(`		if err := m.`, f.GoName, `.validate(`, fPath, `); err != nil {`)
+				g.P(`			return err`)
+				g.P(`		}`)
+				g.P(`	}`)
+
+			case protoreflect.EnumKind:
+				// *_Unknown is never meaningful for required enums
+				g.P(`	if m.`, f.GoName, ` != nil {`)
+				g.P(`		if err := validateEnum(`, fPath, `, *m.`, f.GoName, `, `, required, `); err != nil {`)
+				g.P(`			return err`)
+				g.P(`		}`)
+				g.P(`	}`)
+			}
+		}
+
+		if rule, ok := validate_rules[protoName+"."+name]; ok {
+			g.P(`	if m.`, f.GoName, ` != nil {`)
+			g.P(`		if err := `, fmt.Sprintf(rule, fPath), `; err != nil {`)
+			g.P(`			return err`)
+			g.P(`		}`)
+			g.P(`	}`)
+		}
+	}
+
+	g.P(`	return nil`)
+	g.P(`}`)
+}
+
+func generateValidate(plugin *protogen.Plugin, msgs []*protogen.Message) error {
+
+	g := newGeneratedFile(plugin, "adapt_validate.go")
+
+	g.P(`
+		import (
+			"fmt"
+			"strings"
+
+			"google.golang.org/protobuf/reflect/protoreflect"
+		)
+
+		type Validator interface {
+			Validate() error
+		}
+
+		// ValidationError reports an invalid request field by its path,
+		// eg: TrdPlaceOrderRequest.header.accID
+		type ValidationError struct {
+			Field  string
+			Reason string
+		}
+
+		func (e *ValidationError) Error() string {
+			return e.Field + ": " + e.Reason
+		}
+
+		func validateEnum(path string, e protoreflect.Enum, noUnknown bool) error {
+			v := e.Descriptor().Values().ByNumber(e.Number())
+			if v == nil {
+				return &ValidationError{Field: path, Reason: fmt.Sprintf("invalid %s value %d", e.Descriptor().Name(), e.Number())}
+			}
+			if noUnknown && strings.HasSuffix(string(v.Name()), "_Unknown") {
+				return &ValidationError{Field: path, Reason: "must not be " + string(v.Name())}
+			}
+			return nil
+		}
+
+		func validateMaxBytes(path string, s string, n int) error {
+			if len(s) > n {
+				return &ValidationError{Field: path, Reason: fmt.Sprintf("exceeds %d bytes", n)}
+			}
+			return nil
+		}
+
+		// validateCode checks a security code without market,
+		// A-share codes must be 6 digits.
+		func validateCode(path string, code string, isCN bool) error {
+			if code == "" {
+				return &ValidationError{Field: path, Reason: "empty code"}
+			}
+
+			for _, prefix := range []string{"HK.", "US.", "SH.", "SZ."} {
+				if strings.HasPrefix(code, prefix) {
+					return &ValidationError{Field: path, Reason: "code must not have market prefix: " + code}
+				}
+			}
+
+			if isCN {
+				if len(code) != 6 || strings.Trim(code, "0123456789") != "" {
+					return &ValidationError{Field: path, Reason: "A-share code must be 6 digits: " + code}
+				}
+			}
+			return nil
+		}
+
+		// isCNPlateCode reports plate codes of A-share markets, eg: BK0001 and LIST3000000.
+		func isCNPlateCode(code string) bool {
+			return strings.HasPrefix(code, "BK") || strings.HasPrefix(code, "LIST")
+		}
+
+		func isCNTrdSecMarket(secMarket TrdSecMarket, trdMarket TrdMarket) bool {
+			switch secMarket {
+			case TrdSecMarket_CN_SH, TrdSecMarket_CN_SZ:
+				return true
+			case TrdSecMarket_Unknown:
+				return trdMarket == TrdMarket_CN
+			}
+			return false
+		}
+	`)
+
+	isRequest := map[string]bool{}
+	all_msgs := map[string]*protogen.Message{}
+	for _, msg := range msgs {
+		isRequest[msg.GoIdent.GoName] = true
+		collect_msgs(msg, all_msgs)
+	}
+
+	msg_keys := []string{}
+	for k := range all_msgs {
+		msg_keys = append(msg_keys, k)
+	}
+	sort.Strings(msg_keys)
+
+	for _, k := range msg_keys {
+		generateValidateForMessage(g, all_msgs[k], isRequest[k])
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package futu_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidate(t *testing.T) {
+	should := require.New(t)
+
+	fieldOf := func(err error) string {
+		var verr *pb.ValidationError
+		should.True(errors.As(err, &verr), "%v", err)
+		return verr.Field
+	}
+
+	header := &pb.TrdHeader{
+		TrdEnv:    pb.TrdEnv_Simulate.Enum(),
+		AccID:     proto.Uint64(1),
+		TrdMarket: pb.TrdMarket_HK.Enum(),
+	}
+
+	req := &pb.TrdPlaceOrderRequest{
+		TrdSide:   pb.TrdSide_Buy.Enum(),
+		OrderType: pb.OrderType_Normal.Enum(),
+		Code:      proto.String("00700"),
+		Qty:       proto.Float64(100),
+	}
+
+	// packetID is filled by client, header is not
+	should.Equal("TrdPlaceOrderRequest.header", fieldOf(req.Validate()))
+
+	req.Header = &pb.TrdHeader{TrdEnv: pb.TrdEnv_Simulate.Enum()}
+	should.Equal("TrdPlaceOrderRequest.header.accID", fieldOf(req.Validate()))
+
+	req.Header = proto.Clone(header).(*pb.TrdHeader)
+	req.Header.TrdMarket = pb.TrdMarket_Unknown.Enum()
+	should.Equal("TrdPlaceOrderRequest.header.trdMarket", fieldOf(req.Validate()))
+
+	req.Header = header
+	should.NoError(req.Validate())
+
+	req.TrdSide = pb.TrdSide(99).Enum()
+	should.Equal("TrdPlaceOrderRequest.trdSide", fieldOf(req.Validate()))
+	req.TrdSide = pb.TrdSide_Buy.Enum()
+
+	req.Remark = proto.String(strings.Repeat("x", 65))
+	should.Equal("TrdPlaceOrderRequest.remark", fieldOf(req.Validate()))
+	req.Remark = proto.String(strings.Repeat("x", 64))
+	should.NoError(req.Validate())
+
+	req.Code = proto.String("HK.00700")
+	should.Equal("TrdPlaceOrderRequest.code", fieldOf(req.Validate()))
+
+	req.Code = proto.String("60051")
+	req.SecMarket = pb.TrdSecMarket_CN_SH.Enum()
+	should.Equal("TrdPlaceOrderRequest.code", fieldOf(req.Validate()))
+	req.Code = proto.String("600519")
+	should.NoError(req.Validate())
+
+	// nested lists
+	sub := &pb.QotSubRequest{
+		SecurityList: futu.NewSecurityList("HK.00700", "XX.123"),
+		SubTypeList:  []pb.SubType{pb.SubType_Basic},
+		IsSubOrUnSub: proto.Bool(true),
+	}
+	should.Equal("QotSubRequest.securityList[1].market", fieldOf(sub.Validate()))
+
+	sub.SecurityList = futu.NewSecurityList("HK.00700", "SZ.1")
+	should.Equal("QotSubRequest.securityList[1].code", fieldOf(sub.Validate()))
+
+	sub.SecurityList = futu.NewSecurityList("HK.00700", "SZ.000001")
+	should.NoError(sub.Validate())
+
+	// plates of A-share markets are not 6 digits
+	plate := &pb.QotGetPlateSecurityRequest{Plate: futu.NewSecurity("SH.BK0001")}
+	should.NoError(plate.Validate())
+	plate.Plate = futu.NewSecurity("SH.LIST3000000")
+	should.NoError(plate.Validate())
+	plate.Plate = futu.NewSecurity("SZ.12345")
+	should.Equal("QotGetPlateSecurityRequest.plate.code", fieldOf(plate.Validate()))
+}
@@ -0,0 +1,292 @@
+package futu
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/santsai/futu-go/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// VerifyCodeFunc obtains a verification code of type t from somewhere,
+// eg: stdin prompt, file drop, http endpoint.
+type VerifyCodeFunc func(ctx context.Context, t pb.VerificationType) (string, error)
+
+// LoginAssistant answers OpenD's picture/phone verification code requests.
+// It watches Notify pushes, requests a code from OpenD, obtains the code
+// via VerifyCodeFunc and inputs it to continue login.
+//
+//	la := futu.NewLoginAssistant(client, futu.StdinVerifyCode(os.Stdin, os.Stdout), 5*time.Minute)
+//	client.OnNotify(la.HandleNotify)
+type LoginAssistant struct {
+	rh      pb.RequestHandler
+	getCode VerifyCodeFunc
+	timeout time.Duration
+	busy    atomic.Bool
+}
+
+// NewLoginAssistant creates a LoginAssistant.
+// timeout bounds a whole verification flow, including waiting for the code.
+func NewLoginAssistant(rh pb.RequestHandler, getCode VerifyCodeFunc, timeout time.Duration) *LoginAssistant {
+	return &LoginAssistant{
+		rh:      rh,
+		getCode: getCode,
+		timeout: timeout,
+	}
+}
+
+// Handler returns a Notify push handler.
+func (la *LoginAssistant) Handler() Handler {
+	return func(s2c proto.Message) error {
+		if msg, ok := s2c.(*pb.NotifyResponse); ok {
+			la.HandleNotify(msg)
+		}
+		return nil
+	}
+}
+
+// HandleNotify starts a verification flow if msg asks for a code.
+// The flow runs in background, so it is safe to call from push handlers.
+func (la *LoginAssistant) HandleNotify(msg *pb.NotifyResponse) {
+	t := NeedVerificationType(msg)
+	if t == pb.VerificationType_Unknown {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.TODO(), la.timeout)
+		defer cancel()
+
+		if err := la.Verify(ctx, t, false); err != nil && !errors.Is(err, ErrVerificationBusy) {
+			log.Error().Err(err).Stringer("type", t).Msg("verification error")
+		}
+	}()
+}
+
+// Verify runs a verification flow of type t.
+// A new code is requested from OpenD for pictures, which are only fetched
+// on request, or if resend, eg: the phone code expired. OpenD has sent
+// the phone code already when it asks for one.
+// Only one flow runs at a time, others return ErrVerificationBusy.
+func (la *LoginAssistant) Verify(ctx context.Context, t pb.VerificationType, resend bool) error {
+
+	if !la.busy.CompareAndSwap(false, true) {
+		return ErrVerificationBusy
+	}
+	defer la.busy.Store(false)
+
+	if t == pb.VerificationType_Picture || resend {
+		req := &pb.VerificationRequest{
+			Type: t.Enum(),
+			Op:   pb.VerificationOp_Request.Enum(),
+		}
+		if _, err := req.Dispatch(ctx, la.rh); err != nil {
+			return fmt.Errorf("request verify code: %w", err)
+		}
+	}
+
+	code, err := la.getCode(ctx, t)
+	if err != nil {
+		return fmt.Errorf("get verify code: %w", err)
+	}
+
+	req := &pb.VerificationRequest{
+		Type: t.Enum(),
+		Op:   pb.VerificationOp_InputAndLogin.Enum(),
+		Code: proto.String(code),
+	}
+	if _, err := req.Dispatch(ctx, la.rh); err != nil {
+		return fmt.Errorf("input verify code: %w", err)
+	}
+
+	log.Info().Stringer("type", t).Msg("verify code accepted")
+	return nil
+}
+
+// NeedVerificationType returns the verification type requested by msg,
+// or VerificationType_Unknown if msg doesn't ask for a code.
+func NeedVerificationType(msg *pb.NotifyResponse) pb.VerificationType {
+	switch msg.GetType() {
+	case pb.NotifyType_GtwEvent:
+		switch msg.GetEvent().GetEventType() {
+		case pb.GtwEventType_NeedPicVerifyCode:
+			return pb.VerificationType_Picture
+		case pb.GtwEventType_NeedPhoneVerifyCode:
+			return pb.VerificationType_Phone
+		}
+
+	case pb.NotifyType_ProgramStatus:
+		switch msg.GetProgramStatus().GetProgramStatus().GetType() {
+		case pb.ProgramStatusType_NeedPicVerifyCode:
+			return pb.VerificationType_Picture
+		case pb.ProgramStatusType_NeedPhoneVerifyCode:
+			return pb.VerificationType_Phone
+		}
+	}
+
+	return pb.VerificationType_Unknown
+}
+
+// StdinVerifyCode prompts on w and reads a line from r.
+// r is read by one goroutine for the lifetime of the function,
+// lines read while no code is pending, eg: after a timeout, are dropped.
+func StdinVerifyCode(r io.Reader, w io.Writer) VerifyCodeFunc {
+	type result struct {
+		line string
+		err  error
+		at   time.Time
+	}
+
+	lines := make(chan result)
+	var once sync.Once
+
+	read := func() {
+		defer close(lines)
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadString('\n')
+			lines <- result{strings.TrimSpace(line), err, time.Now()}
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return func(ctx context.Context, t pb.VerificationType) (string, error) {
+		prompted := time.Now()
+		fmt.Fprintf(w, "OpenD requires %s verify code: ", verificationTypeName(t))
+		once.Do(func() { go read() })
+
+		for {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case r, ok := <-lines:
+				if !ok {
+					return "", io.EOF
+				}
+				if r.err == nil && r.at.Before(prompted) {
+					continue
+				}
+				if r.line != "" {
+					return r.line, nil
+				}
+				if r.err == nil {
+					r.err = errors.New("empty verify code")
+				}
+				return "", r.err
+			}
+		}
+	}
+}
+
+// FileVerifyCode waits for a file at path, reads the code and removes it.
+// Handy for headless OpenD, eg: `echo 123456 > /data/verify_code`.
+func FileVerifyCode(path string, pollInterval time.Duration) VerifyCodeFunc {
+	return func(ctx context.Context, t pb.VerificationType) (string, error) {
+
+		// stale codes are invalid after a new request
+		os.Remove(path)
+
+		log.Info().Str("path", path).Stringer("type", t).Msg("waiting for verify code file")
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-ticker.C:
+			}
+
+			b, err := os.ReadFile(path)
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return "", err
+			}
+
+			if code := strings.TrimSpace(string(b)); code != "" {
+				os.Remove(path)
+				return code, nil
+			}
+		}
+	}
+}
+
+// HTTPVerifyCode is a http.Handler receiving verify codes,
+// eg: `curl -d code=123456 http://host/verify_code`.
+// GET returns the pending verification type, if any.
+type HTTPVerifyCode struct {
+	pending atomic.Int32
+	codes   chan string
+}
+
+// NewHTTPVerifyCode creates a HTTPVerifyCode.
+func NewHTTPVerifyCode() *HTTPVerifyCode {
+	return &HTTPVerifyCode{
+		codes: make(chan string),
+	}
+}
+
+// VerifyCode returns a VerifyCodeFunc waiting for codes posted to h.
+func (h *HTTPVerifyCode) VerifyCode() VerifyCodeFunc {
+	return func(ctx context.Context, t pb.VerificationType) (string, error) {
+		h.pending.Store(int32(t))
+		defer h.pending.Store(int32(pb.VerificationType_Unknown))
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case code := <-h.codes:
+			return code, nil
+		}
+	}
+}
+
+func (h *HTTPVerifyCode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	t := pb.VerificationType(h.pending.Load())
+
+	switch r.Method {
+	case http.MethodGet:
+		fmt.Fprintln(w, verificationTypeName(t))
+
+	case http.MethodPost:
+		code := strings.TrimSpace(r.FormValue("code"))
+		if code == "" {
+			http.Error(w, "code is required", http.StatusBadRequest)
+			return
+		}
+
+		select {
+		case h.codes <- code:
+			fmt.Fprintln(w, "ok")
+		default:
+			http.Error(w, "no verify code pending", http.StatusConflict)
+		}
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func verificationTypeName(t pb.VerificationType) string {
+	switch t {
+	case pb.VerificationType_Picture:
+		return "picture"
+	case pb.VerificationType_Phone:
+		return "phone"
+	}
+	return "none"
+}
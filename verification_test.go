@@ -0,0 +1,139 @@
+package futu_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/santsai/futu-go"
+	"github.com/santsai/futu-go/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type verificationHandler struct {
+	reqs []*pb.VerificationRequest
+}
+
+func (h *verificationHandler) Request(ctx context.Context, id pb.ProtoId, req pb.Request, resp pb.Response) (proto.Message, error) {
+	h.reqs = append(h.reqs, req.GetRequestPayload().(*pb.VerificationRequest))
+	return &pb.VerificationResponse{}, nil
+}
+
+func TestNeedVerificationType(t *testing.T) {
+	should := require.New(t)
+
+	msg := &pb.NotifyResponse{
+		Type: pb.NotifyType_GtwEvent.Enum(),
+		Event: &pb.GtwEventNotice{
+			EventType: pb.GtwEventType_NeedPhoneVerifyCode.Enum(),
+		},
+	}
+	should.Equal(pb.VerificationType_Phone, futu.NeedVerificationType(msg))
+
+	msg = &pb.NotifyResponse{
+		Type: pb.NotifyType_ProgramStatus.Enum(),
+		ProgramStatus: &pb.ProgramStatusNotice{
+			ProgramStatus: &pb.ProgramStatus{
+				Type: pb.ProgramStatusType_NeedPicVerifyCode.Enum(),
+			},
+		},
+	}
+	should.Equal(pb.VerificationType_Picture, futu.NeedVerificationType(msg))
+
+	msg = &pb.NotifyResponse{
+		Type: pb.NotifyType_GtwEvent.Enum(),
+		Event: &pb.GtwEventNotice{
+			EventType: pb.GtwEventType_KickedOut.Enum(),
+		},
+	}
+	should.Equal(pb.VerificationType_Unknown, futu.NeedVerificationType(msg))
+}
+
+func TestLoginAssistantVerify(t *testing.T) {
+	should := require.New(t)
+
+	rh := &verificationHandler{}
+	var out strings.Builder
+	la := futu.NewLoginAssistant(rh, futu.StdinVerifyCode(strings.NewReader("123456\n"), &out), time.Second)
+
+	// phone code was sent by OpenD already
+	should.NoError(la.Verify(context.TODO(), pb.VerificationType_Phone, false))
+	should.Contains(out.String(), "phone")
+	should.Len(rh.reqs, 1)
+	should.Equal(pb.VerificationOp_InputAndLogin, rh.reqs[0].GetOp())
+	should.Equal(pb.VerificationType_Phone, rh.reqs[0].GetType())
+	should.Equal("123456", rh.reqs[0].GetCode())
+}
+
+func TestLoginAssistantRequestCode(t *testing.T) {
+	should := require.New(t)
+
+	getCode := func(context.Context, pb.VerificationType) (string, error) { return "abcd", nil }
+
+	rh := &verificationHandler{}
+	la := futu.NewLoginAssistant(rh, getCode, time.Second)
+
+	should.NoError(la.Verify(context.TODO(), pb.VerificationType_Picture, false))
+	should.Len(rh.reqs, 2)
+	should.Equal(pb.VerificationOp_Request, rh.reqs[0].GetOp())
+	should.Equal(pb.VerificationType_Picture, rh.reqs[0].GetType())
+	should.Equal(pb.VerificationOp_InputAndLogin, rh.reqs[1].GetOp())
+
+	// resend phone code
+	rh.reqs = nil
+	should.NoError(la.Verify(context.TODO(), pb.VerificationType_Phone, true))
+	should.Len(rh.reqs, 2)
+	should.Equal(pb.VerificationOp_Request, rh.reqs[0].GetOp())
+	should.Equal(pb.VerificationType_Phone, rh.reqs[0].GetType())
+}
+
+func TestStdinVerifyCodeTimeout(t *testing.T) {
+	should := require.New(t)
+
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	getCode := futu.StdinVerifyCode(pr, io.Discard)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 20*time.Millisecond)
+	defer cancel()
+	_, err := getCode(ctx, pb.VerificationType_Phone)
+	should.ErrorIs(err, context.DeadlineExceeded)
+
+	// typed after the timeout, dropped by the next prompt
+	_, err = io.WriteString(pw, "111111\n")
+	should.NoError(err)
+	time.Sleep(10 * time.Millisecond)
+
+	go io.WriteString(pw, "222222\n")
+
+	ctx, cancel = context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+	code, err := getCode(ctx, pb.VerificationType_Phone)
+	should.NoError(err)
+	should.Equal("222222", code)
+}
+
+func TestFileVerifyCode(t *testing.T) {
+	should := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "code")
+	getCode := futu.FileVerifyCode(path, 10*time.Millisecond)
+
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		os.WriteFile(path, []byte("8888\n"), 0o600)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+
+	code, err := getCode(ctx, pb.VerificationType_Picture)
+	should.NoError(err)
+	should.Equal("8888", code)
+	should.NoFileExists(path)
+}